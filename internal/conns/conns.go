@@ -3,7 +3,9 @@ package conns
 import (
 	"fmt"
 	"log"
+	"net/http"
 	"strings"
+	"time"
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/aws/endpoints"
@@ -848,6 +850,18 @@ func init() {
 	serviceData[XRay] = &ServiceDatum{AWSClientName: "XRay", AWSServiceName: xray.ServiceName, AWSEndpointsID: xray.EndpointsID, AWSServiceID: xray.ServiceID, ProviderNameUpper: "XRay", HCLKeys: []string{"xray"}}
 }
 
+const (
+	EC2MetadataServiceEndpointModeIPv4 = "IPv4"
+	EC2MetadataServiceEndpointModeIPv6 = "IPv6"
+)
+
+func EC2MetadataServiceEndpointMode_Values() []string {
+	return []string{
+		EC2MetadataServiceEndpointModeIPv4,
+		EC2MetadataServiceEndpointModeIPv6,
+	}
+}
+
 type Config struct {
 	AccessKey     string
 	SecretKey     string
@@ -857,6 +871,9 @@ type Config struct {
 	Region        string
 	MaxRetries    int
 
+	MaxRequestsPerSecond map[string]float64
+	RetryMode            string
+
 	AssumeRoleARN               string
 	AssumeRoleDurationSeconds   int
 	AssumeRoleExternalID        string
@@ -866,14 +883,31 @@ type Config struct {
 	AssumeRoleTags              map[string]string
 	AssumeRoleTransitiveTagKeys []string
 
+	AssumeRoleWithWebIdentityARN         string
+	AssumeRoleWithWebIdentityDuration    time.Duration
+	AssumeRoleWithWebIdentityPolicy      string
+	AssumeRoleWithWebIdentityPolicyARNs  []string
+	AssumeRoleWithWebIdentitySessionName string
+	AssumeRoleWithWebIdentityToken       string
+	AssumeRoleWithWebIdentityTokenFile   string
+
 	AllowedAccountIds   []string
 	ForbiddenAccountIds []string
 
-	DefaultTagsConfig *tftags.DefaultConfig
-	Endpoints         map[string]string
-	IgnoreTagsConfig  *tftags.IgnoreConfig
-	Insecure          bool
-	HTTPProxy         string
+	SharedConfigFiles      []string
+	SharedCredentialsFiles []string
+
+	CustomCABundle                 string
+	DefaultTagsConfig              *tftags.DefaultConfig
+	EC2MetadataServiceEndpoint     string
+	EC2MetadataServiceEndpointMode string
+	Endpoints                      map[string]string
+	IgnoreTagsConfig               *tftags.IgnoreConfig
+	Insecure                       bool
+	HTTPProxy                      string
+	TagPolicyConfig                *tftags.PolicyConfig
+
+	ReadOnly bool // Fail any API operation that may mutate resources
 
 	SkipCredsValidation     bool
 	SkipGetEC2Platforms     bool
@@ -882,6 +916,9 @@ type Config struct {
 	SkipMetadataApiCheck    bool
 	S3ForcePathStyle        bool
 
+	UseDualStackEndpoint bool
+	UseFIPSEndpoint      bool
+
 	TerraformVersion string
 }
 
@@ -1005,6 +1042,7 @@ type AWSClient struct {
 	HealthConn                        *health.Health
 	HealthLakeConn                    *healthlake.HealthLake
 	HoneycodeConn                     *honeycode.Honeycode
+	HTTPClient                        *http.Client
 	IAMConn                           *iam.IAM
 	IdentityStoreConn                 *identitystore.IdentityStore
 	IgnoreTagsConfig                  *tftags.IgnoreConfig
@@ -1149,6 +1187,7 @@ type AWSClient struct {
 	SupportedPlatforms                []string
 	SWFConn                           *swf.SWF
 	SyntheticsConn                    *synthetics.Synthetics
+	TagPolicyConfig                   *tftags.PolicyConfig
 	TerraformVersion                  string
 	TextractConn                      *textract.Textract
 	TimestreamQueryConn               *timestreamquery.TimestreamQuery
@@ -1222,7 +1261,7 @@ func (c *Config) Client() (interface{}, error) {
 		UserAgentProducts:           StdUserAgentProducts(c.TerraformVersion),
 	}
 
-	sess, accountID, Partition, err := awsbase.GetSessionWithAccountIDAndPartition(awsbaseConfig)
+	sess, accountID, Partition, err := c.newSession(awsbaseConfig)
 	if err != nil {
 		return nil, fmt.Errorf("error configuring Terraform AWS Provider: %w", err)
 	}
@@ -1240,6 +1279,19 @@ func (c *Config) Client() (interface{}, error) {
 		DNSSuffix = p.DNSSuffix()
 	}
 
+	// All service client sessions are copied from this session so that the retry mode
+	// and client-side request rate limits apply everywhere.
+	sess, err = c.configureRetries(sess)
+
+	if err != nil {
+		return nil, fmt.Errorf("error configuring Terraform AWS Provider: %w", err)
+	}
+
+	if c.ReadOnly {
+		sess = sess.Copy()
+		sess.Handlers.Validate.PushBackNamed(readOnlyHandler)
+	}
+
 	client := &AWSClient{
 		AccessAnalyzerConn:                accessanalyzer.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[AccessAnalyzer])})),
 		AccountConn:                       account.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[Account])})),
@@ -1359,6 +1411,7 @@ func (c *Config) Client() (interface{}, error) {
 		HealthConn:                        health.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[Health])})),
 		HealthLakeConn:                    healthlake.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[HealthLake])})),
 		HoneycodeConn:                     honeycode.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[Honeycode])})),
+		HTTPClient:                        sess.Config.HTTPClient,
 		IAMConn:                           iam.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[IAM])})),
 		IdentityStoreConn:                 identitystore.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[IdentityStore])})),
 		IgnoreTagsConfig:                  c.IgnoreTagsConfig,
@@ -1497,6 +1550,7 @@ func (c *Config) Client() (interface{}, error) {
 		SupportConn:                       support.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[Support])})),
 		SWFConn:                           swf.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[SWF])})),
 		SyntheticsConn:                    synthetics.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[Synthetics])})),
+		TagPolicyConfig:                   c.TagPolicyConfig,
 		TerraformVersion:                  c.TerraformVersion,
 		TextractConn:                      textract.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[Textract])})),
 		TimestreamQueryConn:               timestreamquery.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[TimestreamQuery])})),
@@ -1817,6 +1871,22 @@ func (c *Config) Client() (interface{}, error) {
 	return client, nil
 }
 
+// endpointResolutionConfig returns the AWS SDK configuration for resolving
+// FIPS and dual-stack service endpoints.
+func (c *Config) endpointResolutionConfig() *aws.Config {
+	config := &aws.Config{}
+
+	if c.UseDualStackEndpoint {
+		config.UseDualStackEndpoint = endpoints.DualStackEndpointStateEnabled
+	}
+
+	if c.UseFIPSEndpoint {
+		config.UseFIPSEndpoint = endpoints.FIPSEndpointStateEnabled
+	}
+
+	return config
+}
+
 func StdUserAgentProducts(terraformVersion string) []*awsbase.UserAgentProduct {
 	return []*awsbase.UserAgentProduct{
 		{Name: "APN", Version: "1.0"},
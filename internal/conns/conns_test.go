@@ -4,6 +4,9 @@ import (
 	"reflect"
 	"testing"
 
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/credentials"
+	"github.com/aws/aws-sdk-go/aws/session"
 	"github.com/aws/aws-sdk-go/service/ec2"
 	awsbase "github.com/hashicorp/aws-sdk-go-base"
 )
@@ -82,6 +85,57 @@ func TestAWSClientRegionalHostname(t *testing.T) {
 	}
 }
 
+func TestConfigEndpointResolutionConfig(t *testing.T) {
+	testCases := []struct {
+		Name     string
+		Config   *Config
+		Endpoint string
+		Expected string
+	}{
+		{
+			Name:     "default",
+			Config:   &Config{},
+			Expected: "https://ec2.us-west-2.amazonaws.com", //lintignore:AWSAT003
+		},
+		{
+			Name:     "FIPS",
+			Config:   &Config{UseFIPSEndpoint: true},
+			Expected: "https://ec2-fips.us-west-2.amazonaws.com", //lintignore:AWSAT003
+		},
+		{
+			Name:     "DualStack",
+			Config:   &Config{UseDualStackEndpoint: true},
+			Expected: "https://api.ec2.us-west-2.aws", //lintignore:AWSAT003
+		},
+		{
+			Name:     "FIPS with custom endpoint",
+			Config:   &Config{UseFIPSEndpoint: true},
+			Endpoint: "https://ec2.example.com",
+			Expected: "https://ec2.example.com",
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			sess, err := session.NewSession(&aws.Config{
+				Credentials: credentials.NewStaticCredentials("AKID", "SECRET", ""),
+				Region:      aws.String("us-west-2"), //lintignore:AWSAT003
+			})
+
+			if err != nil {
+				t.Fatalf("error creating session: %s", err)
+			}
+
+			sess = sess.Copy(testCase.Config.endpointResolutionConfig())
+			conn := ec2.New(sess.Copy(&aws.Config{Endpoint: aws.String(testCase.Endpoint)}))
+
+			if got := conn.Endpoint; got != testCase.Expected {
+				t.Errorf("got %s, expected %s", got, testCase.Expected)
+			}
+		})
+	}
+}
+
 func TestGetSupportedEC2Platforms(t *testing.T) {
 	ec2Endpoints := []*awsbase.MockEndpoint{
 		{
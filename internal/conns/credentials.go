@@ -0,0 +1,361 @@
+package conns
+
+import (
+	"fmt"
+	"log"
+	"net/http"
+	"os"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/credentials"
+	"github.com/aws/aws-sdk-go/aws/credentials/stscreds"
+	"github.com/aws/aws-sdk-go/aws/defaults"
+	"github.com/aws/aws-sdk-go/aws/endpoints"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/aws-sdk-go/service/sso"
+	"github.com/aws/aws-sdk-go/service/sts"
+	awsbase "github.com/hashicorp/aws-sdk-go-base"
+	"github.com/mitchellh/go-homedir"
+)
+
+// credentials returns validated credentials for the provider configuration.
+// Static, web identity or shared configuration file credentials are resolved first
+// and are then used as the source identity for assume_role, if configured.
+func (c *Config) credentials(awsbaseConfig *awsbase.Config, httpClient *http.Client) (*credentials.Credentials, error) {
+	var creds *credentials.Credentials
+	var err error
+
+	switch {
+	case c.AccessKey != "":
+		creds = credentials.NewStaticCredentials(c.AccessKey, c.SecretKey, c.Token)
+	case c.AssumeRoleWithWebIdentityARN != "":
+		creds, err = c.webIdentityCredentials(httpClient)
+	default:
+		creds, err = c.sharedConfigCredentials(c.credentialsHTTPClient(httpClient))
+
+		if err != nil {
+			err = awsbaseConfig.NewNoValidCredentialSourcesError(err)
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if c.AssumeRoleARN == "" {
+		return creds, nil
+	}
+
+	log.Printf("[INFO] Attempting to AssumeRole %s (SessionName: %q, ExternalId: %q)", c.AssumeRoleARN, c.AssumeRoleSessionName, c.AssumeRoleExternalID)
+
+	sess, err := session.NewSession(&aws.Config{
+		CredentialsChainVerboseErrors: aws.Bool(true),
+		Credentials:                   creds,
+		HTTPClient:                    httpClient,
+		MaxRetries:                    aws.Int(c.MaxRetries),
+		Region:                        aws.String(c.Region),
+	}, c.endpointResolutionConfig())
+
+	if err != nil {
+		return nil, fmt.Errorf("error creating assume role session: %w", err)
+	}
+
+	creds = c.assumeRoleCredentials(sess)
+
+	if _, err := creds.Get(); err != nil {
+		return nil, awsbaseConfig.NewCannotAssumeRoleError(err)
+	}
+
+	return creds, nil
+}
+
+// webIdentityRoleProvider retrieves credentials by exchanging an OpenID Connect
+// token for temporary credentials via STS AssumeRoleWithWebIdentity.
+// Unlike stscreds.WebIdentityRoleProvider it supports session policies.
+type webIdentityRoleProvider struct {
+	credentials.Expiry
+
+	conn            *sts.STS
+	duration        time.Duration
+	policy          string
+	policyARNs      []string
+	roleARN         string
+	roleSessionName string
+	token           string
+	tokenFile       string
+}
+
+func (p *webIdentityRoleProvider) Retrieve() (credentials.Value, error) {
+	token, err := p.fetchToken()
+
+	if err != nil {
+		return credentials.Value{ProviderName: stscreds.WebIdentityProviderName}, err
+	}
+
+	sessionName := p.roleSessionName
+
+	if sessionName == "" {
+		sessionName = strconv.FormatInt(time.Now().UnixNano(), 10)
+	}
+
+	input := &sts.AssumeRoleWithWebIdentityInput{
+		RoleArn:          aws.String(p.roleARN),
+		RoleSessionName:  aws.String(sessionName),
+		WebIdentityToken: aws.String(token),
+	}
+
+	if p.duration > 0 {
+		input.DurationSeconds = aws.Int64(int64(p.duration / time.Second))
+	}
+
+	if p.policy != "" {
+		input.Policy = aws.String(p.policy)
+	}
+
+	for _, policyARN := range p.policyARNs {
+		input.PolicyArns = append(input.PolicyArns, &sts.PolicyDescriptorType{
+			Arn: aws.String(policyARN),
+		})
+	}
+
+	req, output := p.conn.AssumeRoleWithWebIdentityRequest(input)
+
+	// InvalidIdentityToken can be returned while the OIDC provider's keys propagate.
+	req.RetryErrorCodes = append(req.RetryErrorCodes, sts.ErrCodeInvalidIdentityTokenException)
+
+	if err := req.Send(); err != nil {
+		return credentials.Value{ProviderName: stscreds.WebIdentityProviderName}, fmt.Errorf("error assuming IAM Role (%s) with web identity: %w", p.roleARN, err)
+	}
+
+	p.SetExpiration(aws.TimeValue(output.Credentials.Expiration), stscreds.DefaultDuration/3)
+
+	return credentials.Value{
+		AccessKeyID:     aws.StringValue(output.Credentials.AccessKeyId),
+		SecretAccessKey: aws.StringValue(output.Credentials.SecretAccessKey),
+		SessionToken:    aws.StringValue(output.Credentials.SessionToken),
+		ProviderName:    stscreds.WebIdentityProviderName,
+	}, nil
+}
+
+// fetchToken returns the configured token, re-reading the token file on each call
+// so that tokens rotated by the CI system or kubelet are picked up on refresh.
+func (p *webIdentityRoleProvider) fetchToken() (string, error) {
+	if p.token != "" {
+		return p.token, nil
+	}
+
+	filename, err := homedir.Expand(p.tokenFile)
+
+	if err != nil {
+		return "", fmt.Errorf("error expanding web identity token filename: %w", err)
+	}
+
+	b, err := os.ReadFile(filename)
+
+	if err != nil {
+		return "", fmt.Errorf("error reading web identity token file (%s): %w", filename, err)
+	}
+
+	return strings.TrimSpace(string(b)), nil
+}
+
+// webIdentityCredentials returns validated credentials for the configured
+// assume_role_with_web_identity settings.
+func (c *Config) webIdentityCredentials(httpClient *http.Client) (*credentials.Credentials, error) {
+	log.Printf("[INFO] Attempting to AssumeRoleWithWebIdentity %s (SessionName: %q)", c.AssumeRoleWithWebIdentityARN, c.AssumeRoleWithWebIdentitySessionName)
+
+	// AssumeRoleWithWebIdentity requests are not signed.
+	sess, err := session.NewSession(&aws.Config{
+		Credentials: credentials.AnonymousCredentials,
+		Endpoint:    aws.String(c.Endpoints[STS]),
+		HTTPClient:  httpClient,
+		MaxRetries:  aws.Int(c.MaxRetries),
+		Region:      aws.String(c.Region),
+	}, c.endpointResolutionConfig())
+
+	if err != nil {
+		return nil, fmt.Errorf("error creating web identity session: %w", err)
+	}
+
+	creds := credentials.NewCredentials(&webIdentityRoleProvider{
+		conn:            sts.New(sess),
+		duration:        c.AssumeRoleWithWebIdentityDuration,
+		policy:          c.AssumeRoleWithWebIdentityPolicy,
+		policyARNs:      c.AssumeRoleWithWebIdentityPolicyARNs,
+		roleARN:         c.AssumeRoleWithWebIdentityARN,
+		roleSessionName: c.AssumeRoleWithWebIdentitySessionName,
+		token:           c.AssumeRoleWithWebIdentityToken,
+		tokenFile:       c.AssumeRoleWithWebIdentityTokenFile,
+	})
+
+	if _, err := creds.Get(); err != nil {
+		return nil, err
+	}
+
+	return creds, nil
+}
+
+// assumeRoleCredentials returns credentials for the configured assume_role
+// settings, using the credentials of the given session as the source identity.
+func (c *Config) assumeRoleCredentials(sess *session.Session) *credentials.Credentials {
+	return stscreds.NewCredentialsWithClient(sts.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[STS])})), c.AssumeRoleARN, func(p *stscreds.AssumeRoleProvider) {
+		if c.AssumeRoleDurationSeconds > 0 {
+			p.Duration = time.Duration(c.AssumeRoleDurationSeconds) * time.Second
+		}
+
+		if c.AssumeRoleExternalID != "" {
+			p.ExternalID = aws.String(c.AssumeRoleExternalID)
+		}
+
+		if c.AssumeRolePolicy != "" {
+			p.Policy = aws.String(c.AssumeRolePolicy)
+		}
+
+		for _, policyARN := range c.AssumeRolePolicyARNs {
+			p.PolicyArns = append(p.PolicyArns, &sts.PolicyDescriptorType{
+				Arn: aws.String(policyARN),
+			})
+		}
+
+		if c.AssumeRoleSessionName != "" {
+			p.RoleSessionName = c.AssumeRoleSessionName
+		}
+
+		for k, v := range c.AssumeRoleTags {
+			p.Tags = append(p.Tags, &sts.Tag{
+				Key:   aws.String(k),
+				Value: aws.String(v),
+			})
+		}
+
+		if len(c.AssumeRoleTransitiveTagKeys) > 0 {
+			p.TransitiveTagKeys = aws.StringSlice(c.AssumeRoleTransitiveTagKeys)
+		}
+	})
+}
+
+// sharedConfigFiles returns the shared configuration and credentials files in
+// the order they are loaded. Values in later files take precedence, so
+// credentials files are loaded after configuration files.
+func (c *Config) sharedConfigFiles() ([]string, error) {
+	configFiles := c.SharedConfigFiles
+
+	if len(configFiles) == 0 {
+		configFiles = []string{GetEnvVarWithDefault(EnvVarSharedConfigFile, defaults.SharedConfigFilename())}
+	}
+
+	credentialsFiles := c.SharedCredentialsFiles
+
+	if len(credentialsFiles) == 0 {
+		if c.CredsFilename != "" {
+			credentialsFiles = []string{c.CredsFilename}
+		} else {
+			credentialsFiles = []string{GetEnvVarWithDefault(EnvVarSharedCredentialsFile, defaults.SharedCredentialsFilename())}
+		}
+	}
+
+	var filenames []string
+
+	for _, v := range append(configFiles, credentialsFiles...) {
+		filename, err := homedir.Expand(v)
+
+		if err != nil {
+			return nil, fmt.Errorf("error expanding shared configuration filename (%s): %w", v, err)
+		}
+
+		filenames = append(filenames, filename)
+	}
+
+	return filenames, nil
+}
+
+// sharedConfigCredentials returns validated credentials for the configured profile
+// in the shared configuration and credentials files. Profile resolution is done by
+// the AWS SDK and supports static credentials, source_profile and role_arn chains,
+// AWS SSO (using the cached token from `aws sso login`) and credential_process.
+// Environment variable credentials and the ECS task role or EC2 instance metadata service
+// are used if no profile is configured or the profile does not contain credentials.
+func (c *Config) sharedConfigCredentials(httpClient *http.Client) (*credentials.Credentials, error) {
+	filenames, err := c.sharedConfigFiles()
+
+	if err != nil {
+		return nil, err
+	}
+
+	log.Printf("[INFO] Attempting to use shared configuration files %v (Profile: %q)", filenames, c.Profile)
+
+	options := session.Options{
+		Config: aws.Config{
+			CredentialsChainVerboseErrors: aws.Bool(true),
+			EndpointResolver:              c.credentialsEndpointResolver(),
+			HTTPClient:                    httpClient,
+			MaxRetries:                    aws.Int(c.MaxRetries),
+			Region:                        aws.String(c.Region),
+		},
+		Profile:           c.Profile,
+		SharedConfigFiles: filenames,
+		SharedConfigState: session.SharedConfigEnable,
+	}
+
+	options.Config.MergeIn(c.endpointResolutionConfig())
+
+	if err := c.setEC2MetadataServiceOptions(&options); err != nil {
+		return nil, err
+	}
+
+	sess, err := session.NewSessionWithOptions(options)
+
+	if err != nil {
+		return nil, fmt.Errorf("error loading shared configuration files: %w", err)
+	}
+
+	creds := sess.Config.Credentials
+	value, err := creds.Get()
+
+	if err != nil {
+		return nil, fmt.Errorf("error loading credentials from shared configuration files (Profile: %q): %w", c.Profile, err)
+	}
+
+	log.Printf("[INFO] AWS Auth provider used: %q", value.ProviderName)
+
+	return creds, nil
+}
+
+// credentialsHTTPClient returns the HTTP client for sessions that may fall back to the
+// EC2 instance metadata service while resolving credentials. The AWS SDK only lowers the
+// metadata client's timeout to 1 second when no HTTP client is set, so the provider's
+// HTTP client is only used if it has a proxy, custom CA bundle or insecure setting.
+func (c *Config) credentialsHTTPClient(httpClient *http.Client) *http.Client {
+	if c.HTTPProxy == "" && c.CustomCABundle == "" && !c.Insecure {
+		return nil
+	}
+
+	return httpClient
+}
+
+// credentialsEndpointResolver resolves the custom STS and SSO endpoints, if
+// configured, for the services used while resolving credentials.
+func (c *Config) credentialsEndpointResolver() endpoints.Resolver {
+	return endpoints.ResolverFunc(func(service, region string, opts ...func(*endpoints.Options)) (endpoints.ResolvedEndpoint, error) {
+		var url string
+
+		switch service {
+		case sso.EndpointsID:
+			url = c.Endpoints[SSO]
+		case sts.EndpointsID:
+			url = c.Endpoints[STS]
+		}
+
+		if url != "" {
+			return endpoints.ResolvedEndpoint{
+				URL:           url,
+				SigningRegion: region,
+			}, nil
+		}
+
+		return endpoints.DefaultResolver().EndpointFor(service, region, opts...)
+	})
+}
@@ -0,0 +1,441 @@
+package conns
+
+import (
+	"bytes"
+	"crypto/sha1"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"net/http/httputil"
+	"net/url"
+	"os"
+	"path/filepath"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws/credentials"
+	awsbase "github.com/hashicorp/aws-sdk-go-base"
+	"github.com/hashicorp/go-cleanhttp"
+)
+
+func TestConfigWebIdentityCredentials(t *testing.T) {
+	tokenFile := filepath.Join(t.TempDir(), "token")
+
+	if err := os.WriteFile(tokenFile, []byte(awsbase.MockWebIdentityToken+"\n"), 0600); err != nil {
+		t.Fatal(err)
+	}
+
+	testCases := []struct {
+		Name          string
+		Config        *Config
+		ExpectedError bool
+	}{
+		{
+			Name: "token",
+			Config: &Config{
+				AssumeRoleWithWebIdentityARN:         awsbase.MockStsAssumeRoleWithWebIdentityArn,
+				AssumeRoleWithWebIdentitySessionName: awsbase.MockStsAssumeRoleWithWebIdentitySessionName,
+				AssumeRoleWithWebIdentityToken:       awsbase.MockWebIdentityToken,
+			},
+		},
+		{
+			Name: "token file",
+			Config: &Config{
+				AssumeRoleWithWebIdentityARN:         awsbase.MockStsAssumeRoleWithWebIdentityArn,
+				AssumeRoleWithWebIdentitySessionName: awsbase.MockStsAssumeRoleWithWebIdentitySessionName,
+				AssumeRoleWithWebIdentityTokenFile:   tokenFile,
+			},
+		},
+		{
+			Name: "missing token file",
+			Config: &Config{
+				AssumeRoleWithWebIdentityARN:         awsbase.MockStsAssumeRoleWithWebIdentityArn,
+				AssumeRoleWithWebIdentitySessionName: awsbase.MockStsAssumeRoleWithWebIdentitySessionName,
+				AssumeRoleWithWebIdentityTokenFile:   filepath.Join(t.TempDir(), "missing"),
+			},
+			ExpectedError: true,
+		},
+		{
+			Name: "invalid token",
+			Config: &Config{
+				AssumeRoleWithWebIdentityARN:         awsbase.MockStsAssumeRoleWithWebIdentityArn,
+				AssumeRoleWithWebIdentitySessionName: awsbase.MockStsAssumeRoleWithWebIdentitySessionName,
+				AssumeRoleWithWebIdentityToken:       "invalid",
+			},
+			ExpectedError: true,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			ts := awsbase.MockAwsApiServer("STS", []*awsbase.MockEndpoint{
+				awsbase.MockStsAssumeRoleWithWebIdentityValidEndpoint,
+			})
+			defer ts.Close()
+
+			testCase.Config.Endpoints = map[string]string{STS: ts.URL}
+			testCase.Config.Region = "us-east-1" //lintignore:AWSAT003
+
+			creds, err := testCase.Config.webIdentityCredentials(cleanhttp.DefaultClient())
+
+			if testCase.ExpectedError {
+				if err == nil {
+					t.Fatal("expected error, got none")
+				}
+
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			value, err := creds.Get()
+
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if value != awsbase.MockStsAssumeRoleWithWebIdentityCredentials {
+				t.Errorf("got %#v, expected %#v", value, awsbase.MockStsAssumeRoleWithWebIdentityCredentials)
+			}
+		})
+	}
+}
+
+func TestConfigCredentialsAssumeRoleChainedWithWebIdentity(t *testing.T) {
+	ts := awsbase.MockAwsApiServer("STS", []*awsbase.MockEndpoint{
+		awsbase.MockStsAssumeRoleWithWebIdentityValidEndpoint,
+		awsbase.MockStsAssumeRoleValidEndpoint,
+	})
+	defer ts.Close()
+
+	tsURL, err := url.Parse(ts.URL)
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// Count the STS actions requested via the provider's HTTP client.
+	var mu sync.Mutex
+	actions := make(map[string]int)
+	proxy := httputil.NewSingleHostReverseProxy(tsURL)
+	counter := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+		r.Body = io.NopCloser(bytes.NewReader(body))
+
+		if values, err := url.ParseQuery(string(body)); err == nil {
+			mu.Lock()
+			actions[values.Get("Action")]++
+			mu.Unlock()
+		}
+
+		proxy.ServeHTTP(w, r)
+	}))
+	defer counter.Close()
+
+	config := &Config{
+		AssumeRoleARN:                        awsbase.MockStsAssumeRoleArn,
+		AssumeRoleDurationSeconds:            900,
+		AssumeRoleSessionName:                awsbase.MockStsAssumeRoleSessionName,
+		AssumeRoleWithWebIdentityARN:         awsbase.MockStsAssumeRoleWithWebIdentityArn,
+		AssumeRoleWithWebIdentitySessionName: awsbase.MockStsAssumeRoleWithWebIdentitySessionName,
+		AssumeRoleWithWebIdentityToken:       awsbase.MockWebIdentityToken,
+		Endpoints:                            map[string]string{STS: counter.URL},
+		Region:                               "us-east-1", //lintignore:AWSAT003
+	}
+
+	creds, err := config.credentials(&awsbase.Config{AssumeRoleARN: config.AssumeRoleARN}, cleanhttp.DefaultClient())
+
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	value, err := creds.Get()
+
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if value != awsbase.MockStsAssumeRoleCredentials {
+		t.Errorf("got %#v, expected %#v", value, awsbase.MockStsAssumeRoleCredentials)
+	}
+
+	for _, action := range []string{"AssumeRoleWithWebIdentity", "AssumeRole"} {
+		if got := actions[action]; got != 1 {
+			t.Errorf("%s: got %d requests, expected 1", action, got)
+		}
+	}
+}
+
+func TestConfigCredentialsSharedConfig(t *testing.T) {
+	ssoStartURL := "https://d-123456789a.awsapps.com/start"
+	ssoAccessToken := "sso-access-token"
+
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/federation/credentials" || r.Header.Get("x-amz-sso_bearer_token") != ssoAccessToken {
+			w.WriteHeader(http.StatusUnauthorized)
+			w.Write([]byte(`{"message":"Session token not found or invalid"}`))
+			return
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		fmt.Fprintf(w, `{"roleCredentials":{"accessKeyId":"SSOAKID","secretAccessKey":"SSOSECRET","sessionToken":"SSOTOKEN","expiration":%d}}`, time.Now().Add(time.Hour).UnixNano()/int64(time.Millisecond))
+	}))
+	defer ts.Close()
+
+	dir := t.TempDir()
+
+	credentialProcess := filepath.Join(dir, "credential-process.sh")
+	writeTestFile(t, credentialProcess, `#!/bin/sh
+echo '{"Version":1,"AccessKeyId":"PROCESSAKID","SecretAccessKey":"PROCESSSECRET","SessionToken":"PROCESSTOKEN"}'
+`)
+
+	if err := os.Chmod(credentialProcess, 0700); err != nil {
+		t.Fatal(err)
+	}
+
+	configFile := filepath.Join(dir, "config")
+	writeTestFile(t, configFile, fmt.Sprintf(`
+[profile config]
+aws_access_key_id = CONFIGAKID
+aws_secret_access_key = CONFIGSECRET
+
+[profile both]
+aws_access_key_id = CONFIGAKID
+aws_secret_access_key = CONFIGSECRET
+
+[profile process]
+credential_process = %[1]s
+
+[profile sso]
+sso_start_url = %[2]s
+sso_region = us-east-1
+sso_account_id = 123456789012
+sso_role_name = TestRole
+
+[profile sso-expired]
+sso_start_url = https://d-987654321b.awsapps.com/start
+sso_region = us-east-1
+sso_account_id = 123456789012
+sso_role_name = TestRole
+`, credentialProcess, ssoStartURL))
+
+	credentialsFile1 := filepath.Join(dir, "credentials1")
+	writeTestFile(t, credentialsFile1, `
+[both]
+aws_access_key_id = CREDENTIALSAKID
+aws_secret_access_key = CREDENTIALSSECRET
+
+[multiple]
+aws_access_key_id = CREDENTIALS1AKID
+aws_secret_access_key = CREDENTIALS1SECRET
+`)
+
+	credentialsFile2 := filepath.Join(dir, "credentials2")
+	writeTestFile(t, credentialsFile2, `
+[default]
+aws_access_key_id = DEFAULTAKID
+aws_secret_access_key = DEFAULTSECRET
+
+[multiple]
+aws_access_key_id = CREDENTIALS2AKID
+aws_secret_access_key = CREDENTIALS2SECRET
+`)
+
+	// The AWS SDK reads the token cached by `aws sso login` from the home directory.
+	home := filepath.Join(dir, "home")
+	writeTestFile(t, filepath.Join(home, ".aws", "sso", "cache", ssoCacheFilename(ssoStartURL)), fmt.Sprintf(`{"accessToken":%q,"expiresAt":%q}`, ssoAccessToken, time.Now().Add(time.Hour).UTC().Format(time.RFC3339)))
+	writeTestFile(t, filepath.Join(home, ".aws", "sso", "cache", ssoCacheFilename("https://d-987654321b.awsapps.com/start")), fmt.Sprintf(`{"accessToken":%q,"expiresAt":%q}`, ssoAccessToken, time.Now().Add(-time.Hour).UTC().Format(time.RFC3339)))
+
+	// Profiles in the default shared configuration file are resolved when no files are configured.
+	writeTestFile(t, filepath.Join(home, ".aws", "config"), fmt.Sprintf(`
+[profile default-process]
+credential_process = %[1]s
+
+[profile default-sso]
+sso_start_url = %[2]s
+sso_region = us-east-1
+sso_account_id = 123456789012
+sso_role_name = TestRole
+`, credentialProcess, ssoStartURL))
+
+	testCases := []struct {
+		Name                string
+		Config              *Config
+		ExpectedCredentials credentials.Value
+		ExpectedError       bool
+	}{
+		{
+			Name: "config file",
+			Config: &Config{
+				Profile:           "config",
+				SharedConfigFiles: []string{configFile},
+			},
+			ExpectedCredentials: credentials.Value{AccessKeyID: "CONFIGAKID", SecretAccessKey: "CONFIGSECRET"},
+		},
+		{
+			Name: "credentials file",
+			Config: &Config{
+				SharedConfigFiles:      []string{configFile},
+				SharedCredentialsFiles: []string{credentialsFile2},
+			},
+			ExpectedCredentials: credentials.Value{AccessKeyID: "DEFAULTAKID", SecretAccessKey: "DEFAULTSECRET"},
+		},
+		{
+			Name: "credentials file takes precedence",
+			Config: &Config{
+				Profile:                "both",
+				SharedConfigFiles:      []string{configFile},
+				SharedCredentialsFiles: []string{credentialsFile1},
+			},
+			ExpectedCredentials: credentials.Value{AccessKeyID: "CREDENTIALSAKID", SecretAccessKey: "CREDENTIALSSECRET"},
+		},
+		{
+			Name: "later credentials file takes precedence",
+			Config: &Config{
+				Profile:                "multiple",
+				SharedConfigFiles:      []string{configFile},
+				SharedCredentialsFiles: []string{credentialsFile1, credentialsFile2},
+			},
+			ExpectedCredentials: credentials.Value{AccessKeyID: "CREDENTIALS2AKID", SecretAccessKey: "CREDENTIALS2SECRET"},
+		},
+		{
+			Name: "credential_process",
+			Config: &Config{
+				Profile:           "process",
+				SharedConfigFiles: []string{configFile},
+			},
+			ExpectedCredentials: credentials.Value{AccessKeyID: "PROCESSAKID", SecretAccessKey: "PROCESSSECRET", SessionToken: "PROCESSTOKEN"},
+		},
+		{
+			Name: "SSO",
+			Config: &Config{
+				Profile:           "sso",
+				SharedConfigFiles: []string{configFile},
+			},
+			ExpectedCredentials: credentials.Value{AccessKeyID: "SSOAKID", SecretAccessKey: "SSOSECRET", SessionToken: "SSOTOKEN"},
+		},
+		{
+			Name: "SSO expired token",
+			Config: &Config{
+				Profile:           "sso-expired",
+				SharedConfigFiles: []string{configFile},
+			},
+			ExpectedError: true,
+		},
+		{
+			Name: "credential_process default config file",
+			Config: &Config{
+				Profile: "default-process",
+			},
+			ExpectedCredentials: credentials.Value{AccessKeyID: "PROCESSAKID", SecretAccessKey: "PROCESSSECRET", SessionToken: "PROCESSTOKEN"},
+		},
+		{
+			Name: "SSO default config file",
+			Config: &Config{
+				Profile: "default-sso",
+			},
+			ExpectedCredentials: credentials.Value{AccessKeyID: "SSOAKID", SecretAccessKey: "SSOSECRET", SessionToken: "SSOTOKEN"},
+		},
+		{
+			Name: "static credentials take precedence",
+			Config: &Config{
+				AccessKey:         "STATICAKID",
+				Profile:           "process",
+				SecretKey:         "STATICSECRET",
+				SharedConfigFiles: []string{configFile},
+			},
+			ExpectedCredentials: credentials.Value{AccessKeyID: "STATICAKID", SecretAccessKey: "STATICSECRET"},
+		},
+		{
+			Name: "missing profile",
+			Config: &Config{
+				Profile:           "missing",
+				SharedConfigFiles: []string{configFile},
+			},
+			ExpectedError: true,
+		},
+	}
+
+	envVars := map[string]string{
+		"HOME": home,
+	}
+
+	for _, envVar := range []string{
+		EnvVarAccessKeyId,
+		EnvVarProfile,
+		EnvVarSecretAccessKey,
+		EnvVarSharedConfigFile,
+		EnvVarSharedCredentialsFile,
+		"AWS_SESSION_TOKEN",
+	} {
+		envVars[envVar] = ""
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			for envVar, value := range envVars {
+				if v, ok := os.LookupEnv(envVar); ok {
+					defer os.Setenv(envVar, v)
+				} else {
+					defer os.Unsetenv(envVar)
+				}
+
+				if value == "" {
+					os.Unsetenv(envVar)
+				} else {
+					os.Setenv(envVar, value)
+				}
+			}
+
+			testCase.Config.Endpoints = map[string]string{SSO: ts.URL}
+			testCase.Config.Region = "us-west-2" //lintignore:AWSAT003
+
+			creds, err := testCase.Config.credentials(&awsbase.Config{}, nil)
+
+			if testCase.ExpectedError {
+				if err == nil {
+					t.Fatal("expected error, got none")
+				}
+
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			value, err := creds.Get()
+
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			value.ProviderName = ""
+
+			if value != testCase.ExpectedCredentials {
+				t.Errorf("got %#v, expected %#v", value, testCase.ExpectedCredentials)
+			}
+		})
+	}
+}
+
+func writeTestFile(t *testing.T, filename, content string) {
+	t.Helper()
+
+	if err := os.MkdirAll(filepath.Dir(filename), 0700); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := os.WriteFile(filename, []byte(content), 0600); err != nil {
+		t.Fatal(err)
+	}
+}
+
+// ssoCacheFilename returns the name of the file `aws sso login` caches the token for a start URL in.
+func ssoCacheFilename(startURL string) string {
+	return fmt.Sprintf("%x.json", sha1.Sum([]byte(startURL)))
+}
@@ -29,6 +29,34 @@ const (
 	EnvVarSecretAccessKey = "AWS_SECRET_ACCESS_KEY"
 )
 
+// Standard AWS environment variables used in the Terraform AWS Provider configuration.
+// These are not provided as constants in the AWS Go SDK currently.
+const (
+	// Path to a custom certificate bundle (PEM) used to verify TLS connections
+	EnvVarCustomCABundle = "AWS_CA_BUNDLE"
+
+	// Custom EC2 Instance Metadata Service (IMDS) endpoint
+	EnvVarEC2MetadataServiceEndpoint = "AWS_EC2_METADATA_SERVICE_ENDPOINT"
+
+	// EC2 Instance Metadata Service (IMDS) endpoint mode, IPv4 or IPv6
+	EnvVarEC2MetadataServiceEndpointMode = "AWS_EC2_METADATA_SERVICE_ENDPOINT_MODE"
+
+	// Path to the shared configuration file
+	EnvVarSharedConfigFile = "AWS_CONFIG_FILE"
+
+	// Path to the shared credentials file
+	EnvVarSharedCredentialsFile = "AWS_SHARED_CREDENTIALS_FILE"
+
+	// Retry mode for AWS API requests: legacy, standard or adaptive
+	EnvVarRetryMode = "AWS_RETRY_MODE"
+
+	// Resolve dual-stack (IPv4 and IPv6) service endpoints
+	EnvVarUseDualStackEndpoint = "AWS_USE_DUALSTACK_ENDPOINT"
+
+	// Resolve FIPS 140-2 validated service endpoints
+	EnvVarUseFIPSEndpoint = "AWS_USE_FIPS_ENDPOINT"
+)
+
 // Custom environment variables used in the Terraform AWS Provider testing.
 // Additions should also be documented in the Environment Variable Dictionary
 // of the Maintainers Guide: docs/MAINTAINING.md
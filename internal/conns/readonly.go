@@ -0,0 +1,49 @@
+package conns
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/aws/request"
+)
+
+// ErrCodeReadOnlyOperationBlocked is the error code returned for API operations
+// that are blocked because the client is read-only.
+const ErrCodeReadOnlyOperationBlocked = "ReadOnlyOperationBlocked"
+
+// readOnlyOperationPrefixes are the API operation name prefixes considered not to mutate resources.
+var readOnlyOperationPrefixes = []string{
+	"BatchGet",
+	"Describe",
+	"Get",
+	"Head",
+	"List",
+	"Lookup",
+	"Query",
+	"Scan",
+	"Search",
+	"Select",
+}
+
+// readOnlyHandler fails any request for an API operation that may mutate resources.
+var readOnlyHandler = request.NamedHandler{
+	Name: "tfaws.ReadOnlyHandler",
+	Fn: func(r *request.Request) {
+		if isReadOnlyOperation(r.Operation.Name) {
+			return
+		}
+
+		r.Error = awserr.New(ErrCodeReadOnlyOperationBlocked, fmt.Sprintf("%s.%s blocked by read-only client", r.ClientInfo.ServiceName, r.Operation.Name), nil)
+	},
+}
+
+func isReadOnlyOperation(name string) bool {
+	for _, prefix := range readOnlyOperationPrefixes {
+		if strings.HasPrefix(name, prefix) {
+			return true
+		}
+	}
+
+	return false
+}
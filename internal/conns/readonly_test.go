@@ -0,0 +1,59 @@
+package conns
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/credentials"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
+)
+
+func TestIsReadOnlyOperation(t *testing.T) {
+	testCases := []struct {
+		Name     string
+		Expected bool
+	}{
+		{Name: "DescribeVpcs", Expected: true},
+		{Name: "GetBucketPolicy", Expected: true},
+		{Name: "ListRoles", Expected: true},
+		{Name: "HeadObject", Expected: true},
+		{Name: "DeleteVpc", Expected: false},
+		{Name: "CreateBucket", Expected: false},
+		{Name: "PutBucketPolicy", Expected: false},
+		{Name: "TerminateInstances", Expected: false},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			if got := isReadOnlyOperation(testCase.Name); got != testCase.Expected {
+				t.Errorf("got %t, expected %t", got, testCase.Expected)
+			}
+		})
+	}
+}
+
+func TestReadOnlyHandler(t *testing.T) {
+	sess := session.Must(session.NewSession(&aws.Config{
+		Credentials: credentials.NewStaticCredentials("AKID", "SECRET", ""),
+		Endpoint:    aws.String("http://127.0.0.1:0"),
+		MaxRetries:  aws.Int(0),
+		Region:      aws.String("us-east-1"),
+	}))
+	sess.Handlers.Validate.PushBackNamed(readOnlyHandler)
+
+	conn := ec2.New(sess)
+
+	_, err := conn.DeleteVpc(&ec2.DeleteVpcInput{VpcId: aws.String("vpc-12345678")})
+
+	if !tfawserr.ErrCodeEquals(err, ErrCodeReadOnlyOperationBlocked) {
+		t.Errorf("expected %s error, got: %v", ErrCodeReadOnlyOperationBlocked, err)
+	}
+
+	_, err = conn.DescribeVpcs(&ec2.DescribeVpcsInput{})
+
+	if tfawserr.ErrCodeEquals(err, ErrCodeReadOnlyOperationBlocked) {
+		t.Errorf("unexpected %s error", ErrCodeReadOnlyOperationBlocked)
+	}
+}
@@ -0,0 +1,413 @@
+package conns
+
+import (
+	"fmt"
+	"log"
+	"sync"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/client"
+	"github.com/aws/aws-sdk-go/aws/request"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
+)
+
+const (
+	RetryModeAdaptive = "adaptive"
+	RetryModeLegacy   = "legacy"
+	RetryModeStandard = "standard"
+)
+
+func RetryMode_Values() []string {
+	return []string{
+		RetryModeAdaptive,
+		RetryModeLegacy,
+		RetryModeStandard,
+	}
+}
+
+const (
+	// Maximum backoff between attempts in the standard and adaptive retry modes.
+	standardRetryMaxBackoff = 20 * time.Second
+
+	// Retry quota settings for the standard and adaptive retry modes.
+	// These match the defaults used by the other AWS SDKs.
+	retryQuotaCapacity    = 500
+	retryQuotaCost        = 5
+	retryQuotaTimeoutCost = 10
+	retryQuotaNoRetryCost = 1
+
+	// Adaptive rate limiting settings.
+	adaptiveRateBeta    = 0.7
+	adaptiveRateMinimum = 0.5
+	adaptiveRateWindow  = 500 * time.Millisecond
+)
+
+// retryHandler applies the provider's retry mode and client-side rate limits
+// to every service client created from a session.
+type retryHandler struct {
+	adaptive             bool
+	maxRequestsPerSecond map[string]float64 // Keyed by AWS service name
+	quota                bool
+
+	mu       sync.Mutex
+	services map[string]*serviceRetryState
+}
+
+// serviceRetryState holds the retry quota and rate limiter for a single service.
+type serviceRetryState struct {
+	bucket *tokenBucket
+	quota  *retryQuota
+}
+
+// configureRetries returns a copy of the session configured for the provider's
+// retry mode and per-service request rate limits.
+func (c *Config) configureRetries(sess *session.Session) (*session.Session, error) {
+	h := &retryHandler{
+		maxRequestsPerSecond: make(map[string]float64),
+		services:             make(map[string]*serviceRetryState),
+	}
+
+	for serviceKey, v := range c.MaxRequestsPerSecond {
+		serviceDatum, ok := serviceData[serviceKey]
+
+		if !ok {
+			return nil, fmt.Errorf("no service data found for %s", serviceKey)
+		}
+
+		h.maxRequestsPerSecond[serviceDatum.AWSServiceName] = v
+	}
+
+	switch c.RetryMode {
+	case "", RetryModeLegacy:
+	case RetryModeStandard:
+		h.quota = true
+	case RetryModeAdaptive:
+		h.adaptive = true
+		h.quota = true
+	default:
+		return nil, fmt.Errorf("unsupported retry mode: %s", c.RetryMode)
+	}
+
+	if !h.quota && len(h.maxRequestsPerSecond) == 0 {
+		return sess, nil
+	}
+
+	config := &aws.Config{}
+
+	if h.quota {
+		config.Retryer = client.DefaultRetryer{
+			NumMaxRetries:    c.MaxRetries,
+			MaxRetryDelay:    standardRetryMaxBackoff,
+			MaxThrottleDelay: standardRetryMaxBackoff,
+		}
+	}
+
+	sess = sess.Copy(config)
+
+	// Service clients copy the session handlers, so these run for every service.
+	// Waiting before signing (rather than sending) ensures a request is not sent with a stale signature.
+	sess.Handlers.Sign.PushFrontNamed(request.NamedHandler{Name: "terraform-provider-aws.RateLimit", Fn: h.rateLimit})
+	sess.Handlers.CompleteAttempt.PushBackNamed(request.NamedHandler{Name: "terraform-provider-aws.CompleteAttempt", Fn: h.completeAttempt})
+	sess.Handlers.AfterRetry.PushFrontNamed(request.NamedHandler{Name: "terraform-provider-aws.RetryQuota", Fn: h.checkRetryQuota})
+
+	log.Printf("[INFO] Retry mode: %q, per-service request rate limits: %v", c.RetryMode, h.maxRequestsPerSecond)
+
+	return sess, nil
+}
+
+// service returns the retry state for the specified AWS service, creating it if necessary.
+func (h *retryHandler) service(name string) *serviceRetryState {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	if v, ok := h.services[name]; ok {
+		return v
+	}
+
+	state := &serviceRetryState{}
+
+	if h.quota {
+		state.quota = newRetryQuota(retryQuotaCapacity)
+	}
+
+	if maxRate := h.maxRequestsPerSecond[name]; maxRate > 0 || h.adaptive {
+		state.bucket = newTokenBucket(maxRate, h.adaptive)
+	}
+
+	h.services[name] = state
+
+	return state
+}
+
+func (h *retryHandler) rateLimit(r *request.Request) {
+	bucket := h.service(r.ClientInfo.ServiceName).bucket
+
+	if bucket == nil {
+		return
+	}
+
+	if err := bucket.wait(r.Context()); err != nil {
+		r.Error = err
+	}
+}
+
+func (h *retryHandler) completeAttempt(r *request.Request) {
+	state := h.service(r.ClientInfo.ServiceName)
+
+	if r.Error == nil {
+		if state.quota != nil {
+			state.quota.release(r.RetryCount > 0)
+		}
+
+		if state.bucket != nil {
+			state.bucket.succeeded()
+		}
+
+		return
+	}
+
+	if state.bucket != nil && r.IsErrorThrottle() {
+		state.bucket.throttled()
+	}
+}
+
+// checkRetryQuota runs before the SDK's core AfterRetry handler, after any service
+// specific retry handlers have determined whether the request is retryable.
+// Retries are denied once the quota for the service is exhausted.
+func (h *retryHandler) checkRetryQuota(r *request.Request) {
+	quota := h.service(r.ClientInfo.ServiceName).quota
+
+	if quota == nil {
+		return
+	}
+
+	retryable := r.ShouldRetry(r)
+
+	if r.Retryable != nil {
+		retryable = aws.BoolValue(r.Retryable)
+	}
+
+	if !retryable || r.RetryCount >= r.MaxRetries() {
+		return
+	}
+
+	cost := retryQuotaCost
+
+	if tfawserr.ErrCodeEquals(r.Error, request.ErrCodeResponseTimeout) {
+		cost = retryQuotaTimeoutCost
+	}
+
+	if !quota.acquire(cost) {
+		log.Printf("[WARN] Retry quota exhausted for %s, not retrying %s", r.ClientInfo.ServiceName, r.Operation.Name)
+		r.Retryable = aws.Bool(false)
+		return
+	}
+
+	r.Retryable = aws.Bool(true)
+}
+
+// retryQuota limits the number of retries made while a service is failing.
+// Successful retries refund the cost of the most recent retry made against the
+// quota rather than tracking the cost per request.
+type retryQuota struct {
+	mu        sync.Mutex
+	available int
+	capacity  int
+	lastCost  int
+}
+
+func newRetryQuota(capacity int) *retryQuota {
+	return &retryQuota{
+		available: capacity,
+		capacity:  capacity,
+	}
+}
+
+// acquire attempts to take the specified cost from the quota.
+func (q *retryQuota) acquire(cost int) bool {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+
+	if cost > q.available {
+		return false
+	}
+
+	q.available -= cost
+	q.lastCost = cost
+
+	return true
+}
+
+// release returns capacity to the quota following a successful request.
+func (q *retryQuota) release(retried bool) {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+
+	cost := retryQuotaNoRetryCost
+
+	if retried {
+		cost = q.lastCost
+	}
+
+	q.available += cost
+
+	if q.available > q.capacity {
+		q.available = q.capacity
+	}
+}
+
+// tokenBucket is a client-side request rate limiter.
+//
+// A fixed bucket always limits requests to its maximum rate.
+// An adaptive bucket starts limiting requests when the service first throttles,
+// reducing the rate multiplicatively on each throttling error and increasing it
+// by roughly one request per second each second while requests succeed.
+type tokenBucket struct {
+	mu sync.Mutex
+
+	adaptive bool
+	enabled  bool
+	maxRate  float64 // Zero for no maximum
+	rate     float64
+	tokens   float64
+	last     time.Time
+
+	measuredRate float64
+	requests     int
+	windowStart  time.Time
+}
+
+func newTokenBucket(maxRate float64, adaptive bool) *tokenBucket {
+	now := time.Now()
+
+	return &tokenBucket{
+		adaptive:    adaptive,
+		enabled:     maxRate > 0,
+		maxRate:     maxRate,
+		rate:        maxRate,
+		tokens:      capacity(maxRate),
+		last:        now,
+		windowStart: now,
+	}
+}
+
+// wait blocks until a request can be made or the context is cancelled.
+func (b *tokenBucket) wait(ctx aws.Context) error {
+	for {
+		delay := b.take()
+
+		if delay == 0 {
+			return nil
+		}
+
+		if err := aws.SleepWithContext(ctx, delay); err != nil {
+			return err
+		}
+	}
+}
+
+// take consumes a token, returning zero, or returns how long to wait for one.
+func (b *tokenBucket) take() time.Duration {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	now := time.Now()
+
+	if !b.enabled {
+		b.measure(now)
+		return 0
+	}
+
+	b.tokens += now.Sub(b.last).Seconds() * b.rate
+	b.last = now
+
+	if max := capacity(b.rate); b.tokens > max {
+		b.tokens = max
+	}
+
+	if b.tokens >= 1 {
+		b.tokens--
+		b.measure(now)
+		return 0
+	}
+
+	return time.Duration((1 - b.tokens) / b.rate * float64(time.Second))
+}
+
+// measure records a request, updating the smoothed measured request rate.
+func (b *tokenBucket) measure(now time.Time) {
+	b.requests++
+
+	if elapsed := now.Sub(b.windowStart); elapsed >= adaptiveRateWindow {
+		b.measuredRate = 0.8*float64(b.requests)/elapsed.Seconds() + 0.2*b.measuredRate
+		b.requests = 0
+		b.windowStart = now
+	}
+}
+
+func (b *tokenBucket) throttled() {
+	if !b.adaptive {
+		return
+	}
+
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	rate := b.measuredRate
+
+	if b.enabled && (rate == 0 || b.rate < rate) {
+		rate = b.rate
+	}
+
+	rate *= adaptiveRateBeta
+
+	if rate < adaptiveRateMinimum {
+		rate = adaptiveRateMinimum
+	}
+
+	if !b.enabled {
+		b.enabled = true
+		b.last = time.Now()
+		b.tokens = 0
+	}
+
+	b.setRate(rate)
+}
+
+func (b *tokenBucket) succeeded() {
+	if !b.adaptive {
+		return
+	}
+
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	if !b.enabled {
+		return
+	}
+
+	b.setRate(b.rate + 1/b.rate)
+}
+
+func (b *tokenBucket) setRate(rate float64) {
+	if b.maxRate > 0 && rate > b.maxRate {
+		rate = b.maxRate
+	}
+
+	b.rate = rate
+
+	if max := capacity(rate); b.tokens > max {
+		b.tokens = max
+	}
+}
+
+// capacity returns the burst size for a request rate.
+func capacity(rate float64) float64 {
+	if rate < 1 {
+		return 1
+	}
+
+	return rate
+}
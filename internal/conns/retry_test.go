@@ -0,0 +1,200 @@
+package conns
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/credentials"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/aws-sdk-go/service/sts"
+)
+
+func TestRetryQuota(t *testing.T) {
+	quota := newRetryQuota(12)
+
+	if !quota.acquire(retryQuotaCost) {
+		t.Fatal("expected first acquire to succeed")
+	}
+
+	if !quota.acquire(retryQuotaCost) {
+		t.Fatal("expected second acquire to succeed")
+	}
+
+	if quota.acquire(retryQuotaCost) {
+		t.Fatal("expected third acquire to fail")
+	}
+
+	quota.release(true)
+
+	if got, expected := quota.available, 7; got != expected {
+		t.Errorf("got %d available, expected %d", got, expected)
+	}
+
+	for i := 0; i < 10; i++ {
+		quota.release(false)
+	}
+
+	if got, expected := quota.available, 12; got != expected {
+		t.Errorf("got %d available, expected %d", got, expected)
+	}
+}
+
+func TestTokenBucketFixed(t *testing.T) {
+	bucket := newTokenBucket(2, false)
+
+	for i := 0; i < 2; i++ {
+		if delay := bucket.take(); delay != 0 {
+			t.Fatalf("request %d: got delay %s, expected none", i, delay)
+		}
+	}
+
+	if delay := bucket.take(); delay == 0 {
+		t.Fatal("expected delay once burst capacity is used")
+	}
+
+	bucket.throttled()
+
+	if got, expected := bucket.rate, 2.0; got != expected {
+		t.Errorf("got rate %f, expected %f", got, expected)
+	}
+}
+
+func TestTokenBucketAdaptive(t *testing.T) {
+	bucket := newTokenBucket(0, true)
+
+	for i := 0; i < 100; i++ {
+		if delay := bucket.take(); delay != 0 {
+			t.Fatalf("request %d: got delay %s before throttling, expected none", i, delay)
+		}
+	}
+
+	bucket.throttled()
+
+	if !bucket.enabled {
+		t.Fatal("expected rate limiting after throttling")
+	}
+
+	if bucket.rate < adaptiveRateMinimum {
+		t.Errorf("got rate %f, expected at least %f", bucket.rate, adaptiveRateMinimum)
+	}
+
+	rate := bucket.rate
+	bucket.throttled()
+
+	if bucket.rate >= rate && rate > adaptiveRateMinimum {
+		t.Errorf("got rate %f after throttling, expected less than %f", bucket.rate, rate)
+	}
+
+	rate = bucket.rate
+	bucket.succeeded()
+
+	if bucket.rate <= rate {
+		t.Errorf("got rate %f after success, expected more than %f", bucket.rate, rate)
+	}
+}
+
+func TestTokenBucketAdaptiveMaximum(t *testing.T) {
+	bucket := newTokenBucket(5, true)
+
+	for i := 0; i < 100; i++ {
+		bucket.succeeded()
+	}
+
+	if got, expected := bucket.rate, 5.0; got != expected {
+		t.Errorf("got rate %f, expected %f", got, expected)
+	}
+}
+
+func TestConfigConfigureRetries(t *testing.T) {
+	testCases := []struct {
+		Name             string
+		Config           *Config
+		ExpectedRequests int32
+		ExpectedError    bool
+	}{
+		{
+			Name:             "legacy",
+			Config:           &Config{MaxRetries: 3, RetryMode: RetryModeLegacy},
+			ExpectedRequests: 4,
+		},
+		{
+			Name:             "standard",
+			Config:           &Config{MaxRetries: 3, RetryMode: RetryModeStandard},
+			ExpectedRequests: 4,
+		},
+		{
+			Name:             "adaptive",
+			Config:           &Config{MaxRetries: 1, RetryMode: RetryModeAdaptive},
+			ExpectedRequests: 2,
+		},
+		{
+			Name:             "max requests per second",
+			Config:           &Config{MaxRetries: 3, MaxRequestsPerSecond: map[string]float64{STS: 100}},
+			ExpectedRequests: 4,
+		},
+		{
+			Name:          "invalid retry mode",
+			Config:        &Config{RetryMode: "invalid"},
+			ExpectedError: true,
+		},
+		{
+			Name:          "invalid service",
+			Config:        &Config{MaxRequestsPerSecond: map[string]float64{"invalid": 1}},
+			ExpectedError: true,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			var requests int32
+
+			ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				atomic.AddInt32(&requests, 1)
+				w.Header().Set("Content-Type", "text/xml")
+				w.WriteHeader(http.StatusBadRequest)
+				w.Write([]byte(`<ErrorResponse><Error><Type>Sender</Type><Code>Throttling</Code><Message>Rate exceeded</Message></Error></ErrorResponse>`))
+			}))
+			defer ts.Close()
+
+			sess, err := session.NewSession(&aws.Config{
+				Credentials: credentials.NewStaticCredentials("AKID", "SECRET", ""),
+				Endpoint:    aws.String(ts.URL),
+				MaxRetries:  aws.Int(testCase.Config.MaxRetries),
+				Region:      aws.String("us-east-1"), //lintignore:AWSAT003
+				SleepDelay:  func(time.Duration) {},
+			})
+
+			if err != nil {
+				t.Fatalf("error creating session: %s", err)
+			}
+
+			sess, err = testCase.Config.configureRetries(sess)
+
+			if testCase.ExpectedError {
+				if err == nil {
+					t.Fatal("expected error, got none")
+				}
+
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			_, err = sts.New(sess).GetCallerIdentity(&sts.GetCallerIdentityInput{})
+
+			if err == nil {
+				t.Fatal("expected error, got none")
+			}
+
+			if got := atomic.LoadInt32(&requests); got != testCase.ExpectedRequests {
+				t.Errorf("got %d requests, expected %d", got, testCase.ExpectedRequests)
+			}
+		})
+	}
+}
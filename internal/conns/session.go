@@ -0,0 +1,228 @@
+package conns
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"fmt"
+	"log"
+	"net/http"
+	"net/url"
+	"os"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/arn"
+	"github.com/aws/aws-sdk-go/aws/credentials"
+	"github.com/aws/aws-sdk-go/aws/endpoints"
+	"github.com/aws/aws-sdk-go/aws/request"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/aws-sdk-go/service/iam"
+	"github.com/aws/aws-sdk-go/service/sts"
+	awsbase "github.com/hashicorp/aws-sdk-go-base"
+	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
+	"github.com/hashicorp/go-cleanhttp"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/logging"
+	"github.com/mitchellh/go-homedir"
+)
+
+// httpClient returns the HTTP client used for every AWS API request made by the
+// provider, including those made while resolving and validating credentials.
+// It honours the http_proxy, insecure and custom_ca_bundle arguments.
+func (c *Config) httpClient() (*http.Client, error) {
+	client := cleanhttp.DefaultClient()
+	transport := client.Transport.(*http.Transport)
+
+	if c.Insecure || c.CustomCABundle != "" {
+		transport.TLSClientConfig = &tls.Config{
+			InsecureSkipVerify: c.Insecure,
+		}
+	}
+
+	if c.CustomCABundle != "" {
+		filename, err := homedir.Expand(c.CustomCABundle)
+
+		if err != nil {
+			return nil, fmt.Errorf("error expanding custom CA bundle filename: %w", err)
+		}
+
+		pem, err := os.ReadFile(filename)
+
+		if err != nil {
+			return nil, fmt.Errorf("error reading custom CA bundle: %w", err)
+		}
+
+		pool := x509.NewCertPool()
+
+		if !pool.AppendCertsFromPEM(pem) {
+			return nil, fmt.Errorf("error loading custom CA bundle (%s): no PEM certificates found", filename)
+		}
+
+		transport.TLSClientConfig.RootCAs = pool
+	}
+
+	if c.HTTPProxy != "" {
+		proxyURL, err := url.Parse(c.HTTPProxy)
+
+		if err != nil {
+			return nil, fmt.Errorf("error parsing HTTP proxy URL: %w", err)
+		}
+
+		transport.Proxy = http.ProxyURL(proxyURL)
+	}
+
+	return client, nil
+}
+
+// setEC2MetadataServiceOptions sets the configured EC2 metadata service endpoint
+// and endpoint mode on the specified session options.
+func (c *Config) setEC2MetadataServiceOptions(options *session.Options) error {
+	options.EC2IMDSEndpoint = c.EC2MetadataServiceEndpoint
+
+	if c.EC2MetadataServiceEndpointMode != "" {
+		if err := options.EC2IMDSEndpointMode.SetFromString(c.EC2MetadataServiceEndpointMode); err != nil {
+			return fmt.Errorf("error setting EC2 metadata service endpoint mode: %w", err)
+		}
+	}
+
+	return nil
+}
+
+// newSession returns the session from which all service client sessions are copied,
+// along with the caller's AWS account ID and partition.
+// Every session created by the provider, including those used to resolve and
+// validate credentials, shares the provider's HTTP client and EC2 metadata service settings.
+func (c *Config) newSession(awsbaseConfig *awsbase.Config) (*session.Session, string, string, error) {
+	if c.SkipMetadataApiCheck {
+		os.Setenv("AWS_EC2_METADATA_DISABLED", "true")
+	}
+
+	httpClient, err := c.httpClient()
+
+	if err != nil {
+		return nil, "", "", err
+	}
+
+	creds, err := c.credentials(awsbaseConfig, httpClient)
+
+	if err != nil {
+		return nil, "", "", err
+	}
+
+	options := session.Options{
+		Config: aws.Config{
+			CredentialsChainVerboseErrors: aws.Bool(true),
+			Credentials:                   creds,
+			EndpointResolver:              awsbaseConfig.EndpointResolver(),
+			HTTPClient:                    httpClient,
+			MaxRetries:                    aws.Int(0),
+			Region:                        aws.String(c.Region),
+		},
+		Profile:           c.Profile,
+		SharedConfigState: session.SharedConfigEnable,
+	}
+
+	// All service client sessions are copied from this session so that FIPS and
+	// dual-stack endpoint resolution apply everywhere, including to credentials validation.
+	// Explicitly configured endpoints are used as-is and are not affected by these settings.
+	options.Config.MergeIn(c.endpointResolutionConfig())
+
+	if logging.IsDebugOrHigher() {
+		options.Config.LogLevel = aws.LogLevel(aws.LogDebugWithHTTPBody | aws.LogDebugWithRequestRetries | aws.LogDebugWithRequestErrors)
+		options.Config.Logger = awsbase.DebugLogger{}
+	}
+
+	if err := c.setEC2MetadataServiceOptions(&options); err != nil {
+		return nil, "", "", err
+	}
+
+	sess, err := session.NewSessionWithOptions(options)
+
+	if err != nil {
+		return nil, "", "", fmt.Errorf("error creating AWS session: %w", err)
+	}
+
+	if c.MaxRetries > 0 {
+		sess = sess.Copy(&aws.Config{MaxRetries: aws.Int(c.MaxRetries)})
+	}
+
+	// Configured User-Agent products take precedence over the product added by the AWS SDK,
+	// so push them to the front of the build handlers in reverse order.
+	for i := len(awsbaseConfig.UserAgentProducts) - 1; i >= 0; i-- {
+		product := awsbaseConfig.UserAgentProducts[i]
+		sess.Handlers.Build.PushFront(request.MakeAddToUserAgentHandler(product.Name, product.Version, product.Extra...))
+	}
+
+	if v := os.Getenv(awsbase.AppendUserAgentEnvVar); v != "" {
+		log.Printf("[DEBUG] Using additional User-Agent Info: %s", v)
+		sess.Handlers.Build.PushBack(request.MakeAddToUserAgentFreeFormHandler(v))
+	}
+
+	// Fail fast on networking errors, such as a non-existent service endpoint,
+	// that would otherwise be retried up to the session's retry threshold.
+	sess.Handlers.Retry.PushBack(func(r *request.Request) {
+		if r.RetryCount < awsbase.MaxNetworkRetryCount {
+			return
+		}
+
+		if tfawserr.ErrMessageAndOrigErrContain(r.Error, request.ErrCodeRequestError, "send request failed", "no such host") ||
+			tfawserr.ErrMessageAndOrigErrContain(r.Error, request.ErrCodeRequestError, "send request failed", "connection refused") {
+			log.Printf("[WARN] Disabling retries after next request due to networking issue")
+			r.Retryable = aws.Bool(false)
+		}
+	})
+
+	accountID, partition, err := c.accountIDAndPartition(sess, creds)
+
+	if err != nil {
+		return nil, "", "", err
+	}
+
+	return sess, accountID, partition, nil
+}
+
+// accountIDAndPartition validates the session's credentials, unless skip_credentials_validation is set,
+// and returns the caller's AWS account ID and partition.
+func (c *Config) accountIDAndPartition(sess *session.Session, creds *credentials.Credentials) (string, string, error) {
+	stsConn := sts.New(sess)
+
+	if !c.SkipCredsValidation {
+		accountID, partition, err := awsbase.GetAccountIDAndPartitionFromSTSGetCallerIdentity(stsConn)
+
+		if err != nil {
+			return "", "", fmt.Errorf("error validating provider credentials: %w", err)
+		}
+
+		return accountID, partition, nil
+	}
+
+	if c.AssumeRoleARN != "" {
+		if v, err := arn.Parse(c.AssumeRoleARN); err == nil {
+			return v.AccountID, v.Partition, nil
+		}
+	}
+
+	if !c.SkipRequestingAccountId {
+		credentialsProviderName := ""
+
+		if value, err := creds.Get(); err == nil {
+			credentialsProviderName = value.ProviderName
+		}
+
+		accountID, partition, err := awsbase.GetAccountIDAndPartition(iam.New(sess), stsConn, credentialsProviderName)
+
+		if err != nil {
+			return "", "", fmt.Errorf(
+				"AWS account ID not previously found and failed retrieving via all available methods. "+
+					"See https://www.terraform.io/docs/providers/aws/index.html#skip_requesting_account_id for workaround and implications. "+
+					"Errors: %w", err)
+		}
+
+		return accountID, partition, nil
+	}
+
+	var partition string
+	if p, ok := endpoints.PartitionForRegion(endpoints.DefaultPartitions(), c.Region); ok {
+		partition = p.ID()
+	}
+
+	return "", partition, nil
+}
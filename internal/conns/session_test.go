@@ -0,0 +1,228 @@
+package conns
+
+import (
+	"encoding/pem"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"sync"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws/endpoints"
+	"github.com/aws/aws-sdk-go/aws/session"
+	awsbase "github.com/hashicorp/aws-sdk-go-base"
+)
+
+func TestConfigHTTPClient(t *testing.T) {
+	ts := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {}))
+	defer ts.Close()
+
+	caBundle := filepath.Join(t.TempDir(), "ca-bundle.pem")
+
+	if err := os.WriteFile(caBundle, pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: ts.Certificate().Raw}), 0600); err != nil {
+		t.Fatal(err)
+	}
+
+	invalidCABundle := filepath.Join(t.TempDir(), "invalid.pem")
+
+	if err := os.WriteFile(invalidCABundle, []byte("not a certificate"), 0600); err != nil {
+		t.Fatal(err)
+	}
+
+	testCases := []struct {
+		Name                string
+		Config              *Config
+		ExpectedError       bool
+		ExpectedRequestFail bool
+	}{
+		{
+			Name:                "default",
+			Config:              &Config{},
+			ExpectedRequestFail: true,
+		},
+		{
+			Name: "custom CA bundle",
+			Config: &Config{
+				CustomCABundle: caBundle,
+			},
+		},
+		{
+			Name: "insecure",
+			Config: &Config{
+				Insecure: true,
+			},
+		},
+		{
+			Name: "missing CA bundle",
+			Config: &Config{
+				CustomCABundle: filepath.Join(t.TempDir(), "missing.pem"),
+			},
+			ExpectedError: true,
+		},
+		{
+			Name: "invalid CA bundle",
+			Config: &Config{
+				CustomCABundle: invalidCABundle,
+			},
+			ExpectedError: true,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			client, err := testCase.Config.httpClient()
+
+			if testCase.ExpectedError {
+				if err == nil {
+					t.Fatal("expected error, got none")
+				}
+
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			resp, err := client.Get(ts.URL)
+
+			if testCase.ExpectedRequestFail {
+				if err == nil {
+					t.Fatal("expected certificate verification error, got none")
+				}
+
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			resp.Body.Close()
+		})
+	}
+}
+
+func TestConfigSetEC2MetadataServiceOptions(t *testing.T) {
+	testCases := []struct {
+		Name                 string
+		Config               *Config
+		ExpectedEndpoint     string
+		ExpectedEndpointMode endpoints.EC2IMDSEndpointModeState
+		ExpectedError        bool
+	}{
+		{
+			Name:                 "empty",
+			Config:               &Config{},
+			ExpectedEndpointMode: endpoints.EC2IMDSEndpointModeStateUnset,
+		},
+		{
+			Name: "IPv6",
+			Config: &Config{
+				EC2MetadataServiceEndpoint:     "http://[fd00:ec2::254]",
+				EC2MetadataServiceEndpointMode: EC2MetadataServiceEndpointModeIPv6,
+			},
+			ExpectedEndpoint:     "http://[fd00:ec2::254]",
+			ExpectedEndpointMode: endpoints.EC2IMDSEndpointModeStateIPv6,
+		},
+		{
+			Name: "invalid mode",
+			Config: &Config{
+				EC2MetadataServiceEndpointMode: "IPv5",
+			},
+			ExpectedError: true,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			var options session.Options
+
+			err := testCase.Config.setEC2MetadataServiceOptions(&options)
+
+			if testCase.ExpectedError {
+				if err == nil {
+					t.Fatal("expected error, got none")
+				}
+
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if got, expected := options.EC2IMDSEndpoint, testCase.ExpectedEndpoint; got != expected {
+				t.Errorf("got endpoint %q, expected %q", got, expected)
+			}
+
+			if got, expected := options.EC2IMDSEndpointMode, testCase.ExpectedEndpointMode; got != expected {
+				t.Errorf("got endpoint mode %v, expected %v", got, expected)
+			}
+		})
+	}
+}
+
+func TestConfigNewSessionEndpointResolution(t *testing.T) {
+	testCases := []struct {
+		Name         string
+		Config       *Config
+		ExpectedHost string
+	}{
+		{
+			Name: "default",
+			Config: &Config{
+				Region: "us-west-2",
+			},
+			ExpectedHost: "sts.amazonaws.com:443",
+		},
+		{
+			Name: "FIPS",
+			Config: &Config{
+				Region:          "us-west-2",
+				UseFIPSEndpoint: true,
+			},
+			ExpectedHost: "sts-fips.us-west-2.amazonaws.com:443",
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			var mu sync.Mutex
+			var hosts []string
+
+			// Record the host of every tunnelled request and refuse it, which fails credentials validation.
+			ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				mu.Lock()
+				hosts = append(hosts, r.Host)
+				mu.Unlock()
+
+				w.WriteHeader(http.StatusForbidden)
+			}))
+			defer ts.Close()
+
+			testCase.Config.AccessKey = "AKID"
+			testCase.Config.SecretKey = "SECRET"
+			testCase.Config.HTTPProxy = ts.URL
+			testCase.Config.MaxRetries = 0
+
+			if _, _, _, err := testCase.Config.newSession(&awsbase.Config{}); err == nil {
+				t.Fatal("expected error, got none")
+			}
+
+			mu.Lock()
+			defer mu.Unlock()
+
+			if len(hosts) == 0 {
+				t.Fatal("expected credentials validation request, got none")
+			}
+
+			for _, host := range hosts {
+				if host != testCase.ExpectedHost {
+					t.Errorf("got credentials validation request to %q, expected %q", host, testCase.ExpectedHost)
+				}
+			}
+		})
+	}
+}
@@ -18,12 +18,13 @@ import (
 const filename = `tags_gen.go`
 
 var (
-	getTag             = flag.Bool("GetTag", false, "whether to generate GetTag")
-	listTags           = flag.Bool("ListTags", false, "whether to generate ListTags")
-	serviceTagsMap     = flag.Bool("ServiceTagsMap", false, "whether to generate service tags for map")
-	serviceTagsSlice   = flag.Bool("ServiceTagsSlice", false, "whether to generate service tags for slice")
-	untagInNeedTagType = flag.Bool("UntagInNeedTagType", false, "whether Untag input needs tag type")
-	updateTags         = flag.Bool("UpdateTags", false, "whether to generate UpdateTags")
+	getTag                    = flag.Bool("GetTag", false, "whether to generate GetTag")
+	listTags                  = flag.Bool("ListTags", false, "whether to generate ListTags")
+	serviceTagsMap            = flag.Bool("ServiceTagsMap", false, "whether to generate service tags for map")
+	serviceTagsSlice          = flag.Bool("ServiceTagsSlice", false, "whether to generate service tags for slice")
+	untagInNeedTagType        = flag.Bool("UntagInNeedTagType", false, "whether Untag input needs tag type")
+	updateTags                = flag.Bool("UpdateTags", false, "whether to generate UpdateTags")
+	updateTagsCaseInsensitive = flag.Bool("UpdateTagsCaseInsensitive", false, "whether UpdateTags compares tag keys case-insensitively")
 
 	listTagsInFiltIDName  = flag.String("ListTagsInFiltIDName", "", "listTagsInFiltIDName")
 	listTagsInIDElem      = flag.String("ListTagsInIDElem", "ResourceArn", "listTagsInIDElem")
@@ -94,6 +95,7 @@ type TemplateData struct {
 	UntagInNeedTagType      bool
 	UntagInTagsElem         string
 	UntagOp                 string
+	UpdateTagsFold          bool
 
 	// The following are specific to writing import paths in the `headerBody`;
 	// to include the package, set the corresponding field's value to true
@@ -167,6 +169,7 @@ func main() {
 		UntagInNeedTagType:      *untagInNeedTagType,
 		UntagInTagsElem:         *untagInTagsElem,
 		UntagOp:                 *untagOp,
+		UpdateTagsFold:          *updateTagsCaseInsensitive,
 	}
 
 	if *getTag || *listTags || *serviceTagsMap || *serviceTagsSlice || *updateTags {
@@ -659,8 +662,8 @@ func UpdateTags(conn {{ .ClientType }}, identifier string{{ if .TagResTypeElem }
 	newTags := tftags.New(newTagsMap)
 {{- end }}
 	{{- if eq (.TagOp) (.UntagOp) }}
-	removedTags := oldTags.Removed(newTags)
-	updatedTags := oldTags.Updated(newTags)
+	removedTags := oldTags.Removed{{ if .UpdateTagsFold }}Fold{{ end }}(newTags)
+	updatedTags := oldTags.Updated{{ if .UpdateTagsFold }}Fold{{ end }}(newTags)
 
 	// Ensure we do not send empty requests
 	if len(removedTags) == 0 && len(updatedTags) == 0 {
@@ -704,7 +707,7 @@ func UpdateTags(conn {{ .ClientType }}, identifier string{{ if .TagResTypeElem }
 
 	{{- else }}
 
-	if removedTags := oldTags.Removed(newTags); len(removedTags) > 0 {
+	if removedTags := oldTags.Removed{{ if .UpdateTagsFold }}Fold{{ end }}(newTags); len(removedTags) > 0 {
 		{{- if .TagOpBatchSize }}
 		for _, removedTags := range removedTags.Chunks({{ .TagOpBatchSize }}) {
 		{{- end }}
@@ -740,7 +743,7 @@ func UpdateTags(conn {{ .ClientType }}, identifier string{{ if .TagResTypeElem }
 		{{- end }}
 	}
 
-	if updatedTags := oldTags.Updated(newTags); len(updatedTags) > 0 {
+	if updatedTags := oldTags.Updated{{ if .UpdateTagsFold }}Fold{{ end }}(newTags); len(updatedTags) > 0 {
 		{{- if .TagOpBatchSize }}
 		for _, updatedTags := range updatedTags.Chunks({{ .TagOpBatchSize }}) {
 		{{- end }}
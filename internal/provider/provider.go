@@ -1,9 +1,12 @@
 package provider
 
 import (
+	"context"
 	"fmt"
 	"log"
 	"regexp"
+	"sort"
+	"time"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
@@ -193,11 +196,30 @@ func Provider() *schema.Provider {
 
 			"assume_role": assumeRoleSchema(),
 
-			"shared_credentials_file": {
-				Type:        schema.TypeString,
+			"assume_role_with_web_identity": assumeRoleWithWebIdentitySchema(),
+
+			"shared_config_files": {
+				Type:        schema.TypeList,
 				Optional:    true,
-				Default:     "",
-				Description: descriptions["shared_credentials_file"],
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: descriptions["shared_config_files"],
+			},
+
+			"shared_credentials_file": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				Default:       "",
+				Description:   descriptions["shared_credentials_file"],
+				Deprecated:    "Use shared_credentials_files instead.",
+				ConflictsWith: []string{"shared_credentials_files"},
+			},
+
+			"shared_credentials_files": {
+				Type:          schema.TypeList,
+				Optional:      true,
+				Elem:          &schema.Schema{Type: schema.TypeString},
+				Description:   descriptions["shared_credentials_files"],
+				ConflictsWith: []string{"shared_credentials_file"},
 			},
 
 			"token": {
@@ -225,6 +247,16 @@ func Provider() *schema.Provider {
 				Description: descriptions["max_retries"],
 			},
 
+			"max_requests_per_second": maxRequestsPerSecondSchema(),
+
+			"retry_mode": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				DefaultFunc:  schema.EnvDefaultFunc(conns.EnvVarRetryMode, conns.RetryModeLegacy),
+				Description:  descriptions["retry_mode"],
+				ValidateFunc: validation.StringInSlice(conns.RetryMode_Values(), false),
+			},
+
 			"allowed_account_ids": {
 				Type:          schema.TypeSet,
 				Elem:          &schema.Schema{Type: schema.TypeString},
@@ -254,10 +286,40 @@ func Provider() *schema.Provider {
 							Elem:        &schema.Schema{Type: schema.TypeString},
 							Description: "Resource tags to default across all resources",
 						},
+						"tags_all_precedence": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							Default:      tftags.TagsAllPrecedenceResource,
+							Description:  "Whether resource tags override default tags with matching keys (`resource`) or conflicting resource tags are rejected (`provider`)",
+							ValidateFunc: validation.StringInSlice(tftags.TagsAllPrecedence_Values(), false),
+						},
 					},
 				},
 			},
 
+			"custom_ca_bundle": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				DefaultFunc: schema.EnvDefaultFunc(conns.EnvVarCustomCABundle, ""),
+				Description: descriptions["custom_ca_bundle"],
+			},
+
+			"ec2_metadata_service_endpoint": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				DefaultFunc:  schema.EnvDefaultFunc(conns.EnvVarEC2MetadataServiceEndpoint, ""),
+				Description:  descriptions["ec2_metadata_service_endpoint"],
+				ValidateFunc: validation.IsURLWithHTTPorHTTPS,
+			},
+
+			"ec2_metadata_service_endpoint_mode": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				DefaultFunc:  schema.EnvDefaultFunc(conns.EnvVarEC2MetadataServiceEndpointMode, ""),
+				Description:  descriptions["ec2_metadata_service_endpoint_mode"],
+				ValidateFunc: validation.StringInSlice(conns.EC2MetadataServiceEndpointMode_Values(), false),
+			},
+
 			"http_proxy": {
 				Type:        schema.TypeString,
 				Optional:    true,
@@ -287,6 +349,66 @@ func Provider() *schema.Provider {
 							Set:         schema.HashString,
 							Description: "Resource tag key prefixes to ignore across all resources.",
 						},
+						"key_regexes": {
+							Type:     schema.TypeSet,
+							Optional: true,
+							Elem: &schema.Schema{
+								Type:         schema.TypeString,
+								ValidateFunc: validation.StringIsValidRegExp,
+							},
+							Set:         schema.HashString,
+							Description: "Resource tag key regular expressions to ignore across all resources.",
+						},
+						"key_suffixes": {
+							Type:        schema.TypeSet,
+							Optional:    true,
+							Elem:        &schema.Schema{Type: schema.TypeString},
+							Set:         schema.HashString,
+							Description: "Resource tag key suffixes to ignore across all resources.",
+						},
+					},
+				},
+			},
+
+			"tag_policy": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				MaxItems:    1,
+				Description: "Configuration block with a tag policy that resource tags must comply with across all resources.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"allowed_values": {
+							Type:        schema.TypeSet,
+							Optional:    true,
+							Description: "Allowed values for a resource tag key.",
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"key": {
+										Type:     schema.TypeString,
+										Required: true,
+									},
+									"values": {
+										Type:     schema.TypeSet,
+										Required: true,
+										MinItems: 1,
+										Elem:     &schema.Schema{Type: schema.TypeString},
+									},
+								},
+							},
+						},
+						"key_regex": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							Description:  "Regular expression that all resource tag keys must match.",
+							ValidateFunc: validation.StringIsValidRegExp,
+						},
+						"required_keys": {
+							Type:        schema.TypeSet,
+							Optional:    true,
+							Elem:        &schema.Schema{Type: schema.TypeString},
+							Set:         schema.HashString,
+							Description: "Resource tag keys required on all resources.",
+						},
 					},
 				},
 			},
@@ -339,9 +461,25 @@ func Provider() *schema.Provider {
 				Default:     false,
 				Description: descriptions["s3_force_path_style"],
 			},
+
+			"use_dualstack_endpoint": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				DefaultFunc: schema.EnvDefaultFunc(conns.EnvVarUseDualStackEndpoint, false),
+				Description: descriptions["use_dualstack_endpoint"],
+			},
+
+			"use_fips_endpoint": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				DefaultFunc: schema.EnvDefaultFunc(conns.EnvVarUseFIPSEndpoint, false),
+				Description: descriptions["use_fips_endpoint"],
+			},
 		},
 
 		DataSourcesMap: map[string]*schema.Resource{
+			"aws_accessanalyzer_policy_validation": accessanalyzer.DataSourcePolicyValidation(),
+
 			"aws_acm_certificate": acm.DataSourceCertificate(),
 
 			"aws_acmpca_certificate_authority": acmpca.DataSourceCertificateAuthority(),
@@ -544,18 +682,20 @@ func Provider() *schema.Provider {
 
 			"aws_guardduty_detector": guardduty.DataSourceDetector(),
 
-			"aws_iam_account_alias":      iam.DataSourceAccountAlias(),
-			"aws_iam_group":              iam.DataSourceGroup(),
-			"aws_iam_instance_profile":   iam.DataSourceInstanceProfile(),
-			"aws_iam_policy":             iam.DataSourcePolicy(),
-			"aws_iam_policy_document":    iam.DataSourcePolicyDocument(),
-			"aws_iam_role":               iam.DataSourceRole(),
-			"aws_iam_roles":              iam.DataSourceRoles(),
-			"aws_iam_server_certificate": iam.DataSourceServerCertificate(),
-			"aws_iam_session_context":    iam.DataSourceSessionContext(),
-			"aws_iam_user":               iam.DataSourceUser(),
-			"aws_iam_user_ssh_key":       iam.DataSourceUserSSHKey(),
-			"aws_iam_users":              iam.DataSourceUsers(),
+			"aws_iam_account_alias":                      iam.DataSourceAccountAlias(),
+			"aws_iam_group":                              iam.DataSourceGroup(),
+			"aws_iam_instance_profile":                   iam.DataSourceInstanceProfile(),
+			"aws_iam_openid_connect_provider_thumbprint": iam.DataSourceOpenIDConnectProviderThumbprint(),
+			"aws_iam_policy":                             iam.DataSourcePolicy(),
+			"aws_iam_policy_document":                    iam.DataSourcePolicyDocument(),
+			"aws_iam_policy_simulation":                  iam.DataSourcePolicySimulation(),
+			"aws_iam_role":                               iam.DataSourceRole(),
+			"aws_iam_roles":                              iam.DataSourceRoles(),
+			"aws_iam_server_certificate":                 iam.DataSourceServerCertificate(),
+			"aws_iam_session_context":                    iam.DataSourceSessionContext(),
+			"aws_iam_user":                               iam.DataSourceUser(),
+			"aws_iam_user_ssh_key":                       iam.DataSourceUserSSHKey(),
+			"aws_iam_users":                              iam.DataSourceUsers(),
 
 			"aws_identitystore_group": identitystore.DataSourceGroup(),
 			"aws_identitystore_user":  identitystore.DataSourceUser(),
@@ -665,10 +805,15 @@ func Provider() *schema.Provider {
 			"aws_route53_resolver_rule":     route53resolver.DataSourceRule(),
 			"aws_route53_resolver_rules":    route53resolver.DataSourceRules(),
 
-			"aws_canonical_user_id": s3.DataSourceCanonicalUserID(),
-			"aws_s3_bucket":         s3.DataSourceBucket(),
-			"aws_s3_bucket_object":  s3.DataSourceBucketObject(),
-			"aws_s3_bucket_objects": s3.DataSourceBucketObjects(),
+			"aws_canonical_user_id":   s3.DataSourceCanonicalUserID(),
+			"aws_s3_bucket":           s3.DataSourceBucket(),
+			"aws_s3_bucket_inventory": s3.DataSourceBucketInventory(),
+			"aws_s3_bucket_object":    s3.DataSourceBucketObject(),
+			"aws_s3_bucket_objects":   s3.DataSourceBucketObjects(),
+
+			"aws_s3_access_point":                      s3control.DataSourceAccessPoint(),
+			"aws_s3control_multi_region_access_point":  s3control.DataSourceMultiRegionAccessPoint(),
+			"aws_s3control_object_lambda_access_point": s3control.DataSourceObjectLambdaAccessPoint(),
 
 			"aws_sagemaker_prebuilt_ecr_image": sagemaker.DataSourcePrebuiltECRImage(),
 
@@ -735,7 +880,8 @@ func Provider() *schema.Provider {
 		},
 
 		ResourcesMap: map[string]*schema.Resource{
-			"aws_accessanalyzer_analyzer": accessanalyzer.ResourceAnalyzer(),
+			"aws_accessanalyzer_analyzer":     accessanalyzer.ResourceAnalyzer(),
+			"aws_accessanalyzer_archive_rule": accessanalyzer.ResourceArchiveRule(),
 
 			"aws_account_alternate_contact": account.ResourceAlternateContact(),
 
@@ -1291,29 +1437,35 @@ func Provider() *schema.Provider {
 			"aws_guardduty_publishing_destination":     guardduty.ResourcePublishingDestination(),
 			"aws_guardduty_threatintelset":             guardduty.ResourceThreatintelset(),
 
-			"aws_iam_access_key":              iam.ResourceAccessKey(),
-			"aws_iam_account_alias":           iam.ResourceAccountAlias(),
-			"aws_iam_account_password_policy": iam.ResourceAccountPasswordPolicy(),
-			"aws_iam_group":                   iam.ResourceGroup(),
-			"aws_iam_group_membership":        iam.ResourceGroupMembership(),
-			"aws_iam_group_policy":            iam.ResourceGroupPolicy(),
-			"aws_iam_group_policy_attachment": iam.ResourceGroupPolicyAttachment(),
-			"aws_iam_instance_profile":        iam.ResourceInstanceProfile(),
-			"aws_iam_openid_connect_provider": iam.ResourceOpenIDConnectProvider(),
-			"aws_iam_policy":                  iam.ResourcePolicy(),
-			"aws_iam_policy_attachment":       iam.ResourcePolicyAttachment(),
-			"aws_iam_role":                    iam.ResourceRole(),
-			"aws_iam_role_policy":             iam.ResourceRolePolicy(),
-			"aws_iam_role_policy_attachment":  iam.ResourceRolePolicyAttachment(),
-			"aws_iam_saml_provider":           iam.ResourceSamlProvider(),
-			"aws_iam_server_certificate":      iam.ResourceServerCertificate(),
-			"aws_iam_service_linked_role":     iam.ResourceServiceLinkedRole(),
-			"aws_iam_user":                    iam.ResourceUser(),
-			"aws_iam_user_group_membership":   iam.ResourceUserGroupMembership(),
-			"aws_iam_user_login_profile":      iam.ResourceUserLoginProfile(),
-			"aws_iam_user_policy":             iam.ResourceUserPolicy(),
-			"aws_iam_user_policy_attachment":  iam.ResourceUserPolicyAttachment(),
-			"aws_iam_user_ssh_key":            iam.ResourceUserSSHKey(),
+			"aws_iam_access_key":                         iam.ResourceAccessKey(),
+			"aws_iam_account_alias":                      iam.ResourceAccountAlias(),
+			"aws_iam_account_password_policy":            iam.ResourceAccountPasswordPolicy(),
+			"aws_iam_group":                              iam.ResourceGroup(),
+			"aws_iam_group_membership":                   iam.ResourceGroupMembership(),
+			"aws_iam_group_policies_exclusive":           iam.ResourceGroupPoliciesExclusive(),
+			"aws_iam_group_policy":                       iam.ResourceGroupPolicy(),
+			"aws_iam_group_policy_attachment":            iam.ResourceGroupPolicyAttachment(),
+			"aws_iam_group_policy_attachments_exclusive": iam.ResourceGroupPolicyAttachmentsExclusive(),
+			"aws_iam_instance_profile":                   iam.ResourceInstanceProfile(),
+			"aws_iam_openid_connect_provider":            iam.ResourceOpenIDConnectProvider(),
+			"aws_iam_policy":                             iam.ResourcePolicy(),
+			"aws_iam_policy_attachment":                  iam.ResourcePolicyAttachment(),
+			"aws_iam_role":                               iam.ResourceRole(),
+			"aws_iam_role_policies_exclusive":            iam.ResourceRolePoliciesExclusive(),
+			"aws_iam_role_policy":                        iam.ResourceRolePolicy(),
+			"aws_iam_role_policy_attachment":             iam.ResourceRolePolicyAttachment(),
+			"aws_iam_role_policy_attachments_exclusive":  iam.ResourceRolePolicyAttachmentsExclusive(),
+			"aws_iam_saml_provider":                      iam.ResourceSamlProvider(),
+			"aws_iam_server_certificate":                 iam.ResourceServerCertificate(),
+			"aws_iam_service_linked_role":                iam.ResourceServiceLinkedRole(),
+			"aws_iam_user":                               iam.ResourceUser(),
+			"aws_iam_user_group_membership":              iam.ResourceUserGroupMembership(),
+			"aws_iam_user_login_profile":                 iam.ResourceUserLoginProfile(),
+			"aws_iam_user_policies_exclusive":            iam.ResourceUserPoliciesExclusive(),
+			"aws_iam_user_policy":                        iam.ResourceUserPolicy(),
+			"aws_iam_user_policy_attachment":             iam.ResourceUserPolicyAttachment(),
+			"aws_iam_user_policy_attachments_exclusive":  iam.ResourceUserPolicyAttachmentsExclusive(),
+			"aws_iam_user_ssh_key":                       iam.ResourceUserSSHKey(),
 
 			"aws_imagebuilder_component":                    imagebuilder.ResourceComponent(),
 			"aws_imagebuilder_distribution_configuration":   imagebuilder.ResourceDistributionConfiguration(),
@@ -1548,18 +1700,26 @@ func Provider() *schema.Provider {
 			"aws_route53_resolver_rule":                            route53resolver.ResourceRule(),
 			"aws_route53_resolver_rule_association":                route53resolver.ResourceRuleAssociation(),
 
-			"aws_s3_bucket":                                   s3.ResourceBucket(),
-			"aws_s3_bucket_analytics_configuration":           s3.ResourceBucketAnalyticsConfiguration(),
-			"aws_s3_bucket_intelligent_tiering_configuration": s3.ResourceBucketIntelligentTieringConfiguration(),
-			"aws_s3_bucket_inventory":                         s3.ResourceBucketInventory(),
-			"aws_s3_bucket_metric":                            s3.ResourceBucketMetric(),
-			"aws_s3_bucket_notification":                      s3.ResourceBucketNotification(),
-			"aws_s3_bucket_object":                            s3.ResourceBucketObject(),
-			"aws_s3_bucket_ownership_controls":                s3.ResourceBucketOwnershipControls(),
-			"aws_s3_bucket_policy":                            s3.ResourceBucketPolicy(),
-			"aws_s3_bucket_public_access_block":               s3.ResourceBucketPublicAccessBlock(),
-			"aws_s3_bucket_replication_configuration":         s3.ResourceBucketReplicationConfiguration(),
-			"aws_s3_object_copy":                              s3.ResourceObjectCopy(),
+			"aws_s3_bucket":                                      s3.ResourceBucket(),
+			"aws_s3_bucket_acl":                                  s3.ResourceBucketACL(),
+			"aws_s3_bucket_analytics_configuration":              s3.ResourceBucketAnalyticsConfiguration(),
+			"aws_s3_bucket_cors_configuration":                   s3.ResourceBucketCorsConfiguration(),
+			"aws_s3_bucket_intelligent_tiering_configuration":    s3.ResourceBucketIntelligentTieringConfiguration(),
+			"aws_s3_bucket_inventory":                            s3.ResourceBucketInventory(),
+			"aws_s3_bucket_lifecycle_configuration":              s3.ResourceBucketLifecycleConfiguration(),
+			"aws_s3_bucket_logging":                              s3.ResourceBucketLogging(),
+			"aws_s3_bucket_metric":                               s3.ResourceBucketMetric(),
+			"aws_s3_bucket_notification":                         s3.ResourceBucketNotification(),
+			"aws_s3_bucket_object":                               s3.ResourceBucketObject(),
+			"aws_s3_bucket_ownership_controls":                   s3.ResourceBucketOwnershipControls(),
+			"aws_s3_bucket_policy":                               s3.ResourceBucketPolicy(),
+			"aws_s3_bucket_policy_statement":                     s3.ResourceBucketPolicyStatement(),
+			"aws_s3_bucket_public_access_block":                  s3.ResourceBucketPublicAccessBlock(),
+			"aws_s3_bucket_replication_configuration":            s3.ResourceBucketReplicationConfiguration(),
+			"aws_s3_bucket_server_side_encryption_configuration": s3.ResourceBucketServerSideEncryptionConfiguration(),
+			"aws_s3_bucket_versioning":                           s3.ResourceBucketVersioning(),
+			"aws_s3_bucket_website_configuration":                s3.ResourceBucketWebsiteConfiguration(),
+			"aws_s3_object_copy":                                 s3.ResourceObjectCopy(),
 
 			"aws_s3_access_point":                             s3control.ResourceAccessPoint(),
 			"aws_s3control_access_point_policy":               s3control.ResourceAccessPointPolicy(),
@@ -1571,6 +1731,7 @@ func Provider() *schema.Provider {
 			"aws_s3control_multi_region_access_point_policy":  s3control.ResourceMultiRegionAccessPointPolicy(),
 			"aws_s3control_object_lambda_access_point":        s3control.ResourceObjectLambdaAccessPoint(),
 			"aws_s3control_object_lambda_access_point_policy": s3control.ResourceObjectLambdaAccessPointPolicy(),
+			"aws_s3control_storage_lens_configuration":        s3control.ResourceStorageLensConfiguration(),
 
 			"aws_s3outposts_endpoint": s3outposts.ResourceEndpoint(),
 
@@ -1764,6 +1925,19 @@ func Provider() *schema.Provider {
 		},
 	}
 
+	// Resource types are added to the context of CustomizeDiff functions so that plan-time errors,
+	// such as tag policy violations, can name the resource.
+	for typeName, r := range provider.ResourcesMap {
+		if r.CustomizeDiff == nil {
+			continue
+		}
+
+		typeName, customizeDiff := typeName, r.CustomizeDiff
+		r.CustomizeDiff = func(ctx context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+			return customizeDiff(verify.ContextWithResourceType(ctx, typeName), diff, meta)
+		}
+	}
+
 	provider.ConfigureFunc = func(d *schema.ResourceData) (interface{}, error) {
 		terraformVersion := provider.TerraformVersion
 		if terraformVersion == "" {
@@ -1793,9 +1967,15 @@ func init() {
 		"profile": "The profile for API operations. If not set, the default profile\n" +
 			"created with `aws configure` will be used.",
 
+		"shared_config_files": "List of paths to shared config files. If not set\n" +
+			"this defaults to ~/.aws/config.",
+
 		"shared_credentials_file": "The path to the shared credentials file. If not set\n" +
 			"this defaults to ~/.aws/credentials.",
 
+		"shared_credentials_files": "List of paths to shared credentials files. If not set\n" +
+			"this defaults to ~/.aws/credentials.",
+
 		"token": "session token. A session token is only required if you are\n" +
 			"using temporary security credentials.",
 
@@ -1803,11 +1983,27 @@ func init() {
 			"being executed. If the API request still fails, an error is\n" +
 			"thrown.",
 
+		"max_requests_per_second": "The maximum number of AWS API requests per second made to a service. " +
+			"Requests are delayed client-side to stay within the limit.",
+
+		"retry_mode": "Specifies how retries are attempted. Valid values are `legacy`, `standard` and `adaptive`. " +
+			"Can also be configured using the `AWS_RETRY_MODE` environment variable.",
+
 		"http_proxy": "The address of an HTTP proxy to use when accessing the AWS API. " +
 			"Can also be configured using the `HTTP_PROXY` or `HTTPS_PROXY` environment variables.",
 
 		"endpoint": "Use this to override the default service endpoint URL",
 
+		"custom_ca_bundle": "File containing custom root and intermediate certificates. " +
+			"Can also be configured using the `AWS_CA_BUNDLE` environment variable.",
+
+		"ec2_metadata_service_endpoint": "Address of the EC2 metadata service endpoint to use. " +
+			"Can also be configured using the `AWS_EC2_METADATA_SERVICE_ENDPOINT` environment variable.",
+
+		"ec2_metadata_service_endpoint_mode": "Protocol to use with EC2 metadata service endpoint. " +
+			"Valid values are `IPv4` and `IPv6`. Can also be configured using the " +
+			"`AWS_EC2_METADATA_SERVICE_ENDPOINT_MODE` environment variable.",
+
 		"insecure": "Explicitly allow the provider to perform \"insecure\" SSL requests. If omitted, " +
 			"default value is `false`",
 
@@ -1830,30 +2026,44 @@ func init() {
 			"i.e., http://s3.amazonaws.com/BUCKET/KEY. By default, the S3 client will\n" +
 			"use virtual hosted bucket addressing when possible\n" +
 			"(http://BUCKET.s3.amazonaws.com/KEY). Specific to the Amazon S3 service.",
+
+		"use_dualstack_endpoint": "Resolve an endpoint with DualStack capability. " +
+			"Can also be configured using the `AWS_USE_DUALSTACK_ENDPOINT` environment variable.",
+
+		"use_fips_endpoint": "Resolve an endpoint with FIPS capability. " +
+			"Can also be configured using the `AWS_USE_FIPS_ENDPOINT` environment variable.",
 	}
 }
 
 func providerConfigure(d *schema.ResourceData, terraformVersion string) (interface{}, error) {
 	config := conns.Config{
-		AccessKey:               d.Get("access_key").(string),
-		SecretKey:               d.Get("secret_key").(string),
-		Profile:                 d.Get("profile").(string),
-		Token:                   d.Get("token").(string),
-		Region:                  d.Get("region").(string),
-		CredsFilename:           d.Get("shared_credentials_file").(string),
-		DefaultTagsConfig:       expandProviderDefaultTags(d.Get("default_tags").([]interface{})),
-		Endpoints:               make(map[string]string),
-		MaxRetries:              d.Get("max_retries").(int),
-		IgnoreTagsConfig:        expandProviderIgnoreTags(d.Get("ignore_tags").([]interface{})),
-		Insecure:                d.Get("insecure").(bool),
-		HTTPProxy:               d.Get("http_proxy").(string),
-		SkipCredsValidation:     d.Get("skip_credentials_validation").(bool),
-		SkipGetEC2Platforms:     d.Get("skip_get_ec2_platforms").(bool),
-		SkipRegionValidation:    d.Get("skip_region_validation").(bool),
-		SkipRequestingAccountId: d.Get("skip_requesting_account_id").(bool),
-		SkipMetadataApiCheck:    d.Get("skip_metadata_api_check").(bool),
-		S3ForcePathStyle:        d.Get("s3_force_path_style").(bool),
-		TerraformVersion:        terraformVersion,
+		AccessKey:                      d.Get("access_key").(string),
+		SecretKey:                      d.Get("secret_key").(string),
+		Profile:                        d.Get("profile").(string),
+		Token:                          d.Get("token").(string),
+		Region:                         d.Get("region").(string),
+		CredsFilename:                  d.Get("shared_credentials_file").(string),
+		CustomCABundle:                 d.Get("custom_ca_bundle").(string),
+		DefaultTagsConfig:              expandProviderDefaultTags(d.Get("default_tags").([]interface{})),
+		EC2MetadataServiceEndpoint:     d.Get("ec2_metadata_service_endpoint").(string),
+		EC2MetadataServiceEndpointMode: d.Get("ec2_metadata_service_endpoint_mode").(string),
+		Endpoints:                      make(map[string]string),
+		MaxRequestsPerSecond:           make(map[string]float64),
+		MaxRetries:                     d.Get("max_retries").(int),
+		IgnoreTagsConfig:               expandProviderIgnoreTags(d.Get("ignore_tags").([]interface{})),
+		Insecure:                       d.Get("insecure").(bool),
+		HTTPProxy:                      d.Get("http_proxy").(string),
+		RetryMode:                      d.Get("retry_mode").(string),
+		SkipCredsValidation:            d.Get("skip_credentials_validation").(bool),
+		SkipGetEC2Platforms:            d.Get("skip_get_ec2_platforms").(bool),
+		SkipRegionValidation:           d.Get("skip_region_validation").(bool),
+		SkipRequestingAccountId:        d.Get("skip_requesting_account_id").(bool),
+		SkipMetadataApiCheck:           d.Get("skip_metadata_api_check").(bool),
+		S3ForcePathStyle:               d.Get("s3_force_path_style").(bool),
+		TagPolicyConfig:                expandProviderTagPolicy(d.Get("tag_policy").([]interface{})),
+		TerraformVersion:               terraformVersion,
+		UseDualStackEndpoint:           d.Get("use_dualstack_endpoint").(bool),
+		UseFIPSEndpoint:                d.Get("use_fips_endpoint").(bool),
 	}
 
 	if l, ok := d.Get("assume_role").([]interface{}); ok && len(l) > 0 && l[0] != nil {
@@ -1920,6 +2130,53 @@ func providerConfigure(d *schema.ResourceData, terraformVersion string) (interfa
 		log.Printf("[INFO] assume_role configuration set: (ARN: %q, SessionID: %q, ExternalID: %q)", config.AssumeRoleARN, config.AssumeRoleSessionName, config.AssumeRoleExternalID)
 	}
 
+	if l, ok := d.Get("assume_role_with_web_identity").([]interface{}); ok && len(l) > 0 && l[0] != nil {
+		m := l[0].(map[string]interface{})
+
+		if v, ok := m["duration"].(string); ok && v != "" {
+			duration, _ := time.ParseDuration(v)
+			config.AssumeRoleWithWebIdentityDuration = duration
+		}
+
+		if v, ok := m["policy"].(string); ok && v != "" {
+			config.AssumeRoleWithWebIdentityPolicy = v
+		}
+
+		if v, ok := m["policy_arns"].(*schema.Set); ok && v.Len() > 0 {
+			for _, policyARNRaw := range v.List() {
+				policyARN, ok := policyARNRaw.(string)
+
+				if !ok {
+					continue
+				}
+
+				config.AssumeRoleWithWebIdentityPolicyARNs = append(config.AssumeRoleWithWebIdentityPolicyARNs, policyARN)
+			}
+		}
+
+		if v, ok := m["role_arn"].(string); ok && v != "" {
+			config.AssumeRoleWithWebIdentityARN = v
+		}
+
+		if v, ok := m["session_name"].(string); ok && v != "" {
+			config.AssumeRoleWithWebIdentitySessionName = v
+		}
+
+		if v, ok := m["web_identity_token"].(string); ok && v != "" {
+			config.AssumeRoleWithWebIdentityToken = v
+		}
+
+		if v, ok := m["web_identity_token_file"].(string); ok && v != "" {
+			config.AssumeRoleWithWebIdentityTokenFile = v
+		}
+
+		if config.AssumeRoleWithWebIdentityToken == "" && config.AssumeRoleWithWebIdentityTokenFile == "" {
+			return nil, fmt.Errorf("assume_role_with_web_identity: one of `web_identity_token` or `web_identity_token_file` must be set")
+		}
+
+		log.Printf("[INFO] assume_role_with_web_identity configuration set: (ARN: %q, SessionID: %q)", config.AssumeRoleWithWebIdentityARN, config.AssumeRoleWithWebIdentitySessionName)
+	}
+
 	endpointsSet := d.Get("endpoints").(*schema.Set)
 
 	for _, endpointsSetI := range endpointsSet.List() {
@@ -1938,6 +2195,38 @@ func providerConfigure(d *schema.ResourceData, terraformVersion string) (interfa
 		}
 	}
 
+	if l, ok := d.Get("max_requests_per_second").([]interface{}); ok && len(l) > 0 && l[0] != nil {
+		m := l[0].(map[string]interface{})
+
+		for _, hclKey := range conns.HCLKeys() {
+			serviceKey, err := conns.ServiceForHCLKey(hclKey)
+
+			if err != nil {
+				return nil, fmt.Errorf("failed to assign maximum requests per second (%s): %w", hclKey, err)
+			}
+
+			if v, ok := m[hclKey].(float64); ok && v > 0 {
+				config.MaxRequestsPerSecond[serviceKey] = v
+			}
+		}
+	}
+
+	if v, ok := d.GetOk("shared_config_files"); ok {
+		for _, filenameRaw := range v.([]interface{}) {
+			if filename, ok := filenameRaw.(string); ok && filename != "" {
+				config.SharedConfigFiles = append(config.SharedConfigFiles, filename)
+			}
+		}
+	}
+
+	if v, ok := d.GetOk("shared_credentials_files"); ok {
+		for _, filenameRaw := range v.([]interface{}) {
+			if filename, ok := filenameRaw.(string); ok && filename != "" {
+				config.SharedCredentialsFiles = append(config.SharedCredentialsFiles, filename)
+			}
+		}
+	}
+
 	if v, ok := d.GetOk("allowed_account_ids"); ok {
 		for _, accountIDRaw := range v.(*schema.Set).List() {
 			config.AllowedAccountIds = append(config.AllowedAccountIds, accountIDRaw.(string))
@@ -2022,6 +2311,83 @@ func assumeRoleSchema() *schema.Schema {
 	}
 }
 
+func assumeRoleWithWebIdentitySchema() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeList,
+		Optional: true,
+		MaxItems: 1,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"duration": {
+					Type:         schema.TypeString,
+					Optional:     true,
+					Description:  "The duration of the role session, e.g. `1h`. Valid values are between 15 minutes and 12 hours.",
+					ValidateFunc: validAssumeRoleDuration,
+				},
+				"policy": {
+					Type:         schema.TypeString,
+					Optional:     true,
+					Description:  "IAM Policy JSON describing further restricting permissions for the IAM Role being assumed.",
+					ValidateFunc: validation.StringIsJSON,
+				},
+				"policy_arns": {
+					Type:        schema.TypeSet,
+					Optional:    true,
+					Description: "Amazon Resource Names (ARNs) of IAM Policies describing further restricting permissions for the IAM Role being assumed.",
+					Elem: &schema.Schema{
+						Type:         schema.TypeString,
+						ValidateFunc: verify.ValidARN,
+					},
+				},
+				"role_arn": {
+					Type:         schema.TypeString,
+					Required:     true,
+					Description:  "Amazon Resource Name of an IAM Role to assume using a web identity token.",
+					ValidateFunc: verify.ValidARN,
+				},
+				"session_name": {
+					Type:        schema.TypeString,
+					Optional:    true,
+					Description: "An identifier for the assumed role session.",
+					ValidateFunc: validation.All(
+						validation.StringLenBetween(2, 64),
+						validation.StringMatch(regexp.MustCompile(`[\w+=,.@\-]*`), ""),
+					),
+				},
+				"web_identity_token": {
+					Type:          schema.TypeString,
+					Optional:      true,
+					Sensitive:     true,
+					Description:   "The OAuth 2.0 access token or OpenID Connect ID token provided by the identity provider.",
+					ConflictsWith: []string{"assume_role_with_web_identity.0.web_identity_token_file"},
+					ValidateFunc:  validation.StringLenBetween(4, 20000),
+				},
+				"web_identity_token_file": {
+					Type:          schema.TypeString,
+					Optional:      true,
+					Description:   "The path to a file containing the OAuth 2.0 access token or OpenID Connect ID token provided by the identity provider.",
+					ConflictsWith: []string{"assume_role_with_web_identity.0.web_identity_token"},
+				},
+			},
+		},
+	}
+}
+
+func validAssumeRoleDuration(v interface{}, k string) (ws []string, errors []error) {
+	duration, err := time.ParseDuration(v.(string))
+
+	if err != nil {
+		errors = append(errors, fmt.Errorf("%q cannot be parsed as a duration: %w", k, err))
+		return
+	}
+
+	if duration < 15*time.Minute || duration > 12*time.Hour {
+		errors = append(errors, fmt.Errorf("%q must be between 15 minutes (15m) and 12 hours (12h), inclusive", k))
+	}
+
+	return
+}
+
 func endpointsSchema() *schema.Schema {
 	endpointsAttributes := make(map[string]*schema.Schema)
 
@@ -2043,6 +2409,28 @@ func endpointsSchema() *schema.Schema {
 	}
 }
 
+func maxRequestsPerSecondSchema() *schema.Schema {
+	maxRequestsPerSecondAttributes := make(map[string]*schema.Schema)
+
+	for _, serviceKey := range conns.HCLKeys() {
+		maxRequestsPerSecondAttributes[serviceKey] = &schema.Schema{
+			Type:         schema.TypeFloat,
+			Optional:     true,
+			Description:  descriptions["max_requests_per_second"],
+			ValidateFunc: validation.FloatAtLeast(0),
+		}
+	}
+
+	return &schema.Schema{
+		Type:     schema.TypeList,
+		Optional: true,
+		MaxItems: 1,
+		Elem: &schema.Resource{
+			Schema: maxRequestsPerSecondAttributes,
+		},
+	}
+}
+
 func expandProviderDefaultTags(l []interface{}) *tftags.DefaultConfig {
 	if len(l) == 0 || l[0] == nil {
 		return nil
@@ -2054,6 +2442,11 @@ func expandProviderDefaultTags(l []interface{}) *tftags.DefaultConfig {
 	if v, ok := m["tags"].(map[string]interface{}); ok {
 		defaultConfig.Tags = tftags.New(v)
 	}
+
+	if v, ok := m["tags_all_precedence"].(string); ok {
+		defaultConfig.TagsAllPrecedence = v
+	}
+
 	return defaultConfig
 }
 
@@ -2073,5 +2466,58 @@ func expandProviderIgnoreTags(l []interface{}) *tftags.IgnoreConfig {
 		ignoreConfig.KeyPrefixes = tftags.New(v.List())
 	}
 
+	if v, ok := m["key_regexes"].(*schema.Set); ok {
+		for _, regexRaw := range v.List() {
+			// Validated during schema validation.
+			ignoreConfig.KeyRegexes = append(ignoreConfig.KeyRegexes, regexp.MustCompile(regexRaw.(string)))
+		}
+	}
+
+	if v, ok := m["key_suffixes"].(*schema.Set); ok {
+		ignoreConfig.KeySuffixes = tftags.New(v.List())
+	}
+
 	return ignoreConfig
 }
+
+func expandProviderTagPolicy(l []interface{}) *tftags.PolicyConfig {
+	if len(l) == 0 || l[0] == nil {
+		return nil
+	}
+
+	policyConfig := &tftags.PolicyConfig{}
+	m := l[0].(map[string]interface{})
+
+	if v, ok := m["allowed_values"].(*schema.Set); ok && v.Len() > 0 {
+		policyConfig.AllowedValues = make(map[string][]string)
+
+		for _, tfMapRaw := range v.List() {
+			tfMap, ok := tfMapRaw.(map[string]interface{})
+
+			if !ok {
+				continue
+			}
+
+			key := tfMap["key"].(string)
+
+			for _, valueRaw := range tfMap["values"].(*schema.Set).List() {
+				policyConfig.AllowedValues[key] = append(policyConfig.AllowedValues[key], valueRaw.(string))
+			}
+
+			sort.Strings(policyConfig.AllowedValues[key])
+		}
+	}
+
+	if v, ok := m["key_regex"].(string); ok && v != "" {
+		// Validated during schema validation.
+		policyConfig.KeyRegex = regexp.MustCompile(v)
+	}
+
+	if v, ok := m["required_keys"].(*schema.Set); ok {
+		for _, keyRaw := range v.List() {
+			policyConfig.RequiredKeys = append(policyConfig.RequiredKeys, keyRaw.(string))
+		}
+	}
+
+	return policyConfig
+}
@@ -0,0 +1,284 @@
+package accessanalyzer
+
+import (
+	"fmt"
+	"log"
+	"strconv"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/accessanalyzer"
+	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+const archiveRuleResourceIDSeparator = "/"
+
+func ResourceArchiveRule() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceArchiveRuleCreate,
+		Read:   resourceArchiveRuleRead,
+		Update: resourceArchiveRuleUpdate,
+		Delete: resourceArchiveRuleDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"analyzer_name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"filter": {
+				Type:     schema.TypeSet,
+				Required: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"contains": {
+							Type:     schema.TypeList,
+							Optional: true,
+							MinItems: 1,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+						"criteria": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						"eq": {
+							Type:     schema.TypeList,
+							Optional: true,
+							MinItems: 1,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+						"exists": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: validation.StringInSlice([]string{"true", "false"}, false),
+						},
+						"neq": {
+							Type:     schema.TypeList,
+							Optional: true,
+							MinItems: 1,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+					},
+				},
+			},
+			"rule_name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(1, 255),
+			},
+		},
+	}
+}
+
+func resourceArchiveRuleCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).AccessAnalyzerConn
+
+	analyzerName := d.Get("analyzer_name").(string)
+	ruleName := d.Get("rule_name").(string)
+	id := ArchiveRuleCreateResourceID(analyzerName, ruleName)
+
+	input := &accessanalyzer.CreateArchiveRuleInput{
+		AnalyzerName: aws.String(analyzerName),
+		ClientToken:  aws.String(resource.UniqueId()),
+		Filter:       expandArchiveRuleFilters(d.Get("filter").(*schema.Set).List()),
+		RuleName:     aws.String(ruleName),
+	}
+
+	log.Printf("[DEBUG] Creating Access Analyzer Archive Rule: %s", input)
+	_, err := conn.CreateArchiveRule(input)
+
+	if err != nil {
+		return fmt.Errorf("error creating Access Analyzer Archive Rule (%s): %w", id, err)
+	}
+
+	d.SetId(id)
+
+	return resourceArchiveRuleRead(d, meta)
+}
+
+func resourceArchiveRuleRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).AccessAnalyzerConn
+
+	analyzerName, ruleName, err := ArchiveRuleParseResourceID(d.Id())
+
+	if err != nil {
+		return err
+	}
+
+	archiveRule, err := FindArchiveRule(conn, analyzerName, ruleName)
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] Access Analyzer Archive Rule (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error reading Access Analyzer Archive Rule (%s): %w", d.Id(), err)
+	}
+
+	d.Set("analyzer_name", analyzerName)
+	if err := d.Set("filter", flattenArchiveRuleFilters(archiveRule.Filter)); err != nil {
+		return fmt.Errorf("error setting filter: %w", err)
+	}
+	d.Set("rule_name", archiveRule.RuleName)
+
+	return nil
+}
+
+func resourceArchiveRuleUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).AccessAnalyzerConn
+
+	analyzerName, ruleName, err := ArchiveRuleParseResourceID(d.Id())
+
+	if err != nil {
+		return err
+	}
+
+	input := &accessanalyzer.UpdateArchiveRuleInput{
+		AnalyzerName: aws.String(analyzerName),
+		ClientToken:  aws.String(resource.UniqueId()),
+		Filter:       expandArchiveRuleFilters(d.Get("filter").(*schema.Set).List()),
+		RuleName:     aws.String(ruleName),
+	}
+
+	log.Printf("[DEBUG] Updating Access Analyzer Archive Rule: %s", input)
+	_, err = conn.UpdateArchiveRule(input)
+
+	if err != nil {
+		return fmt.Errorf("error updating Access Analyzer Archive Rule (%s): %w", d.Id(), err)
+	}
+
+	return resourceArchiveRuleRead(d, meta)
+}
+
+func resourceArchiveRuleDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).AccessAnalyzerConn
+
+	analyzerName, ruleName, err := ArchiveRuleParseResourceID(d.Id())
+
+	if err != nil {
+		return err
+	}
+
+	log.Printf("[DEBUG] Deleting Access Analyzer Archive Rule: (%s)", d.Id())
+	_, err = conn.DeleteArchiveRule(&accessanalyzer.DeleteArchiveRuleInput{
+		AnalyzerName: aws.String(analyzerName),
+		ClientToken:  aws.String(resource.UniqueId()),
+		RuleName:     aws.String(ruleName),
+	})
+
+	if tfawserr.ErrCodeEquals(err, accessanalyzer.ErrCodeResourceNotFoundException) {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error deleting Access Analyzer Archive Rule (%s): %w", d.Id(), err)
+	}
+
+	return nil
+}
+
+func ArchiveRuleCreateResourceID(analyzerName, ruleName string) string {
+	parts := []string{analyzerName, ruleName}
+	id := strings.Join(parts, archiveRuleResourceIDSeparator)
+
+	return id
+}
+
+func ArchiveRuleParseResourceID(id string) (string, string, error) {
+	parts := strings.Split(id, archiveRuleResourceIDSeparator)
+
+	if len(parts) == 2 && parts[0] != "" && parts[1] != "" {
+		return parts[0], parts[1], nil
+	}
+
+	return "", "", fmt.Errorf("unexpected format for ID (%[1]s), expected analyzer-name%[2]srule-name", id, archiveRuleResourceIDSeparator)
+}
+
+func expandArchiveRuleFilters(tfList []interface{}) map[string]*accessanalyzer.Criterion {
+	if len(tfList) == 0 {
+		return nil
+	}
+
+	apiObject := make(map[string]*accessanalyzer.Criterion)
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		criterion := &accessanalyzer.Criterion{}
+
+		if v, ok := tfMap["contains"].([]interface{}); ok && len(v) > 0 {
+			criterion.Contains = flex.ExpandStringList(v)
+		}
+
+		if v, ok := tfMap["eq"].([]interface{}); ok && len(v) > 0 {
+			criterion.Eq = flex.ExpandStringList(v)
+		}
+
+		if v, ok := tfMap["exists"].(string); ok && v != "" {
+			exists, _ := strconv.ParseBool(v)
+			criterion.Exists = aws.Bool(exists)
+		}
+
+		if v, ok := tfMap["neq"].([]interface{}); ok && len(v) > 0 {
+			criterion.Neq = flex.ExpandStringList(v)
+		}
+
+		apiObject[tfMap["criteria"].(string)] = criterion
+	}
+
+	return apiObject
+}
+
+func flattenArchiveRuleFilters(apiObject map[string]*accessanalyzer.Criterion) []interface{} {
+	if len(apiObject) == 0 {
+		return nil
+	}
+
+	var tfList []interface{}
+
+	for criteria, criterion := range apiObject {
+		if criterion == nil {
+			continue
+		}
+
+		tfMap := map[string]interface{}{
+			"criteria": criteria,
+		}
+
+		if v := criterion.Contains; len(v) > 0 {
+			tfMap["contains"] = aws.StringValueSlice(v)
+		}
+
+		if v := criterion.Eq; len(v) > 0 {
+			tfMap["eq"] = aws.StringValueSlice(v)
+		}
+
+		if v := criterion.Exists; v != nil {
+			tfMap["exists"] = strconv.FormatBool(aws.BoolValue(v))
+		}
+
+		if v := criterion.Neq; len(v) > 0 {
+			tfMap["neq"] = aws.StringValueSlice(v)
+		}
+
+		tfList = append(tfList, tfMap)
+	}
+
+	return tfList
+}
@@ -0,0 +1,256 @@
+package accessanalyzer_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/accessanalyzer"
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfaccessanalyzer "github.com/hashicorp/terraform-provider-aws/internal/service/accessanalyzer"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func testAccArchiveRule_basic(t *testing.T) {
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_accessanalyzer_archive_rule.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t); testAccPreCheck(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, accessanalyzer.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckArchiveRuleDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccArchiveRuleConfig(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckArchiveRuleExists(resourceName),
+					resource.TestCheckResourceAttrPair(resourceName, "analyzer_name", "aws_accessanalyzer_analyzer.test", "analyzer_name"),
+					resource.TestCheckResourceAttr(resourceName, "rule_name", rName),
+					resource.TestCheckResourceAttr(resourceName, "filter.#", "2"),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "filter.*", map[string]string{
+						"criteria": "isPublic",
+						"eq.#":     "1",
+						"eq.0":     "false",
+					}),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "filter.*", map[string]string{
+						"criteria": "error",
+						"exists":   "true",
+					}),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccArchiveRule_disappears(t *testing.T) {
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_accessanalyzer_archive_rule.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t); testAccPreCheck(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, accessanalyzer.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckArchiveRuleDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccArchiveRuleConfig(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckArchiveRuleExists(resourceName),
+					acctest.CheckResourceDisappears(acctest.Provider, tfaccessanalyzer.ResourceArchiveRule(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func testAccArchiveRule_updateFilters(t *testing.T) {
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_accessanalyzer_archive_rule.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t); testAccPreCheck(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, accessanalyzer.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckArchiveRuleDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccArchiveRuleConfig(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckArchiveRuleExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "filter.#", "2"),
+				),
+			},
+			{
+				Config: testAccArchiveRuleUpdatedFiltersConfig(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckArchiveRuleExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "filter.#", "1"),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "filter.*", map[string]string{
+						"criteria": "resourceType",
+						"neq.#":    "2",
+					}),
+				),
+			},
+		},
+	})
+}
+
+func TestArchiveRuleParseResourceID(t *testing.T) {
+	testCases := []struct {
+		TestName             string
+		InputID              string
+		ExpectedError        bool
+		ExpectedAnalyzerName string
+		ExpectedRuleName     string
+	}{
+		{
+			TestName:      "empty ID",
+			InputID:       "",
+			ExpectedError: true,
+		},
+		{
+			TestName:      "missing rule name",
+			InputID:       "example/",
+			ExpectedError: true,
+		},
+		{
+			TestName:      "too many parts",
+			InputID:       "example/rule/extra",
+			ExpectedError: true,
+		},
+		{
+			TestName:             "valid ID",
+			InputID:              tfaccessanalyzer.ArchiveRuleCreateResourceID("example", "rule"),
+			ExpectedAnalyzerName: "example",
+			ExpectedRuleName:     "rule",
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.TestName, func(t *testing.T) {
+			gotAnalyzerName, gotRuleName, err := tfaccessanalyzer.ArchiveRuleParseResourceID(testCase.InputID)
+
+			if err == nil && testCase.ExpectedError {
+				t.Fatalf("expected error, got no error")
+			}
+
+			if err != nil && !testCase.ExpectedError {
+				t.Fatalf("got unexpected error: %s", err)
+			}
+
+			if gotAnalyzerName != testCase.ExpectedAnalyzerName {
+				t.Errorf("got analyzer name %s, expected %s", gotAnalyzerName, testCase.ExpectedAnalyzerName)
+			}
+
+			if gotRuleName != testCase.ExpectedRuleName {
+				t.Errorf("got rule name %s, expected %s", gotRuleName, testCase.ExpectedRuleName)
+			}
+		})
+	}
+}
+
+func testAccCheckArchiveRuleDestroy(s *terraform.State) error {
+	conn := acctest.Provider.Meta().(*conns.AWSClient).AccessAnalyzerConn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_accessanalyzer_archive_rule" {
+			continue
+		}
+
+		analyzerName, ruleName, err := tfaccessanalyzer.ArchiveRuleParseResourceID(rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		_, err = tfaccessanalyzer.FindArchiveRule(conn, analyzerName, ruleName)
+
+		if tfresource.NotFound(err) {
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+
+		return fmt.Errorf("Access Analyzer Archive Rule %s still exists", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+func testAccCheckArchiveRuleExists(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No Access Analyzer Archive Rule ID is set")
+		}
+
+		analyzerName, ruleName, err := tfaccessanalyzer.ArchiveRuleParseResourceID(rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).AccessAnalyzerConn
+
+		_, err = tfaccessanalyzer.FindArchiveRule(conn, analyzerName, ruleName)
+
+		return err
+	}
+}
+
+func testAccArchiveRuleConfig(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_accessanalyzer_analyzer" "test" {
+  analyzer_name = %[1]q
+}
+
+resource "aws_accessanalyzer_archive_rule" "test" {
+  analyzer_name = aws_accessanalyzer_analyzer.test.analyzer_name
+  rule_name     = %[1]q
+
+  filter {
+    criteria = "isPublic"
+    eq       = ["false"]
+  }
+
+  filter {
+    criteria = "error"
+    exists   = "true"
+  }
+}
+`, rName)
+}
+
+func testAccArchiveRuleUpdatedFiltersConfig(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_accessanalyzer_analyzer" "test" {
+  analyzer_name = %[1]q
+}
+
+resource "aws_accessanalyzer_archive_rule" "test" {
+  analyzer_name = aws_accessanalyzer_analyzer.test.analyzer_name
+  rule_name     = %[1]q
+
+  filter {
+    criteria = "resourceType"
+    neq      = ["AWS::S3::Bucket", "AWS::KMS::Key"]
+  }
+}
+`, rName)
+}
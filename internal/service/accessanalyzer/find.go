@@ -0,0 +1,35 @@
+package accessanalyzer
+
+import (
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/accessanalyzer"
+	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func FindArchiveRule(conn *accessanalyzer.AccessAnalyzer, analyzerName, ruleName string) (*accessanalyzer.ArchiveRuleSummary, error) {
+	input := &accessanalyzer.GetArchiveRuleInput{
+		AnalyzerName: aws.String(analyzerName),
+		RuleName:     aws.String(ruleName),
+	}
+
+	output, err := conn.GetArchiveRule(input)
+
+	if tfawserr.ErrCodeEquals(err, accessanalyzer.ErrCodeResourceNotFoundException) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.ArchiveRule == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output.ArchiveRule, nil
+}
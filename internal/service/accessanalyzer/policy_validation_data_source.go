@@ -0,0 +1,274 @@
+package accessanalyzer
+
+import (
+	"fmt"
+	"strconv"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/accessanalyzer"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/create"
+)
+
+func DataSourcePolicyValidation() *schema.Resource {
+	positionSchema := func() *schema.Schema {
+		return &schema.Schema{
+			Type:     schema.TypeList,
+			Computed: true,
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"column": {
+						Type:     schema.TypeInt,
+						Computed: true,
+					},
+					"line": {
+						Type:     schema.TypeInt,
+						Computed: true,
+					},
+					"offset": {
+						Type:     schema.TypeInt,
+						Computed: true,
+					},
+				},
+			},
+		}
+	}
+
+	return &schema.Resource{
+		Read: dataSourcePolicyValidationRead,
+
+		Schema: map[string]*schema.Schema{
+			"findings": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"finding_details": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"finding_type": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"issue_code": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"learn_more_link": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"locations": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"path": {
+										Type:     schema.TypeList,
+										Computed: true,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"index": {
+													Type:     schema.TypeInt,
+													Computed: true,
+												},
+												"key": {
+													Type:     schema.TypeString,
+													Computed: true,
+												},
+												"substring": {
+													Type:     schema.TypeList,
+													Computed: true,
+													Elem: &schema.Resource{
+														Schema: map[string]*schema.Schema{
+															"length": {
+																Type:     schema.TypeInt,
+																Computed: true,
+															},
+															"start": {
+																Type:     schema.TypeInt,
+																Computed: true,
+															},
+														},
+													},
+												},
+												"value": {
+													Type:     schema.TypeString,
+													Computed: true,
+												},
+											},
+										},
+									},
+									"span": {
+										Type:     schema.TypeList,
+										Computed: true,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"end":   positionSchema(),
+												"start": positionSchema(),
+											},
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			"locale": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringInSlice(accessanalyzer.Locale_Values(), false),
+			},
+			"policy_document": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringIsJSON,
+			},
+			"policy_type": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringInSlice(accessanalyzer.PolicyType_Values(), false),
+			},
+			"validate_policy_resource_type": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringInSlice(accessanalyzer.ValidatePolicyResourceType_Values(), false),
+			},
+		},
+	}
+}
+
+func dataSourcePolicyValidationRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).AccessAnalyzerConn
+
+	policyDocument := d.Get("policy_document").(string)
+	policyType := d.Get("policy_type").(string)
+
+	input := &accessanalyzer.ValidatePolicyInput{
+		PolicyDocument: aws.String(policyDocument),
+		PolicyType:     aws.String(policyType),
+	}
+
+	if v, ok := d.GetOk("locale"); ok {
+		input.Locale = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("validate_policy_resource_type"); ok {
+		input.ValidatePolicyResourceType = aws.String(v.(string))
+	}
+
+	var findings []*accessanalyzer.ValidatePolicyFinding
+
+	err := conn.ValidatePolicyPages(input, func(page *accessanalyzer.ValidatePolicyOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, v := range page.Findings {
+			if v != nil {
+				findings = append(findings, v)
+			}
+		}
+
+		return !lastPage
+	})
+
+	if err != nil {
+		return fmt.Errorf("error validating Access Analyzer policy: %w", err)
+	}
+
+	d.SetId(strconv.Itoa(create.StringHashcode(policyType + policyDocument)))
+
+	if err := d.Set("findings", flattenValidatePolicyFindings(findings)); err != nil {
+		return fmt.Errorf("error setting findings: %w", err)
+	}
+
+	return nil
+}
+
+func flattenValidatePolicyFindings(apiObjects []*accessanalyzer.ValidatePolicyFinding) []interface{} {
+	var tfList []interface{}
+
+	for _, apiObject := range apiObjects {
+		tfList = append(tfList, map[string]interface{}{
+			"finding_details": aws.StringValue(apiObject.FindingDetails),
+			"finding_type":    aws.StringValue(apiObject.FindingType),
+			"issue_code":      aws.StringValue(apiObject.IssueCode),
+			"learn_more_link": aws.StringValue(apiObject.LearnMoreLink),
+			"locations":       flattenLocations(apiObject.Locations),
+		})
+	}
+
+	return tfList
+}
+
+func flattenLocations(apiObjects []*accessanalyzer.Location) []interface{} {
+	var tfList []interface{}
+
+	for _, apiObject := range apiObjects {
+		if apiObject == nil {
+			continue
+		}
+
+		tfMap := map[string]interface{}{
+			"path": flattenPathElements(apiObject.Path),
+		}
+
+		if v := apiObject.Span; v != nil {
+			tfMap["span"] = []interface{}{map[string]interface{}{
+				"end":   flattenPosition(v.End),
+				"start": flattenPosition(v.Start),
+			}}
+		}
+
+		tfList = append(tfList, tfMap)
+	}
+
+	return tfList
+}
+
+func flattenPathElements(apiObjects []*accessanalyzer.PathElement) []interface{} {
+	var tfList []interface{}
+
+	for _, apiObject := range apiObjects {
+		if apiObject == nil {
+			continue
+		}
+
+		tfMap := map[string]interface{}{
+			"key":   aws.StringValue(apiObject.Key),
+			"value": aws.StringValue(apiObject.Value),
+		}
+
+		if v := apiObject.Index; v != nil {
+			tfMap["index"] = int(aws.Int64Value(v))
+		}
+
+		if v := apiObject.Substring; v != nil {
+			tfMap["substring"] = []interface{}{map[string]interface{}{
+				"length": int(aws.Int64Value(v.Length)),
+				"start":  int(aws.Int64Value(v.Start)),
+			}}
+		}
+
+		tfList = append(tfList, tfMap)
+	}
+
+	return tfList
+}
+
+func flattenPosition(apiObject *accessanalyzer.Position) []interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	return []interface{}{map[string]interface{}{
+		"column": int(aws.Int64Value(apiObject.Column)),
+		"line":   int(aws.Int64Value(apiObject.Line)),
+		"offset": int(aws.Int64Value(apiObject.Offset)),
+	}}
+}
@@ -0,0 +1,83 @@
+package accessanalyzer_test
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/accessanalyzer"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+)
+
+func TestAccAccessAnalyzerPolicyValidationDataSource_basic(t *testing.T) {
+	dataSourceName := "data.aws_accessanalyzer_policy_validation.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:   func() { acctest.PreCheck(t); testAccPreCheck(t) },
+		ErrorCheck: acctest.ErrorCheck(t, accessanalyzer.EndpointsID),
+		Providers:  acctest.Providers,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccPolicyValidationDataSourceConfig,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet(dataSourceName, "findings.#"),
+					resource.TestCheckTypeSetElemNestedAttrs(dataSourceName, "findings.*", map[string]string{
+						"finding_type": accessanalyzer.ValidatePolicyFindingTypeSecurityWarning,
+						"issue_code":   "PASS_ROLE_WITH_STAR_IN_RESOURCE",
+					}),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAccessAnalyzerPolicyValidationDataSource_noFindings(t *testing.T) {
+	dataSourceName := "data.aws_accessanalyzer_policy_validation.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:   func() { acctest.PreCheck(t); testAccPreCheck(t) },
+		ErrorCheck: acctest.ErrorCheck(t, accessanalyzer.EndpointsID),
+		Providers:  acctest.Providers,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccPolicyValidationDataSourceNoFindingsConfig,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "findings.#", "0"),
+				),
+			},
+		},
+	})
+}
+
+const testAccPolicyValidationDataSourceConfig = `
+data "aws_accessanalyzer_policy_validation" "test" {
+  policy_type = "IDENTITY_POLICY"
+
+  policy_document = jsonencode({
+    Version = "2012-10-17"
+    Statement = [{
+      Effect   = "Allow"
+      Action   = "iam:PassRole"
+      Resource = "*"
+    }]
+  })
+}
+`
+
+const testAccPolicyValidationDataSourceNoFindingsConfig = `
+data "aws_partition" "current" {}
+
+data "aws_caller_identity" "current" {}
+
+data "aws_accessanalyzer_policy_validation" "test" {
+  policy_type = "IDENTITY_POLICY"
+
+  policy_document = jsonencode({
+    Version = "2012-10-17"
+    Statement = [{
+      Effect   = "Allow"
+      Action   = "s3:GetObject"
+      Resource = "arn:${data.aws_partition.current.partition}:s3:::example-${data.aws_caller_identity.current.account_id}/*"
+    }]
+  })
+}
+`
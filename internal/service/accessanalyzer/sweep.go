@@ -15,7 +15,7 @@ import (
 )
 
 func init() {
-	resource.AddTestSweepers("aws_accessanalyzer_analyzer", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_accessanalyzer_analyzer", &resource.Sweeper{
 		Name: "aws_accessanalyzer_analyzer",
 		F:    sweepAnalyzers,
 	})
@@ -55,7 +55,7 @@ func sweepAnalyzers(region string) error {
 		return fmt.Errorf("error listing Access Analyzer Analyzers (%s): %w", region, err)
 	}
 
-	err = sweep.SweepOrchestrator(sweepResources)
+	err = sweep.SweepOrchestrator("aws_accessanalyzer_analyzer", sweepResources)
 
 	if err != nil {
 		return fmt.Errorf("error sweeping Access Analyzer Analyzers (%s): %w", region, err)
@@ -17,7 +17,7 @@ import (
 )
 
 func init() {
-	resource.AddTestSweepers("aws_acmpca_certificate_authority", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_acmpca_certificate_authority", &resource.Sweeper{
 		Name: "aws_acmpca_certificate_authority",
 		F:    sweepCertificateAuthorities,
 	})
@@ -18,12 +18,12 @@ import (
 )
 
 func init() {
-	resource.AddTestSweepers("aws_api_gateway_rest_api", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_api_gateway_rest_api", &resource.Sweeper{
 		Name: "aws_api_gateway_rest_api",
 		F:    sweepRestAPIs,
 	})
 
-	resource.AddTestSweepers("aws_api_gateway_vpc_link", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_api_gateway_vpc_link", &resource.Sweeper{
 		Name: "aws_api_gateway_vpc_link",
 		F:    sweepVPCLinks,
 	})
@@ -102,7 +102,7 @@ func sweepVPCLinks(region string) error {
 		return fmt.Errorf("error retrieving API Gateway VPC Links: %w", err)
 	}
 
-	if err := sweep.SweepOrchestrator(sweepResources); err != nil {
+	if err := sweep.SweepOrchestrator("aws_api_gateway_vpc_link", sweepResources); err != nil {
 		sweeperErrs = multierror.Append(sweeperErrs, fmt.Errorf("error sweeping API Gateway VPC Links: %w", err))
 	}
 
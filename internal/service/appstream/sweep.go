@@ -15,22 +15,22 @@ import (
 )
 
 func init() {
-	resource.AddTestSweepers("aws_appstream_directory_config", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_appstream_directory_config", &resource.Sweeper{
 		Name: "aws_appstream_directory_config",
 		F:    sweepDirectoryConfigs,
 	})
 
-	resource.AddTestSweepers("aws_appstream_fleet", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_appstream_fleet", &resource.Sweeper{
 		Name: "aws_appstream_fleet",
 		F:    sweepFleets,
 	})
 
-	resource.AddTestSweepers("aws_appstream_image_builder", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_appstream_image_builder", &resource.Sweeper{
 		Name: "aws_appstream_image_builder",
 		F:    sweepImageBuilders,
 	})
 
-	resource.AddTestSweepers("aws_appstream_stack", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_appstream_stack", &resource.Sweeper{
 		Name: "aws_appstream_stack",
 		F:    sweepStacks,
 	})
@@ -70,7 +70,7 @@ func sweepDirectoryConfigs(region string) error {
 		return fmt.Errorf("error listing AppStream Directory Configs (%s): %w", region, err)
 	}
 
-	err = sweep.SweepOrchestrator(sweepResources)
+	err = sweep.SweepOrchestrator("aws_appstream_directory_config", sweepResources)
 
 	if err != nil {
 		return fmt.Errorf("error sweeping AppStream Directory Configs (%s): %w", region, err)
@@ -113,7 +113,7 @@ func sweepFleets(region string) error {
 		return fmt.Errorf("error listing AppStream Fleets (%s): %w", region, err)
 	}
 
-	err = sweep.SweepOrchestrator(sweepResources)
+	err = sweep.SweepOrchestrator("aws_appstream_fleet", sweepResources)
 
 	if err != nil {
 		return fmt.Errorf("error sweeping AppStream Fleets (%s): %w", region, err)
@@ -156,7 +156,7 @@ func sweepImageBuilders(region string) error {
 		return fmt.Errorf("error listing AppStream Image Builders (%s): %w", region, err)
 	}
 
-	err = sweep.SweepOrchestrator(sweepResources)
+	err = sweep.SweepOrchestrator("aws_appstream_image_builder", sweepResources)
 
 	if err != nil {
 		return fmt.Errorf("error sweeping AppStream Image Builders (%s): %w", region, err)
@@ -199,7 +199,7 @@ func sweepStacks(region string) error {
 		return fmt.Errorf("error listing AppStream Stacks (%s): %w", region, err)
 	}
 
-	err = sweep.SweepOrchestrator(sweepResources)
+	err = sweep.SweepOrchestrator("aws_appstream_stack", sweepResources)
 
 	if err != nil {
 		return fmt.Errorf("error sweeping AppStream Stacks (%s): %w", region, err)
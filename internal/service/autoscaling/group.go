@@ -900,7 +900,7 @@ func resourceGroupRead(d *schema.ResourceData, meta interface{}) error {
 	if v, tagOk = d.GetOk("tag"); tagOk {
 		proposedStateTags := KeyValueTags(v, d.Id(), TagResourceTypeGroup)
 
-		if err := d.Set("tag", ListOfMap(KeyValueTags(g.Tags, d.Id(), TagResourceTypeGroup).IgnoreAWS().IgnoreConfig(ignoreTagsConfig).Only(proposedStateTags))); err != nil {
+		if err := d.Set("tag", ListOfMap(KeyValueTags(g.Tags, d.Id(), TagResourceTypeGroup).IgnoreAWS().IgnoreConfig(ignoreTagsConfig).FoldKeys(proposedStateTags).Only(proposedStateTags))); err != nil {
 			return fmt.Errorf("error setting tag: %w", err)
 		}
 	}
@@ -908,7 +908,7 @@ func resourceGroupRead(d *schema.ResourceData, meta interface{}) error {
 	if v, tagsOk = d.GetOk("tags"); tagsOk {
 		proposedStateTags := KeyValueTags(v, d.Id(), TagResourceTypeGroup)
 
-		if err := d.Set("tags", ListOfStringMap(KeyValueTags(g.Tags, d.Id(), TagResourceTypeGroup).IgnoreAWS().IgnoreConfig(ignoreTagsConfig).Only(proposedStateTags))); err != nil {
+		if err := d.Set("tags", ListOfStringMap(KeyValueTags(g.Tags, d.Id(), TagResourceTypeGroup).IgnoreAWS().IgnoreConfig(ignoreTagsConfig).FoldKeys(proposedStateTags).Only(proposedStateTags))); err != nil {
 			return fmt.Errorf("error setting tags: %w", err)
 		}
 	}
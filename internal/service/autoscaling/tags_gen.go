@@ -233,7 +233,7 @@ func UpdateTags(conn *autoscaling.AutoScaling, identifier string, resourceType s
 	oldTags := KeyValueTags(oldTagsSet, identifier, resourceType)
 	newTags := KeyValueTags(newTagsSet, identifier, resourceType)
 
-	if removedTags := oldTags.Removed(newTags); len(removedTags) > 0 {
+	if removedTags := oldTags.RemovedFold(newTags); len(removedTags) > 0 {
 		input := &autoscaling.DeleteTagsInput{
 			Tags: Tags(removedTags.IgnoreAWS()),
 		}
@@ -245,7 +245,7 @@ func UpdateTags(conn *autoscaling.AutoScaling, identifier string, resourceType s
 		}
 	}
 
-	if updatedTags := oldTags.Updated(newTags); len(updatedTags) > 0 {
+	if updatedTags := oldTags.UpdatedFold(newTags); len(updatedTags) > 0 {
 		input := &autoscaling.CreateOrUpdateTagsInput{
 			Tags: Tags(updatedTags.IgnoreAWS()),
 		}
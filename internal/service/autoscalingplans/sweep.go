@@ -15,7 +15,7 @@ import (
 )
 
 func init() {
-	resource.AddTestSweepers("aws_autoscalingplans_scaling_plan", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_autoscalingplans_scaling_plan", &resource.Sweeper{
 		Name: "aws_autoscalingplans_scaling_plan",
 		F:    sweepScalingPlans,
 	})
@@ -60,7 +60,7 @@ func sweepScalingPlans(region string) error {
 		return fmt.Errorf("error listing Auto Scaling Scaling Plans (%s): %w", region, err)
 	}
 
-	err = sweep.SweepOrchestrator(sweepResources)
+	err = sweep.SweepOrchestrator("aws_autoscalingplans_scaling_plan", sweepResources)
 
 	if err != nil {
 		return fmt.Errorf("error sweeping Auto Scaling Scaling Plans (%s): %w", region, err)
@@ -16,22 +16,22 @@ import (
 )
 
 func init() {
-	resource.AddTestSweepers("aws_backup_vault_lock_configuration", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_backup_vault_lock_configuration", &resource.Sweeper{
 		Name: "aws_backup_vault_lock_configuration",
 		F:    sweepVaultLockConfiguration,
 	})
 
-	resource.AddTestSweepers("aws_backup_vault_notifications", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_backup_vault_notifications", &resource.Sweeper{
 		Name: "aws_backup_vault_notifications",
 		F:    sweepVaultNotifications,
 	})
 
-	resource.AddTestSweepers("aws_backup_vault_policy", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_backup_vault_policy", &resource.Sweeper{
 		Name: "aws_backup_vault_policy",
 		F:    sweepVaultPolicies,
 	})
 
-	resource.AddTestSweepers("aws_backup_vault", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_backup_vault", &resource.Sweeper{
 		Name: "aws_backup_vault",
 		F:    sweepVaults,
 		Dependencies: []string{
@@ -79,7 +79,7 @@ func sweepVaultLockConfiguration(region string) error {
 		errs = multierror.Append(errs, fmt.Errorf("error listing Backup Vaults for %s: %w", region, err))
 	}
 
-	if err = sweep.SweepOrchestrator(sweepResources); err != nil {
+	if err = sweep.SweepOrchestrator("aws_backup_vault_lock_configuration", sweepResources); err != nil {
 		errs = multierror.Append(errs, fmt.Errorf("error sweeping Backup Vault Lock Configuration for %s: %w", region, err))
 	}
 
@@ -128,7 +128,7 @@ func sweepVaultNotifications(region string) error {
 		errs = multierror.Append(errs, fmt.Errorf("error listing Backup Vaults for %s: %w", region, err))
 	}
 
-	if err = sweep.SweepOrchestrator(sweepResources); err != nil {
+	if err = sweep.SweepOrchestrator("aws_backup_vault_notifications", sweepResources); err != nil {
 		errs = multierror.Append(errs, fmt.Errorf("error sweeping Backup Vault Notifications for %s: %w", region, err))
 	}
 
@@ -175,7 +175,7 @@ func sweepVaultPolicies(region string) error {
 		sweeperErrs = multierror.Append(sweeperErrs, fmt.Errorf("error listing Backup Vaults for %s: %w", region, err))
 	}
 
-	if err := sweep.SweepOrchestrator(sweepResources); err != nil {
+	if err := sweep.SweepOrchestrator("aws_backup_vault_policy", sweepResources); err != nil {
 		sweeperErrs = multierror.Append(sweeperErrs, fmt.Errorf("error sweeping Backup Vault Policies for %s: %w", region, err))
 	}
 
@@ -264,7 +264,7 @@ func sweepVaults(region string) error {
 		sweeperErrs = multierror.Append(sweeperErrs, fmt.Errorf("error listing Backup Vaults for %s: %w", region, err))
 	}
 
-	if err := sweep.SweepOrchestrator(sweepResources); err != nil {
+	if err := sweep.SweepOrchestrator("aws_backup_vault", sweepResources); err != nil {
 		sweeperErrs = multierror.Append(sweeperErrs, fmt.Errorf("error sweeping Backup Vaults for %s: %w", region, err))
 	}
 
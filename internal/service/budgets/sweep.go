@@ -17,12 +17,12 @@ import (
 )
 
 func init() {
-	resource.AddTestSweepers("aws_budgets_budget_action", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_budgets_budget_action", &resource.Sweeper{
 		Name: "aws_budgets_budget_action",
 		F:    sweepBudgetActionss,
 	})
 
-	resource.AddTestSweepers("aws_budgets_budget", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_budgets_budget", &resource.Sweeper{
 		Name: "aws_budgets_budget",
 		F:    sweepBudgets,
 	})
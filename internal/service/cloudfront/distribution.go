@@ -1,6 +1,7 @@
 package cloudfront
 
 import (
+	"context"
 	"fmt"
 	"log"
 	"time"
@@ -9,6 +10,7 @@ import (
 	"github.com/aws/aws-sdk-go/aws/endpoints"
 	"github.com/aws/aws-sdk-go/service/cloudfront"
 	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
@@ -21,10 +23,10 @@ import (
 func ResourceDistribution() *schema.Resource {
 	//lintignore:R011
 	return &schema.Resource{
-		Create: resourceDistributionCreate,
-		Read:   resourceDistributionRead,
-		Update: resourceDistributionUpdate,
-		Delete: resourceDistributionDelete,
+		CreateContext: resourceDistributionCreate,
+		ReadContext:   resourceDistributionRead,
+		UpdateContext: resourceDistributionUpdate,
+		DeleteContext: resourceDistributionDelete,
 		Importer: &schema.ResourceImporter{
 			State: func(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
 				// Set non API attributes to their Default settings in the schema
@@ -817,7 +819,7 @@ func ResourceDistribution() *schema.Resource {
 	}
 }
 
-func resourceDistributionCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceDistributionCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	conn := meta.(*conns.AWSClient).CloudFrontConn
 	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
 	tags := defaultTagsConfig.MergeTags(tftags.New(d.Get("tags").(map[string]interface{})))
@@ -833,7 +835,7 @@ func resourceDistributionCreate(d *schema.ResourceData, meta interface{}) error
 	// Handle eventual consistency issues
 	err := resource.Retry(1*time.Minute, func() *resource.RetryError {
 		var err error
-		resp, err = conn.CreateDistributionWithTags(params)
+		resp, err = conn.CreateDistributionWithTagsWithContext(ctx, params)
 
 		// ACM and IAM certificate eventual consistency
 		// InvalidViewerCertificate: The specified SSL certificate doesn't exist, isn't in us-east-1 region, isn't valid, or doesn't include a valid certificate chain.
@@ -850,26 +852,26 @@ func resourceDistributionCreate(d *schema.ResourceData, meta interface{}) error
 
 	// Propagate AWS Go SDK retried error, if any
 	if tfresource.TimedOut(err) {
-		resp, err = conn.CreateDistributionWithTags(params)
+		resp, err = conn.CreateDistributionWithTagsWithContext(ctx, params)
 	}
 
 	if err != nil {
-		return fmt.Errorf("error creating CloudFront Distribution: %s", err)
+		return diag.FromErr(fmt.Errorf("error creating CloudFront Distribution: %s", err))
 	}
 
 	d.SetId(aws.StringValue(resp.Distribution.Id))
 
 	if d.Get("wait_for_deployment").(bool) {
 		log.Printf("[DEBUG] Waiting until CloudFront Distribution (%s) is deployed", d.Id())
-		if err := DistributionWaitUntilDeployed(d.Id(), meta); err != nil {
-			return fmt.Errorf("error waiting until CloudFront Distribution (%s) is deployed: %s", d.Id(), err)
+		if err := DistributionWaitUntilDeployed(ctx, d.Id(), meta); err != nil {
+			return diag.FromErr(fmt.Errorf("error waiting until CloudFront Distribution (%s) is deployed: %s", d.Id(), err))
 		}
 	}
 
-	return resourceDistributionRead(d, meta)
+	return resourceDistributionRead(ctx, d, meta)
 }
 
-func resourceDistributionRead(d *schema.ResourceData, meta interface{}) error {
+func resourceDistributionRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	conn := meta.(*conns.AWSClient).CloudFrontConn
 	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
 	ignoreTagsConfig := meta.(*conns.AWSClient).IgnoreTagsConfig
@@ -878,7 +880,7 @@ func resourceDistributionRead(d *schema.ResourceData, meta interface{}) error {
 		Id: aws.String(d.Id()),
 	}
 
-	resp, err := conn.GetDistribution(params)
+	resp, err := conn.GetDistributionWithContext(ctx, params)
 	if err != nil {
 		if tfawserr.ErrMessageContains(err, cloudfront.ErrCodeNoSuchDistribution, "") {
 			log.Printf("[WARN] No Distribution found: %s", d.Id())
@@ -886,21 +888,21 @@ func resourceDistributionRead(d *schema.ResourceData, meta interface{}) error {
 			return nil
 		}
 
-		return err
+		return diag.FromErr(err)
 	}
 
 	// Update attributes from DistributionConfig
 	err = flattenDistributionConfig(d, resp.Distribution.DistributionConfig)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	// Update other attributes outside of DistributionConfig
 	if err := d.Set("trusted_key_groups", flattenCloudfrontActiveTrustedKeyGroups(resp.Distribution.ActiveTrustedKeyGroups)); err != nil {
-		return fmt.Errorf("error setting trusted_key_groups: %w", err)
+		return diag.FromErr(fmt.Errorf("error setting trusted_key_groups: %w", err))
 	}
 	if err := d.Set("trusted_signers", flattenCloudfrontActiveTrustedSigners(resp.Distribution.ActiveTrustedSigners)); err != nil {
-		return fmt.Errorf("error setting trusted_signers: %w", err)
+		return diag.FromErr(fmt.Errorf("error setting trusted_signers: %w", err))
 	}
 	d.Set("status", resp.Distribution.Status)
 	d.Set("domain_name", resp.Distribution.DomainName)
@@ -919,23 +921,23 @@ func resourceDistributionRead(d *schema.ResourceData, meta interface{}) error {
 
 	tags, err := ListTags(conn, d.Get("arn").(string))
 	if err != nil {
-		return fmt.Errorf("error listing tags for CloudFront Distribution (%s): %s", d.Id(), err)
+		return diag.FromErr(fmt.Errorf("error listing tags for CloudFront Distribution (%s): %s", d.Id(), err))
 	}
 	tags = tags.IgnoreAWS().IgnoreConfig(ignoreTagsConfig)
 
 	//lintignore:AWSR002
 	if err := d.Set("tags", tags.RemoveDefaultConfig(defaultTagsConfig).Map()); err != nil {
-		return fmt.Errorf("error setting tags: %w", err)
+		return diag.FromErr(fmt.Errorf("error setting tags: %w", err))
 	}
 
 	if err := d.Set("tags_all", tags.Map()); err != nil {
-		return fmt.Errorf("error setting tags_all: %w", err)
+		return diag.FromErr(fmt.Errorf("error setting tags_all: %w", err))
 	}
 
 	return nil
 }
 
-func resourceDistributionUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceDistributionUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	conn := meta.(*conns.AWSClient).CloudFrontConn
 	params := &cloudfront.UpdateDistributionInput{
 		Id:                 aws.String(d.Id()),
@@ -945,7 +947,7 @@ func resourceDistributionUpdate(d *schema.ResourceData, meta interface{}) error
 
 	// Handle eventual consistency issues
 	err := resource.Retry(1*time.Minute, func() *resource.RetryError {
-		_, err := conn.UpdateDistribution(params)
+		_, err := conn.UpdateDistributionWithContext(ctx, params)
 
 		// ACM and IAM certificate eventual consistency
 		// InvalidViewerCertificate: The specified SSL certificate doesn't exist, isn't in us-east-1 region, isn't valid, or doesn't include a valid certificate chain.
@@ -962,31 +964,31 @@ func resourceDistributionUpdate(d *schema.ResourceData, meta interface{}) error
 
 	// Propagate AWS Go SDK retried error, if any
 	if tfresource.TimedOut(err) {
-		_, err = conn.UpdateDistribution(params)
+		_, err = conn.UpdateDistributionWithContext(ctx, params)
 	}
 
 	if err != nil {
-		return fmt.Errorf("error updating CloudFront Distribution (%s): %s", d.Id(), err)
+		return diag.FromErr(fmt.Errorf("error updating CloudFront Distribution (%s): %s", d.Id(), err))
 	}
 
 	if d.Get("wait_for_deployment").(bool) {
 		log.Printf("[DEBUG] Waiting until CloudFront Distribution (%s) is deployed", d.Id())
-		if err := DistributionWaitUntilDeployed(d.Id(), meta); err != nil {
-			return fmt.Errorf("error waiting until CloudFront Distribution (%s) is deployed: %s", d.Id(), err)
+		if err := DistributionWaitUntilDeployed(ctx, d.Id(), meta); err != nil {
+			return diag.FromErr(fmt.Errorf("error waiting until CloudFront Distribution (%s) is deployed: %s", d.Id(), err))
 		}
 	}
 
 	if d.HasChange("tags_all") {
 		o, n := d.GetChange("tags_all")
 		if err := UpdateTags(conn, d.Get("arn").(string), o, n); err != nil {
-			return fmt.Errorf("error updating tags for CloudFront Distribution (%s): %s", d.Id(), err)
+			return diag.FromErr(fmt.Errorf("error updating tags for CloudFront Distribution (%s): %s", d.Id(), err))
 		}
 	}
 
-	return resourceDistributionRead(d, meta)
+	return resourceDistributionRead(ctx, d, meta)
 }
 
-func resourceDistributionDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceDistributionDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	conn := meta.(*conns.AWSClient).CloudFrontConn
 
 	if d.Get("retain_on_delete").(bool) {
@@ -996,14 +998,14 @@ func resourceDistributionDelete(d *schema.ResourceData, meta interface{}) error
 		}
 
 		log.Printf("[DEBUG] Refreshing CloudFront Distribution (%s) to check if disable is necessary", d.Id())
-		getDistributionOutput, err := conn.GetDistribution(getDistributionInput)
+		getDistributionOutput, err := conn.GetDistributionWithContext(ctx, getDistributionInput)
 
 		if err != nil {
-			return fmt.Errorf("error refreshing CloudFront Distribution (%s) to check if disable is necessary: %s", d.Id(), err)
+			return diag.FromErr(fmt.Errorf("error refreshing CloudFront Distribution (%s) to check if disable is necessary: %s", d.Id(), err))
 		}
 
 		if getDistributionOutput == nil || getDistributionOutput.Distribution == nil || getDistributionOutput.Distribution.DistributionConfig == nil {
-			return fmt.Errorf("error refreshing CloudFront Distribution (%s) to check if disable is necessary: empty response", d.Id())
+			return diag.FromErr(fmt.Errorf("error refreshing CloudFront Distribution (%s) to check if disable is necessary: empty response", d.Id()))
 		}
 
 		if !aws.BoolValue(getDistributionOutput.Distribution.DistributionConfig.Enabled) {
@@ -1019,10 +1021,10 @@ func resourceDistributionDelete(d *schema.ResourceData, meta interface{}) error
 		updateDistributionInput.DistributionConfig.Enabled = aws.Bool(false)
 
 		log.Printf("[DEBUG] Disabling CloudFront Distribution: %s", d.Id())
-		_, err = conn.UpdateDistribution(updateDistributionInput)
+		_, err = conn.UpdateDistributionWithContext(ctx, updateDistributionInput)
 
 		if err != nil {
-			return fmt.Errorf("error disabling CloudFront Distribution (%s): %s", d.Id(), err)
+			return diag.FromErr(fmt.Errorf("error disabling CloudFront Distribution (%s): %s", d.Id(), err))
 		}
 
 		log.Printf("[WARN] Removing CloudFront Distribution ID %q with `retain_on_delete` set. Please delete this distribution manually.", d.Id())
@@ -1035,7 +1037,7 @@ func resourceDistributionDelete(d *schema.ResourceData, meta interface{}) error
 	}
 
 	log.Printf("[DEBUG] Deleting CloudFront Distribution: %s", d.Id())
-	_, err := conn.DeleteDistribution(deleteDistributionInput)
+	_, err := conn.DeleteDistributionWithContext(ctx, deleteDistributionInput)
 
 	if err == nil || tfawserr.ErrMessageContains(err, cloudfront.ErrCodeNoSuchDistribution, "") {
 		return nil
@@ -1049,19 +1051,19 @@ func resourceDistributionDelete(d *schema.ResourceData, meta interface{}) error
 		var getDistributionOutput *cloudfront.GetDistributionOutput
 
 		log.Printf("[DEBUG] Refreshing CloudFront Distribution (%s) ETag", d.Id())
-		getDistributionOutput, err = conn.GetDistribution(getDistributionInput)
+		getDistributionOutput, err = conn.GetDistributionWithContext(ctx, getDistributionInput)
 
 		if err != nil {
-			return fmt.Errorf("error refreshing CloudFront Distribution (%s) ETag: %s", d.Id(), err)
+			return diag.FromErr(fmt.Errorf("error refreshing CloudFront Distribution (%s) ETag: %s", d.Id(), err))
 		}
 
 		if getDistributionOutput == nil {
-			return fmt.Errorf("error refreshing CloudFront Distribution (%s) ETag: empty response", d.Id())
+			return diag.FromErr(fmt.Errorf("error refreshing CloudFront Distribution (%s) ETag: empty response", d.Id()))
 		}
 
 		deleteDistributionInput.IfMatch = getDistributionOutput.ETag
 
-		_, err = conn.DeleteDistribution(deleteDistributionInput)
+		_, err = conn.DeleteDistributionWithContext(ctx, deleteDistributionInput)
 	}
 
 	// Disable distribution if it is not yet disabled and attempt deletion again.
@@ -1074,14 +1076,14 @@ func resourceDistributionDelete(d *schema.ResourceData, meta interface{}) error
 		var getDistributionOutput *cloudfront.GetDistributionOutput
 
 		log.Printf("[DEBUG] Refreshing CloudFront Distribution (%s) to disable", d.Id())
-		getDistributionOutput, err = conn.GetDistribution(getDistributionInput)
+		getDistributionOutput, err = conn.GetDistributionWithContext(ctx, getDistributionInput)
 
 		if err != nil {
-			return fmt.Errorf("error refreshing CloudFront Distribution (%s) to disable: %s", d.Id(), err)
+			return diag.FromErr(fmt.Errorf("error refreshing CloudFront Distribution (%s) to disable: %s", d.Id(), err))
 		}
 
 		if getDistributionOutput == nil || getDistributionOutput.Distribution == nil {
-			return fmt.Errorf("error refreshing CloudFront Distribution (%s) to disable: empty response", d.Id())
+			return diag.FromErr(fmt.Errorf("error refreshing CloudFront Distribution (%s) to disable: empty response", d.Id()))
 		}
 
 		updateDistributionInput := &cloudfront.UpdateDistributionInput{
@@ -1093,27 +1095,27 @@ func resourceDistributionDelete(d *schema.ResourceData, meta interface{}) error
 		var updateDistributionOutput *cloudfront.UpdateDistributionOutput
 
 		log.Printf("[DEBUG] Disabling CloudFront Distribution: %s", d.Id())
-		updateDistributionOutput, err = conn.UpdateDistribution(updateDistributionInput)
+		updateDistributionOutput, err = conn.UpdateDistributionWithContext(ctx, updateDistributionInput)
 
 		if err != nil {
-			return fmt.Errorf("error disabling CloudFront Distribution (%s): %s", d.Id(), err)
+			return diag.FromErr(fmt.Errorf("error disabling CloudFront Distribution (%s): %s", d.Id(), err))
 		}
 
 		log.Printf("[DEBUG] Waiting until CloudFront Distribution (%s) is deployed", d.Id())
-		if err := DistributionWaitUntilDeployed(d.Id(), meta); err != nil {
-			return fmt.Errorf("error waiting until CloudFront Distribution (%s) is deployed: %s", d.Id(), err)
+		if err := DistributionWaitUntilDeployed(ctx, d.Id(), meta); err != nil {
+			return diag.FromErr(fmt.Errorf("error waiting until CloudFront Distribution (%s) is deployed: %s", d.Id(), err))
 		}
 
 		deleteDistributionInput.IfMatch = updateDistributionOutput.ETag
 
-		_, err = conn.DeleteDistribution(deleteDistributionInput)
+		_, err = conn.DeleteDistributionWithContext(ctx, deleteDistributionInput)
 
 		// CloudFront has eventual consistency issues even for "deployed" state.
 		// Occasionally the DeleteDistribution call will return this error as well, in which retries will succeed:
 		//   * PreconditionFailed: The request failed because it didn't meet the preconditions in one or more request-header fields
 		if tfawserr.ErrMessageContains(err, cloudfront.ErrCodeDistributionNotDisabled, "") || tfawserr.ErrMessageContains(err, cloudfront.ErrCodePreconditionFailed, "") {
 			err = resource.Retry(2*time.Minute, func() *resource.RetryError {
-				_, err := conn.DeleteDistribution(deleteDistributionInput)
+				_, err := conn.DeleteDistributionWithContext(ctx, deleteDistributionInput)
 
 				if tfawserr.ErrMessageContains(err, cloudfront.ErrCodeDistributionNotDisabled, "") {
 					return resource.RetryableError(err)
@@ -1136,7 +1138,7 @@ func resourceDistributionDelete(d *schema.ResourceData, meta interface{}) error
 
 			// Propagate AWS Go SDK retried error, if any
 			if tfresource.TimedOut(err) {
-				_, err = conn.DeleteDistribution(deleteDistributionInput)
+				_, err = conn.DeleteDistributionWithContext(ctx, deleteDistributionInput)
 			}
 		}
 	}
@@ -1146,7 +1148,7 @@ func resourceDistributionDelete(d *schema.ResourceData, meta interface{}) error
 	}
 
 	if err != nil {
-		return fmt.Errorf("CloudFront Distribution %s cannot be deleted: %s", d.Id(), err)
+		return diag.FromErr(fmt.Errorf("CloudFront Distribution %s cannot be deleted: %s", d.Id(), err))
 	}
 
 	return nil
@@ -1155,17 +1157,18 @@ func resourceDistributionDelete(d *schema.ResourceData, meta interface{}) error
 // resourceAwsCloudFrontWebDistributionWaitUntilDeployed blocks until the
 // distribution is deployed. It currently takes exactly 15 minutes to deploy
 // but that might change in the future.
-func DistributionWaitUntilDeployed(id string, meta interface{}) error {
-	stateConf := &resource.StateChangeConf{
-		Pending:    []string{"InProgress"},
-		Target:     []string{"Deployed"},
-		Refresh:    resourceWebDistributionStateRefreshFunc(id, meta),
-		Timeout:    90 * time.Minute,
-		MinTimeout: 15 * time.Second,
-		Delay:      1 * time.Minute,
+func DistributionWaitUntilDeployed(ctx context.Context, id string, meta interface{}) error {
+	stateConf := &tfresource.StateChangeConf{
+		Pending:     []string{"InProgress"},
+		Target:      []string{"Deployed"},
+		Refresh:     resourceWebDistributionStateRefreshFunc(id, meta),
+		Timeout:     90 * time.Minute,
+		MinTimeout:  15 * time.Second,
+		Delay:       1 * time.Minute,
+		Description: fmt.Sprintf("CloudFront Distribution (%s) deployment", id),
 	}
 
-	_, err := stateConf.WaitForState()
+	_, err := stateConf.WaitForStateContext(ctx)
 	return err
 }
 
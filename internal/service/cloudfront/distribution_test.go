@@ -1,6 +1,7 @@
 package cloudfront_test
 
 import (
+	"context"
 	"fmt"
 	"os"
 	"regexp"
@@ -1363,7 +1364,7 @@ func testAccCheckCloudFrontDistributionDisappears(distribution *cloudfront.Distr
 
 func testAccCheckCloudFrontDistributionWaitForDeployment(distribution *cloudfront.Distribution) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
-		return tfcloudfront.DistributionWaitUntilDeployed(aws.StringValue(distribution.Id), acctest.Provider.Meta())
+		return tfcloudfront.DistributionWaitUntilDeployed(context.Background(), aws.StringValue(distribution.Id), acctest.Provider.Meta())
 	}
 }
 
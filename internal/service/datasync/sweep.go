@@ -17,47 +17,47 @@ import (
 )
 
 func init() {
-	resource.AddTestSweepers("aws_datasync_agent", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_datasync_agent", &resource.Sweeper{
 		Name: "aws_datasync_agent",
 		F:    sweepAgents,
 	})
 
-	resource.AddTestSweepers("aws_datasync_location_efs", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_datasync_location_efs", &resource.Sweeper{
 		Name: "aws_datasync_location_efs",
 		F:    sweepLocationEFSs,
 	})
 
-	resource.AddTestSweepers("aws_datasync_location_fsx_windows_file_system", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_datasync_location_fsx_windows_file_system", &resource.Sweeper{
 		Name: "aws_datasync_location_fsx_windows_file_system",
 		F:    sweepLocationFSxWindows,
 	})
 
-	resource.AddTestSweepers("aws_datasync_location_fsx_lustre_file_system", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_datasync_location_fsx_lustre_file_system", &resource.Sweeper{
 		Name: "aws_datasync_location_fsx_lustre_file_system",
 		F:    sweepLocationFSxLustres,
 	})
 
-	resource.AddTestSweepers("aws_datasync_location_nfs", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_datasync_location_nfs", &resource.Sweeper{
 		Name: "aws_datasync_location_nfs",
 		F:    sweepLocationNFSs,
 	})
 
-	resource.AddTestSweepers("aws_datasync_location_s3", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_datasync_location_s3", &resource.Sweeper{
 		Name: "aws_datasync_location_s3",
 		F:    sweepLocationS3s,
 	})
 
-	resource.AddTestSweepers("aws_datasync_location_smb", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_datasync_location_smb", &resource.Sweeper{
 		Name: "aws_datasync_location_smb",
 		F:    sweepLocationSMBs,
 	})
 
-	resource.AddTestSweepers("aws_datasync_location_hdfs", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_datasync_location_hdfs", &resource.Sweeper{
 		Name: "aws_datasync_location_hdfs",
 		F:    sweepLocationHdfss,
 	})
 
-	resource.AddTestSweepers("aws_datasync_task", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_datasync_task", &resource.Sweeper{
 		Name: "aws_datasync_task",
 		F:    sweepTasks,
 	})
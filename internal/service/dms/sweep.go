@@ -16,7 +16,7 @@ import (
 )
 
 func init() {
-	resource.AddTestSweepers("aws_dms_replication_instance", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_dms_replication_instance", &resource.Sweeper{
 		Name: "aws_dms_replication_instance",
 		F:    sweepReplicationInstances,
 		Dependencies: []string{
@@ -24,7 +24,7 @@ func init() {
 		},
 	})
 
-	resource.AddTestSweepers("aws_dms_replication_task", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_dms_replication_task", &resource.Sweeper{
 		Name: "aws_dms_replication_task",
 		F:    sweepReplicationTasks,
 	})
@@ -58,7 +58,7 @@ func sweepReplicationInstances(region string) error {
 		errs = multierror.Append(errs, fmt.Errorf("error describing DMS Replication Instances: %w", err))
 	}
 
-	if err = sweep.SweepOrchestrator(sweepResources); err != nil {
+	if err = sweep.SweepOrchestrator("aws_dms_replication_instance", sweepResources); err != nil {
 		errs = multierror.Append(errs, fmt.Errorf("error sweeping DMS Replication Instances for %s: %w", region, err))
 	}
 
@@ -101,7 +101,7 @@ func sweepReplicationTasks(region string) error {
 		errs = multierror.Append(errs, fmt.Errorf("error describing DMS Replication Tasks: %w", err))
 	}
 
-	if err = sweep.SweepOrchestrator(sweepResources); err != nil {
+	if err = sweep.SweepOrchestrator("aws_dms_replication_task", sweepResources); err != nil {
 		errs = multierror.Append(errs, fmt.Errorf("error sweeping DMS Replication Tasks for %s: %w", region, err))
 	}
 
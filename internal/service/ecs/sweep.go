@@ -17,7 +17,7 @@ import (
 )
 
 func init() {
-	resource.AddTestSweepers("aws_ecs_capacity_provider", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_ecs_capacity_provider", &resource.Sweeper{
 		Name: "aws_ecs_capacity_provider",
 		F:    sweepCapacityProviders,
 		Dependencies: []string{
@@ -26,7 +26,7 @@ func init() {
 		},
 	})
 
-	resource.AddTestSweepers("aws_ecs_cluster", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_ecs_cluster", &resource.Sweeper{
 		Name: "aws_ecs_cluster",
 		F:    sweepClusters,
 		Dependencies: []string{
@@ -34,12 +34,12 @@ func init() {
 		},
 	})
 
-	resource.AddTestSweepers("aws_ecs_service", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_ecs_service", &resource.Sweeper{
 		Name: "aws_ecs_service",
 		F:    sweepServices,
 	})
 
-	resource.AddTestSweepers("aws_ecs_task_definition", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_ecs_task_definition", &resource.Sweeper{
 		Name: "aws_ecs_task_definition",
 		F:    sweepTaskDefinitions,
 		Dependencies: []string{
@@ -90,7 +90,7 @@ func sweepCapacityProviders(region string) error {
 		sweeperErrs = multierror.Append(sweeperErrs, fmt.Errorf("error listing ECS Capacity Providers for %s: %w", region, err))
 	}
 
-	if err := sweep.SweepOrchestrator(sweepResources); err != nil {
+	if err := sweep.SweepOrchestrator("aws_ecs_capacity_provider", sweepResources); err != nil {
 		sweeperErrs = multierror.Append(sweeperErrs, fmt.Errorf("error sweeping ECS Capacity Providers for %s: %w", region, err))
 	}
 
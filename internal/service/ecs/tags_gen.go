@@ -91,7 +91,7 @@ func UpdateTags(conn *ecs.ECS, identifier string, oldTagsMap interface{}, newTag
 	oldTags := tftags.New(oldTagsMap)
 	newTags := tftags.New(newTagsMap)
 
-	if removedTags := oldTags.Removed(newTags); len(removedTags) > 0 {
+	if removedTags := oldTags.RemovedFold(newTags); len(removedTags) > 0 {
 		input := &ecs.UntagResourceInput{
 			ResourceArn: aws.String(identifier),
 			TagKeys:     aws.StringSlice(removedTags.IgnoreAWS().Keys()),
@@ -104,7 +104,7 @@ func UpdateTags(conn *ecs.ECS, identifier string, oldTagsMap interface{}, newTag
 		}
 	}
 
-	if updatedTags := oldTags.Updated(newTags); len(updatedTags) > 0 {
+	if updatedTags := oldTags.UpdatedFold(newTags); len(updatedTags) > 0 {
 		input := &ecs.TagResourceInput{
 			ResourceArn: aws.String(identifier),
 			Tags:        Tags(updatedTags.IgnoreAWS()),
@@ -444,6 +444,9 @@ func resourceTaskSetRead(d *schema.ResourceData, meta interface{}) error {
 
 	tags := KeyValueTags(taskSet.Tags).IgnoreAWS().IgnoreConfig(ignoreTagsConfig)
 
+	// ECS may return tag keys with different casing than configured.
+	tags = tags.FoldKeys(defaultTagsConfig.MergeTags(tftags.New(d.Get("tags").(map[string]interface{}))))
+
 	//lintignore:AWSR002
 	if err := d.Set("tags", tags.RemoveDefaultConfig(defaultTagsConfig).Map()); err != nil {
 		return fmt.Errorf("error setting tags: %w", err)
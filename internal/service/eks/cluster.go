@@ -10,6 +10,7 @@ import (
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/eks"
 	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -24,12 +25,12 @@ import (
 
 func ResourceCluster() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceClusterCreate,
-		Read:   resourceClusterRead,
-		Update: resourceClusterUpdate,
-		Delete: resourceClusterDelete,
+		CreateContext: resourceClusterCreate,
+		ReadContext:   resourceClusterRead,
+		UpdateContext: resourceClusterUpdate,
+		DeleteContext: resourceClusterDelete,
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: schema.ImportStatePassthroughContext,
 		},
 
 		CustomizeDiff: customdiff.Sequence(
@@ -231,7 +232,7 @@ func ResourceCluster() *schema.Resource {
 	}
 }
 
-func resourceClusterCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceClusterCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	conn := meta.(*conns.AWSClient).EKSConn
 	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
 	tags := defaultTagsConfig.MergeTags(tftags.New(d.Get("tags").(map[string]interface{})))
@@ -259,10 +260,10 @@ func resourceClusterCreate(d *schema.ResourceData, meta interface{}) error {
 
 	log.Printf("[DEBUG] Creating EKS Cluster: %s", input)
 	var output *eks.CreateClusterOutput
-	err := resource.Retry(tfiam.PropagationTimeout, func() *resource.RetryError {
+	err := resource.RetryContext(ctx, tfiam.PropagationTimeout, func() *resource.RetryError {
 		var err error
 
-		output, err = conn.CreateCluster(input)
+		output, err = conn.CreateClusterWithContext(ctx, input)
 
 		// InvalidParameterException: roleArn, arn:aws:iam::123456789012:role/XXX, does not exist
 		if tfawserr.ErrMessageContains(err, eks.ErrCodeInvalidParameterException, "does not exist") {
@@ -296,25 +297,25 @@ func resourceClusterCreate(d *schema.ResourceData, meta interface{}) error {
 	})
 
 	if tfresource.TimedOut(err) {
-		output, err = conn.CreateCluster(input)
+		output, err = conn.CreateClusterWithContext(ctx, input)
 	}
 
 	if err != nil {
-		return fmt.Errorf("error creating EKS Cluster (%s): %w", name, err)
+		return diag.FromErr(fmt.Errorf("error creating EKS Cluster (%s): %w", name, err))
 	}
 
 	d.SetId(aws.StringValue(output.Cluster.Name))
 
-	_, err = waitClusterCreated(conn, d.Id(), d.Timeout(schema.TimeoutCreate))
+	_, err = waitClusterCreated(ctx, conn, d.Id(), d.Timeout(schema.TimeoutCreate))
 
 	if err != nil {
-		return fmt.Errorf("error waiting for EKS Cluster (%s) to create: %w", d.Id(), err)
+		return diag.FromErr(fmt.Errorf("error waiting for EKS Cluster (%s) to create: %w", d.Id(), err))
 	}
 
-	return resourceClusterRead(d, meta)
+	return resourceClusterRead(ctx, d, meta)
 }
 
-func resourceClusterRead(d *schema.ResourceData, meta interface{}) error {
+func resourceClusterRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	conn := meta.(*conns.AWSClient).EKSConn
 	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
 	ignoreTagsConfig := meta.(*conns.AWSClient).IgnoreTagsConfig
@@ -328,33 +329,33 @@ func resourceClusterRead(d *schema.ResourceData, meta interface{}) error {
 	}
 
 	if err != nil {
-		return fmt.Errorf("error reading EKS Cluster (%s): %w", d.Id(), err)
+		return diag.FromErr(fmt.Errorf("error reading EKS Cluster (%s): %w", d.Id(), err))
 	}
 
 	d.Set("arn", cluster.Arn)
 
 	if err := d.Set("certificate_authority", flattenEksCertificate(cluster.CertificateAuthority)); err != nil {
-		return fmt.Errorf("error setting certificate_authority: %w", err)
+		return diag.FromErr(fmt.Errorf("error setting certificate_authority: %w", err))
 	}
 
 	d.Set("created_at", aws.TimeValue(cluster.CreatedAt).String())
 
 	if err := d.Set("enabled_cluster_log_types", flattenEksEnabledLogTypes(cluster.Logging)); err != nil {
-		return fmt.Errorf("error setting enabled_cluster_log_types: %w", err)
+		return diag.FromErr(fmt.Errorf("error setting enabled_cluster_log_types: %w", err))
 	}
 
 	if err := d.Set("encryption_config", flattenEksEncryptionConfig(cluster.EncryptionConfig)); err != nil {
-		return fmt.Errorf("error setting encryption_config: %w", err)
+		return diag.FromErr(fmt.Errorf("error setting encryption_config: %w", err))
 	}
 
 	d.Set("endpoint", cluster.Endpoint)
 
 	if err := d.Set("identity", flattenEksIdentity(cluster.Identity)); err != nil {
-		return fmt.Errorf("error setting identity: %w", err)
+		return diag.FromErr(fmt.Errorf("error setting identity: %w", err))
 	}
 
 	if err := d.Set("kubernetes_network_config", flattenEksNetworkConfig(cluster.KubernetesNetworkConfig)); err != nil {
-		return fmt.Errorf("error setting kubernetes_network_config: %w", err)
+		return diag.FromErr(fmt.Errorf("error setting kubernetes_network_config: %w", err))
 	}
 
 	d.Set("name", cluster.Name)
@@ -364,24 +365,24 @@ func resourceClusterRead(d *schema.ResourceData, meta interface{}) error {
 	d.Set("version", cluster.Version)
 
 	if err := d.Set("vpc_config", flattenEksVpcConfigResponse(cluster.ResourcesVpcConfig)); err != nil {
-		return fmt.Errorf("error setting vpc_config: %w", err)
+		return diag.FromErr(fmt.Errorf("error setting vpc_config: %w", err))
 	}
 
 	tags := KeyValueTags(cluster.Tags).IgnoreAWS().IgnoreConfig(ignoreTagsConfig)
 
 	//lintignore:AWSR002
 	if err := d.Set("tags", tags.RemoveDefaultConfig(defaultTagsConfig).Map()); err != nil {
-		return fmt.Errorf("error setting tags: %w", err)
+		return diag.FromErr(fmt.Errorf("error setting tags: %w", err))
 	}
 
 	if err := d.Set("tags_all", tags.Map()); err != nil {
-		return fmt.Errorf("error setting tags_all: %w", err)
+		return diag.FromErr(fmt.Errorf("error setting tags_all: %w", err))
 	}
 
 	return nil
 }
 
-func resourceClusterUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceClusterUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	conn := meta.(*conns.AWSClient).EKSConn
 
 	// Do any version update first.
@@ -392,18 +393,18 @@ func resourceClusterUpdate(d *schema.ResourceData, meta interface{}) error {
 		}
 
 		log.Printf("[DEBUG] Updating EKS Cluster (%s) version: %s", d.Id(), input)
-		output, err := conn.UpdateClusterVersion(input)
+		output, err := conn.UpdateClusterVersionWithContext(ctx, input)
 
 		if err != nil {
-			return fmt.Errorf("error updating EKS Cluster (%s) version: %w", d.Id(), err)
+			return diag.FromErr(fmt.Errorf("error updating EKS Cluster (%s) version: %w", d.Id(), err))
 		}
 
 		updateID := aws.StringValue(output.Update.Id)
 
-		_, err = waitClusterUpdateSuccessful(conn, d.Id(), updateID, d.Timeout(schema.TimeoutUpdate))
+		_, err = waitClusterUpdateSuccessful(ctx, conn, d.Id(), updateID, d.Timeout(schema.TimeoutUpdate))
 
 		if err != nil {
-			return fmt.Errorf("error waiting for EKS Cluster (%s) version update (%s): %w", d.Id(), updateID, err)
+			return diag.FromErr(fmt.Errorf("error waiting for EKS Cluster (%s) version update (%s): %w", d.Id(), updateID, err))
 		}
 	}
 
@@ -417,18 +418,18 @@ func resourceClusterUpdate(d *schema.ResourceData, meta interface{}) error {
 			}
 
 			log.Printf("[DEBUG] Associating EKS Cluster (%s) encryption config: %s", d.Id(), input)
-			output, err := conn.AssociateEncryptionConfig(input)
+			output, err := conn.AssociateEncryptionConfigWithContext(ctx, input)
 
 			if err != nil {
-				return fmt.Errorf("error associating EKS Cluster (%s) encryption config: %w", d.Id(), err)
+				return diag.FromErr(fmt.Errorf("error associating EKS Cluster (%s) encryption config: %w", d.Id(), err))
 			}
 
 			updateID := aws.StringValue(output.Update.Id)
 
-			_, err = waitClusterUpdateSuccessful(conn, d.Id(), updateID, d.Timeout(schema.TimeoutUpdate))
+			_, err = waitClusterUpdateSuccessful(ctx, conn, d.Id(), updateID, d.Timeout(schema.TimeoutUpdate))
 
 			if err != nil {
-				return fmt.Errorf("error waiting for EKS Cluster (%s) encryption config association (%s): %w", d.Id(), updateID, err)
+				return diag.FromErr(fmt.Errorf("error waiting for EKS Cluster (%s) encryption config association (%s): %w", d.Id(), updateID, err))
 			}
 		}
 	}
@@ -440,18 +441,18 @@ func resourceClusterUpdate(d *schema.ResourceData, meta interface{}) error {
 		}
 
 		log.Printf("[DEBUG] Updating EKS Cluster (%s) logging: %s", d.Id(), input)
-		output, err := conn.UpdateClusterConfig(input)
+		output, err := conn.UpdateClusterConfigWithContext(ctx, input)
 
 		if err != nil {
-			return fmt.Errorf("error updating EKS Cluster (%s) logging: %w", d.Id(), err)
+			return diag.FromErr(fmt.Errorf("error updating EKS Cluster (%s) logging: %w", d.Id(), err))
 		}
 
 		updateID := aws.StringValue(output.Update.Id)
 
-		_, err = waitClusterUpdateSuccessful(conn, d.Id(), updateID, d.Timeout(schema.TimeoutUpdate))
+		_, err = waitClusterUpdateSuccessful(ctx, conn, d.Id(), updateID, d.Timeout(schema.TimeoutUpdate))
 
 		if err != nil {
-			return fmt.Errorf("error waiting for EKS Cluster (%s) logging update (%s): %w", d.Id(), updateID, err)
+			return diag.FromErr(fmt.Errorf("error waiting for EKS Cluster (%s) logging update (%s): %w", d.Id(), updateID, err))
 		}
 	}
 
@@ -462,36 +463,36 @@ func resourceClusterUpdate(d *schema.ResourceData, meta interface{}) error {
 		}
 
 		log.Printf("[DEBUG] Updating EKS Cluster (%s) VPC config: %s", d.Id(), input)
-		output, err := conn.UpdateClusterConfig(input)
+		output, err := conn.UpdateClusterConfigWithContext(ctx, input)
 
 		if err != nil {
-			return fmt.Errorf("error updating EKS Cluster (%s) VPC config: %w", d.Id(), err)
+			return diag.FromErr(fmt.Errorf("error updating EKS Cluster (%s) VPC config: %w", d.Id(), err))
 		}
 
 		updateID := aws.StringValue(output.Update.Id)
 
-		_, err = waitClusterUpdateSuccessful(conn, d.Id(), updateID, d.Timeout(schema.TimeoutUpdate))
+		_, err = waitClusterUpdateSuccessful(ctx, conn, d.Id(), updateID, d.Timeout(schema.TimeoutUpdate))
 
 		if err != nil {
-			return fmt.Errorf("error waiting for EKS Cluster (%s) VPC config update (%s): %w", d.Id(), updateID, err)
+			return diag.FromErr(fmt.Errorf("error waiting for EKS Cluster (%s) VPC config update (%s): %w", d.Id(), updateID, err))
 		}
 	}
 
 	if d.HasChange("tags_all") {
 		o, n := d.GetChange("tags_all")
 		if err := UpdateTags(conn, d.Get("arn").(string), o, n); err != nil {
-			return fmt.Errorf("error updating tags: %w", err)
+			return diag.FromErr(fmt.Errorf("error updating tags: %w", err))
 		}
 	}
 
-	return resourceClusterRead(d, meta)
+	return resourceClusterRead(ctx, d, meta)
 }
 
-func resourceClusterDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceClusterDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	conn := meta.(*conns.AWSClient).EKSConn
 
 	log.Printf("[DEBUG] Deleting EKS Cluster: %s", d.Id())
-	_, err := conn.DeleteCluster(&eks.DeleteClusterInput{
+	_, err := conn.DeleteClusterWithContext(ctx, &eks.DeleteClusterInput{
 		Name: aws.String(d.Id()),
 	})
 
@@ -506,13 +507,13 @@ func resourceClusterDelete(d *schema.ResourceData, meta interface{}) error {
 	}
 
 	if err != nil {
-		return fmt.Errorf("error deleting EKS Cluster (%s): %w", d.Id(), err)
+		return diag.FromErr(fmt.Errorf("error deleting EKS Cluster (%s): %w", d.Id(), err))
 	}
 
-	_, err = waitClusterDeleted(conn, d.Id(), d.Timeout(schema.TimeoutDelete))
+	_, err = waitClusterDeleted(ctx, conn, d.Id(), d.Timeout(schema.TimeoutDelete))
 
 	if err != nil {
-		return fmt.Errorf("error waiting for EKS Cluster (%s) to delete: %w", d.Id(), err)
+		return diag.FromErr(fmt.Errorf("error waiting for EKS Cluster (%s) to delete: %w", d.Id(), err))
 	}
 
 	return nil
@@ -1,6 +1,7 @@
 package eks
 
 import (
+	"context"
 	"fmt"
 	"log"
 	"time"
@@ -8,6 +9,7 @@ import (
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/eks"
 	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
@@ -21,12 +23,12 @@ import (
 
 func ResourceFargateProfile() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceFargateProfileCreate,
-		Read:   resourceFargateProfileRead,
-		Update: resourceFargateProfileUpdate,
-		Delete: resourceFargateProfileDelete,
+		CreateContext: resourceFargateProfileCreate,
+		ReadContext:   resourceFargateProfileRead,
+		UpdateContext: resourceFargateProfileUpdate,
+		DeleteContext: resourceFargateProfileDelete,
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: schema.ImportStatePassthroughContext,
 		},
 
 		CustomizeDiff: verify.SetTagsDiff,
@@ -98,7 +100,7 @@ func ResourceFargateProfile() *schema.Resource {
 	}
 }
 
-func resourceFargateProfileCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceFargateProfileCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	conn := meta.(*conns.AWSClient).EKSConn
 	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
 	tags := defaultTagsConfig.MergeTags(tftags.New(d.Get("tags").(map[string]interface{})))
@@ -125,8 +127,8 @@ func resourceFargateProfileCreate(d *schema.ResourceData, meta interface{}) erro
 	conns.GlobalMutexKV.Lock(mutexKey)
 	defer conns.GlobalMutexKV.Unlock(mutexKey)
 
-	err := resource.Retry(tfiam.PropagationTimeout, func() *resource.RetryError {
-		_, err := conn.CreateFargateProfile(input)
+	err := resource.RetryContext(ctx, tfiam.PropagationTimeout, func() *resource.RetryError {
+		_, err := conn.CreateFargateProfileWithContext(ctx, input)
 
 		// Retry for IAM eventual consistency on error:
 		// InvalidParameterException: Misconfigured PodExecutionRole Trust Policy; Please add the eks-fargate-pods.amazonaws.com Service Principal
@@ -142,25 +144,25 @@ func resourceFargateProfileCreate(d *schema.ResourceData, meta interface{}) erro
 	})
 
 	if tfresource.TimedOut(err) {
-		_, err = conn.CreateFargateProfile(input)
+		_, err = conn.CreateFargateProfileWithContext(ctx, input)
 	}
 
 	if err != nil {
-		return fmt.Errorf("error creating EKS Fargate Profile (%s): %w", id, err)
+		return diag.FromErr(fmt.Errorf("error creating EKS Fargate Profile (%s): %w", id, err))
 	}
 
 	d.SetId(id)
 
-	_, err = waitFargateProfileCreated(conn, clusterName, fargateProfileName, d.Timeout(schema.TimeoutCreate))
+	_, err = waitFargateProfileCreated(ctx, conn, clusterName, fargateProfileName, d.Timeout(schema.TimeoutCreate))
 
 	if err != nil {
-		return fmt.Errorf("error waiting for EKS Fargate Profile (%s) to create: %w", d.Id(), err)
+		return diag.FromErr(fmt.Errorf("error waiting for EKS Fargate Profile (%s) to create: %w", d.Id(), err))
 	}
 
-	return resourceFargateProfileRead(d, meta)
+	return resourceFargateProfileRead(ctx, d, meta)
 }
 
-func resourceFargateProfileRead(d *schema.ResourceData, meta interface{}) error {
+func resourceFargateProfileRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	conn := meta.(*conns.AWSClient).EKSConn
 	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
 	ignoreTagsConfig := meta.(*conns.AWSClient).IgnoreTagsConfig
@@ -168,7 +170,7 @@ func resourceFargateProfileRead(d *schema.ResourceData, meta interface{}) error
 	clusterName, fargateProfileName, err := FargateProfileParseResourceID(d.Id())
 
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	fargateProfile, err := FindFargateProfileByClusterNameAndFargateProfileName(conn, clusterName, fargateProfileName)
@@ -180,7 +182,7 @@ func resourceFargateProfileRead(d *schema.ResourceData, meta interface{}) error
 	}
 
 	if err != nil {
-		return fmt.Errorf("error reading EKS Fargate Profile (%s): %w", d.Id(), err)
+		return diag.FromErr(fmt.Errorf("error reading EKS Fargate Profile (%s): %w", d.Id(), err))
 	}
 
 	d.Set("arn", fargateProfile.FargateProfileArn)
@@ -189,49 +191,49 @@ func resourceFargateProfileRead(d *schema.ResourceData, meta interface{}) error
 	d.Set("pod_execution_role_arn", fargateProfile.PodExecutionRoleArn)
 
 	if err := d.Set("selector", flattenEksFargateProfileSelectors(fargateProfile.Selectors)); err != nil {
-		return fmt.Errorf("error setting selector: %w", err)
+		return diag.FromErr(fmt.Errorf("error setting selector: %w", err))
 	}
 
 	d.Set("status", fargateProfile.Status)
 
 	if err := d.Set("subnet_ids", aws.StringValueSlice(fargateProfile.Subnets)); err != nil {
-		return fmt.Errorf("error setting subnet_ids: %w", err)
+		return diag.FromErr(fmt.Errorf("error setting subnet_ids: %w", err))
 	}
 
 	tags := KeyValueTags(fargateProfile.Tags).IgnoreAWS().IgnoreConfig(ignoreTagsConfig)
 
 	//lintignore:AWSR002
 	if err := d.Set("tags", tags.RemoveDefaultConfig(defaultTagsConfig).Map()); err != nil {
-		return fmt.Errorf("error setting tags: %w", err)
+		return diag.FromErr(fmt.Errorf("error setting tags: %w", err))
 	}
 
 	if err := d.Set("tags_all", tags.Map()); err != nil {
-		return fmt.Errorf("error setting tags_all: %w", err)
+		return diag.FromErr(fmt.Errorf("error setting tags_all: %w", err))
 	}
 
 	return nil
 }
 
-func resourceFargateProfileUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceFargateProfileUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	conn := meta.(*conns.AWSClient).EKSConn
 
 	if d.HasChange("tags_all") {
 		o, n := d.GetChange("tags_all")
 		if err := UpdateTags(conn, d.Get("arn").(string), o, n); err != nil {
-			return fmt.Errorf("error updating tags: %w", err)
+			return diag.FromErr(fmt.Errorf("error updating tags: %w", err))
 		}
 	}
 
-	return resourceFargateProfileRead(d, meta)
+	return resourceFargateProfileRead(ctx, d, meta)
 }
 
-func resourceFargateProfileDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceFargateProfileDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	conn := meta.(*conns.AWSClient).EKSConn
 
 	clusterName, fargateProfileName, err := FargateProfileParseResourceID(d.Id())
 
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	// mutex lock for creation/deletion serialization
@@ -240,7 +242,7 @@ func resourceFargateProfileDelete(d *schema.ResourceData, meta interface{}) erro
 	defer conns.GlobalMutexKV.Unlock(mutexKey)
 
 	log.Printf("[DEBUG] Deleting EKS Fargate Profile: %s", d.Id())
-	_, err = conn.DeleteFargateProfile(&eks.DeleteFargateProfileInput{
+	_, err = conn.DeleteFargateProfileWithContext(ctx, &eks.DeleteFargateProfileInput{
 		ClusterName:        aws.String(clusterName),
 		FargateProfileName: aws.String(fargateProfileName),
 	})
@@ -250,13 +252,13 @@ func resourceFargateProfileDelete(d *schema.ResourceData, meta interface{}) erro
 	}
 
 	if err != nil {
-		return fmt.Errorf("error deleting EKS Fargate Profile (%s): %w", d.Id(), err)
+		return diag.FromErr(fmt.Errorf("error deleting EKS Fargate Profile (%s): %w", d.Id(), err))
 	}
 
-	_, err = waitFargateProfileDeleted(conn, clusterName, fargateProfileName, d.Timeout(schema.TimeoutDelete))
+	_, err = waitFargateProfileDeleted(ctx, conn, clusterName, fargateProfileName, d.Timeout(schema.TimeoutDelete))
 
 	if err != nil {
-		return fmt.Errorf("error waiting for EKS Fargate Profile (%s) to delete: %w", d.Id(), err)
+		return diag.FromErr(fmt.Errorf("error waiting for EKS Fargate Profile (%s) to delete: %w", d.Id(), err))
 	}
 
 	return nil
@@ -2,11 +2,11 @@ package eks
 
 import (
 	"context"
+	"fmt"
 	"time"
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/eks"
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 )
 
@@ -17,7 +17,7 @@ const (
 )
 
 func waitAddonCreated(ctx context.Context, conn *eks.EKS, clusterName, addonName string) (*eks.Addon, error) {
-	stateConf := resource.StateChangeConf{
+	stateConf := tfresource.StateChangeConf{
 		Pending: []string{eks.AddonStatusCreating, eks.AddonStatusDegraded},
 		Target:  []string{eks.AddonStatusActive},
 		Refresh: statusAddon(ctx, conn, clusterName, addonName),
@@ -38,7 +38,7 @@ func waitAddonCreated(ctx context.Context, conn *eks.EKS, clusterName, addonName
 }
 
 func waitAddonDeleted(ctx context.Context, conn *eks.EKS, clusterName, addonName string) (*eks.Addon, error) {
-	stateConf := &resource.StateChangeConf{
+	stateConf := &tfresource.StateChangeConf{
 		Pending: []string{eks.AddonStatusActive, eks.AddonStatusDeleting},
 		Target:  []string{},
 		Refresh: statusAddon(ctx, conn, clusterName, addonName),
@@ -59,7 +59,7 @@ func waitAddonDeleted(ctx context.Context, conn *eks.EKS, clusterName, addonName
 }
 
 func waitAddonUpdateSuccessful(ctx context.Context, conn *eks.EKS, clusterName, addonName, id string) (*eks.Update, error) {
-	stateConf := resource.StateChangeConf{
+	stateConf := tfresource.StateChangeConf{
 		Pending: []string{eks.UpdateStatusInProgress},
 		Target:  []string{eks.UpdateStatusSuccessful},
 		Refresh: statusAddonUpdate(ctx, conn, clusterName, addonName, id),
@@ -79,15 +79,16 @@ func waitAddonUpdateSuccessful(ctx context.Context, conn *eks.EKS, clusterName,
 	return nil, err
 }
 
-func waitClusterCreated(conn *eks.EKS, name string, timeout time.Duration) (*eks.Cluster, error) {
-	stateConf := &resource.StateChangeConf{
-		Pending: []string{eks.ClusterStatusCreating},
-		Target:  []string{eks.ClusterStatusActive},
-		Refresh: statusCluster(conn, name),
-		Timeout: timeout,
+func waitClusterCreated(ctx context.Context, conn *eks.EKS, name string, timeout time.Duration) (*eks.Cluster, error) {
+	stateConf := &tfresource.StateChangeConf{
+		Pending:     []string{eks.ClusterStatusCreating},
+		Target:      []string{eks.ClusterStatusActive},
+		Refresh:     statusCluster(conn, name),
+		Timeout:     timeout,
+		Description: fmt.Sprintf("EKS Cluster (%s) create", name),
 	}
 
-	outputRaw, err := stateConf.WaitForState()
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
 
 	if output, ok := outputRaw.(*eks.Cluster); ok {
 		return output, err
@@ -96,15 +97,16 @@ func waitClusterCreated(conn *eks.EKS, name string, timeout time.Duration) (*eks
 	return nil, err
 }
 
-func waitClusterDeleted(conn *eks.EKS, name string, timeout time.Duration) (*eks.Cluster, error) {
-	stateConf := &resource.StateChangeConf{
-		Pending: []string{eks.ClusterStatusActive, eks.ClusterStatusDeleting},
-		Target:  []string{},
-		Refresh: statusCluster(conn, name),
-		Timeout: timeout,
+func waitClusterDeleted(ctx context.Context, conn *eks.EKS, name string, timeout time.Duration) (*eks.Cluster, error) {
+	stateConf := &tfresource.StateChangeConf{
+		Pending:     []string{eks.ClusterStatusActive, eks.ClusterStatusDeleting},
+		Target:      []string{},
+		Refresh:     statusCluster(conn, name),
+		Timeout:     timeout,
+		Description: fmt.Sprintf("EKS Cluster (%s) delete", name),
 	}
 
-	outputRaw, err := stateConf.WaitForState()
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
 
 	if output, ok := outputRaw.(*eks.Cluster); ok {
 		return output, err
@@ -113,15 +115,15 @@ func waitClusterDeleted(conn *eks.EKS, name string, timeout time.Duration) (*eks
 	return nil, err
 }
 
-func waitClusterUpdateSuccessful(conn *eks.EKS, name, id string, timeout time.Duration) (*eks.Update, error) { //nolint:unparam
-	stateConf := &resource.StateChangeConf{
+func waitClusterUpdateSuccessful(ctx context.Context, conn *eks.EKS, name, id string, timeout time.Duration) (*eks.Update, error) { //nolint:unparam
+	stateConf := &tfresource.StateChangeConf{
 		Pending: []string{eks.UpdateStatusInProgress},
 		Target:  []string{eks.UpdateStatusSuccessful},
 		Refresh: statusClusterUpdate(conn, name, id),
 		Timeout: timeout,
 	}
 
-	outputRaw, err := stateConf.WaitForState()
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
 
 	if output, ok := outputRaw.(*eks.Update); ok {
 		if status := aws.StringValue(output.Status); status == eks.UpdateStatusCancelled || status == eks.UpdateStatusFailed {
@@ -134,15 +136,15 @@ func waitClusterUpdateSuccessful(conn *eks.EKS, name, id string, timeout time.Du
 	return nil, err
 }
 
-func waitFargateProfileCreated(conn *eks.EKS, clusterName, fargateProfileName string, timeout time.Duration) (*eks.FargateProfile, error) {
-	stateConf := &resource.StateChangeConf{
+func waitFargateProfileCreated(ctx context.Context, conn *eks.EKS, clusterName, fargateProfileName string, timeout time.Duration) (*eks.FargateProfile, error) {
+	stateConf := &tfresource.StateChangeConf{
 		Pending: []string{eks.FargateProfileStatusCreating},
 		Target:  []string{eks.FargateProfileStatusActive},
 		Refresh: statusFargateProfile(conn, clusterName, fargateProfileName),
 		Timeout: timeout,
 	}
 
-	outputRaw, err := stateConf.WaitForState()
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
 
 	if output, ok := outputRaw.(*eks.FargateProfile); ok {
 		return output, err
@@ -151,15 +153,15 @@ func waitFargateProfileCreated(conn *eks.EKS, clusterName, fargateProfileName st
 	return nil, err
 }
 
-func waitFargateProfileDeleted(conn *eks.EKS, clusterName, fargateProfileName string, timeout time.Duration) (*eks.FargateProfile, error) {
-	stateConf := &resource.StateChangeConf{
+func waitFargateProfileDeleted(ctx context.Context, conn *eks.EKS, clusterName, fargateProfileName string, timeout time.Duration) (*eks.FargateProfile, error) {
+	stateConf := &tfresource.StateChangeConf{
 		Pending: []string{eks.FargateProfileStatusActive, eks.FargateProfileStatusDeleting},
 		Target:  []string{},
 		Refresh: statusFargateProfile(conn, clusterName, fargateProfileName),
 		Timeout: timeout,
 	}
 
-	outputRaw, err := stateConf.WaitForState()
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
 
 	if output, ok := outputRaw.(*eks.FargateProfile); ok {
 		return output, err
@@ -169,11 +171,12 @@ func waitFargateProfileDeleted(conn *eks.EKS, clusterName, fargateProfileName st
 }
 
 func waitNodegroupCreated(ctx context.Context, conn *eks.EKS, clusterName, nodeGroupName string, timeout time.Duration) (*eks.Nodegroup, error) {
-	stateConf := &resource.StateChangeConf{
-		Pending: []string{eks.NodegroupStatusCreating},
-		Target:  []string{eks.NodegroupStatusActive},
-		Refresh: statusNodegroup(conn, clusterName, nodeGroupName),
-		Timeout: timeout,
+	stateConf := &tfresource.StateChangeConf{
+		Pending:     []string{eks.NodegroupStatusCreating},
+		Target:      []string{eks.NodegroupStatusActive},
+		Refresh:     statusNodegroup(conn, clusterName, nodeGroupName),
+		Timeout:     timeout,
+		Description: fmt.Sprintf("EKS Node Group (%s/%s) create", clusterName, nodeGroupName),
 	}
 
 	outputRaw, err := stateConf.WaitForStateContext(ctx)
@@ -190,11 +193,12 @@ func waitNodegroupCreated(ctx context.Context, conn *eks.EKS, clusterName, nodeG
 }
 
 func waitNodegroupDeleted(ctx context.Context, conn *eks.EKS, clusterName, nodeGroupName string, timeout time.Duration) (*eks.Nodegroup, error) {
-	stateConf := &resource.StateChangeConf{
-		Pending: []string{eks.NodegroupStatusActive, eks.NodegroupStatusDeleting},
-		Target:  []string{},
-		Refresh: statusNodegroup(conn, clusterName, nodeGroupName),
-		Timeout: timeout,
+	stateConf := &tfresource.StateChangeConf{
+		Pending:     []string{eks.NodegroupStatusActive, eks.NodegroupStatusDeleting},
+		Target:      []string{},
+		Refresh:     statusNodegroup(conn, clusterName, nodeGroupName),
+		Timeout:     timeout,
+		Description: fmt.Sprintf("EKS Node Group (%s/%s) delete", clusterName, nodeGroupName),
 	}
 
 	outputRaw, err := stateConf.WaitForStateContext(ctx)
@@ -211,7 +215,7 @@ func waitNodegroupDeleted(ctx context.Context, conn *eks.EKS, clusterName, nodeG
 }
 
 func waitNodegroupUpdateSuccessful(ctx context.Context, conn *eks.EKS, clusterName, nodeGroupName, id string, timeout time.Duration) (*eks.Update, error) { //nolint:unparam
-	stateConf := &resource.StateChangeConf{
+	stateConf := &tfresource.StateChangeConf{
 		Pending: []string{eks.UpdateStatusInProgress},
 		Target:  []string{eks.UpdateStatusSuccessful},
 		Refresh: statusNodegroupUpdate(conn, clusterName, nodeGroupName, id),
@@ -232,7 +236,7 @@ func waitNodegroupUpdateSuccessful(ctx context.Context, conn *eks.EKS, clusterNa
 }
 
 func waitOIDCIdentityProviderConfigCreated(ctx context.Context, conn *eks.EKS, clusterName, configName string, timeout time.Duration) (*eks.OidcIdentityProviderConfig, error) {
-	stateConf := resource.StateChangeConf{
+	stateConf := tfresource.StateChangeConf{
 		Pending: []string{eks.ConfigStatusCreating},
 		Target:  []string{eks.ConfigStatusActive},
 		Refresh: statusOIDCIdentityProviderConfig(ctx, conn, clusterName, configName),
@@ -249,7 +253,7 @@ func waitOIDCIdentityProviderConfigCreated(ctx context.Context, conn *eks.EKS, c
 }
 
 func waitOIDCIdentityProviderConfigDeleted(ctx context.Context, conn *eks.EKS, clusterName, configName string, timeout time.Duration) (*eks.OidcIdentityProviderConfig, error) {
-	stateConf := resource.StateChangeConf{
+	stateConf := tfresource.StateChangeConf{
 		Pending: []string{eks.ConfigStatusActive, eks.ConfigStatusDeleting},
 		Target:  []string{},
 		Refresh: statusOIDCIdentityProviderConfig(ctx, conn, clusterName, configName),
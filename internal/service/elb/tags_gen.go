@@ -77,7 +77,7 @@ func UpdateTags(conn *elb.ELB, identifier string, oldTagsMap interface{}, newTag
 	oldTags := tftags.New(oldTagsMap)
 	newTags := tftags.New(newTagsMap)
 
-	if removedTags := oldTags.Removed(newTags); len(removedTags) > 0 {
+	if removedTags := oldTags.RemovedFold(newTags); len(removedTags) > 0 {
 		input := &elb.RemoveTagsInput{
 			LoadBalancerNames: aws.StringSlice([]string{identifier}),
 			Tags:              TagKeys(removedTags.IgnoreAWS()),
@@ -90,7 +90,7 @@ func UpdateTags(conn *elb.ELB, identifier string, oldTagsMap interface{}, newTag
 		}
 	}
 
-	if updatedTags := oldTags.Updated(newTags); len(updatedTags) > 0 {
+	if updatedTags := oldTags.UpdatedFold(newTags); len(updatedTags) > 0 {
 		input := &elb.AddTagsInput{
 			LoadBalancerNames: aws.StringSlice([]string{identifier}),
 			Tags:              Tags(updatedTags.IgnoreAWS()),
@@ -16,12 +16,12 @@ import (
 )
 
 func init() {
-	resource.AddTestSweepers("aws_emr_cluster", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_emr_cluster", &resource.Sweeper{
 		Name: "aws_emr_cluster",
 		F:    sweepClusters,
 	})
 
-	resource.AddTestSweepers("aws_emr_studio", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_emr_studio", &resource.Sweeper{
 		Name: "aws_emr_studio",
 		F:    sweepStudios,
 	})
@@ -117,7 +117,7 @@ func sweepStudios(region string) error {
 		sweeperErrs = multierror.Append(sweeperErrs, fmt.Errorf("error listing EMR Studios for %s: %w", region, err))
 	}
 
-	if err = sweep.SweepOrchestrator(sweepResources); err != nil {
+	if err = sweep.SweepOrchestrator("aws_emr_studio", sweepResources); err != nil {
 		sweeperErrs = multierror.Append(sweeperErrs, fmt.Errorf("error sweeping EMR Studios for %s: %w", region, err))
 	}
 
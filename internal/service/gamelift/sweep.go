@@ -17,7 +17,7 @@ import (
 )
 
 func init() {
-	resource.AddTestSweepers("aws_gamelift_alias", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_gamelift_alias", &resource.Sweeper{
 		Name: "aws_gamelift_alias",
 		Dependencies: []string{
 			"aws_gamelift_fleet",
@@ -25,12 +25,12 @@ func init() {
 		F: sweepAliases,
 	})
 
-	resource.AddTestSweepers("aws_gamelift_build", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_gamelift_build", &resource.Sweeper{
 		Name: "aws_gamelift_build",
 		F:    sweepBuilds,
 	})
 
-	resource.AddTestSweepers("aws_gamelift_fleet", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_gamelift_fleet", &resource.Sweeper{
 		Name: "aws_gamelift_fleet",
 		Dependencies: []string{
 			"aws_gamelift_build",
@@ -38,7 +38,7 @@ func init() {
 		F: sweepFleets,
 	})
 
-	resource.AddTestSweepers("aws_gamelift_game_session_queue", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_gamelift_game_session_queue", &resource.Sweeper{
 		Name: "aws_gamelift_game_session_queue",
 		F:    sweepGameSessionQueue,
 	})
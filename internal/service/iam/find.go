@@ -171,3 +171,213 @@ func FindRoleByName(conn *iam.IAM, name string) (*iam.Role, error) {
 
 	return output.Role, nil
 }
+
+// FindGroupAttachedPolicyARNs returns the ARNs of the managed policies attached to the specified IAM group.
+func FindGroupAttachedPolicyARNs(conn *iam.IAM, groupName string) ([]string, error) {
+	input := &iam.ListAttachedGroupPoliciesInput{
+		GroupName: aws.String(groupName),
+	}
+
+	var results []string
+
+	err := conn.ListAttachedGroupPoliciesPages(input, func(page *iam.ListAttachedGroupPoliciesOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, attachedPolicy := range page.AttachedPolicies {
+			if attachedPolicy == nil {
+				continue
+			}
+
+			results = append(results, aws.StringValue(attachedPolicy.PolicyArn))
+		}
+
+		return !lastPage
+	})
+
+	if tfawserr.ErrCodeEquals(err, iam.ErrCodeNoSuchEntityException) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	return results, nil
+}
+
+// FindRoleAttachedPolicyARNs returns the ARNs of the managed policies attached to the specified IAM role.
+func FindRoleAttachedPolicyARNs(conn *iam.IAM, roleName string) ([]string, error) {
+	input := &iam.ListAttachedRolePoliciesInput{
+		RoleName: aws.String(roleName),
+	}
+
+	var results []string
+
+	err := conn.ListAttachedRolePoliciesPages(input, func(page *iam.ListAttachedRolePoliciesOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, attachedPolicy := range page.AttachedPolicies {
+			if attachedPolicy == nil {
+				continue
+			}
+
+			results = append(results, aws.StringValue(attachedPolicy.PolicyArn))
+		}
+
+		return !lastPage
+	})
+
+	if tfawserr.ErrCodeEquals(err, iam.ErrCodeNoSuchEntityException) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	return results, nil
+}
+
+// FindUserAttachedPolicyARNs returns the ARNs of the managed policies attached to the specified IAM user.
+func FindUserAttachedPolicyARNs(conn *iam.IAM, userName string) ([]string, error) {
+	input := &iam.ListAttachedUserPoliciesInput{
+		UserName: aws.String(userName),
+	}
+
+	var results []string
+
+	err := conn.ListAttachedUserPoliciesPages(input, func(page *iam.ListAttachedUserPoliciesOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, attachedPolicy := range page.AttachedPolicies {
+			if attachedPolicy == nil {
+				continue
+			}
+
+			results = append(results, aws.StringValue(attachedPolicy.PolicyArn))
+		}
+
+		return !lastPage
+	})
+
+	if tfawserr.ErrCodeEquals(err, iam.ErrCodeNoSuchEntityException) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	return results, nil
+}
+
+// FindGroupPolicyNames returns the names of the inline policies embedded in the specified IAM group.
+func FindGroupPolicyNames(conn *iam.IAM, groupName string) ([]string, error) {
+	input := &iam.ListGroupPoliciesInput{
+		GroupName: aws.String(groupName),
+	}
+
+	var results []string
+
+	err := conn.ListGroupPoliciesPages(input, func(page *iam.ListGroupPoliciesOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		results = append(results, aws.StringValueSlice(page.PolicyNames)...)
+
+		return !lastPage
+	})
+
+	if tfawserr.ErrCodeEquals(err, iam.ErrCodeNoSuchEntityException) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	return results, nil
+}
+
+// FindRolePolicyNames returns the names of the inline policies embedded in the specified IAM role.
+func FindRolePolicyNames(conn *iam.IAM, roleName string) ([]string, error) {
+	input := &iam.ListRolePoliciesInput{
+		RoleName: aws.String(roleName),
+	}
+
+	var results []string
+
+	err := conn.ListRolePoliciesPages(input, func(page *iam.ListRolePoliciesOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		results = append(results, aws.StringValueSlice(page.PolicyNames)...)
+
+		return !lastPage
+	})
+
+	if tfawserr.ErrCodeEquals(err, iam.ErrCodeNoSuchEntityException) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	return results, nil
+}
+
+// FindUserPolicyNames returns the names of the inline policies embedded in the specified IAM user.
+func FindUserPolicyNames(conn *iam.IAM, userName string) ([]string, error) {
+	input := &iam.ListUserPoliciesInput{
+		UserName: aws.String(userName),
+	}
+
+	var results []string
+
+	err := conn.ListUserPoliciesPages(input, func(page *iam.ListUserPoliciesOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		results = append(results, aws.StringValueSlice(page.PolicyNames)...)
+
+		return !lastPage
+	})
+
+	if tfawserr.ErrCodeEquals(err, iam.ErrCodeNoSuchEntityException) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	return results, nil
+}
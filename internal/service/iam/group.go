@@ -165,18 +165,9 @@ func resourceGroupDelete(d *schema.ResourceData, meta interface{}) error {
 }
 
 func DeleteGroupPolicyAttachments(conn *iam.IAM, groupName string) error {
-	var attachedPolicies []*iam.AttachedPolicy
-	input := &iam.ListAttachedGroupPoliciesInput{
-		GroupName: aws.String(groupName),
-	}
-
-	err := conn.ListAttachedGroupPoliciesPages(input, func(page *iam.ListAttachedGroupPoliciesOutput, lastPage bool) bool {
-		attachedPolicies = append(attachedPolicies, page.AttachedPolicies...)
-
-		return !lastPage
-	})
+	policyARNs, err := FindGroupAttachedPolicyARNs(conn, groupName)
 
-	if tfawserr.ErrMessageContains(err, iam.ErrCodeNoSuchEntityException, "") {
+	if tfresource.NotFound(err) {
 		return nil
 	}
 
@@ -184,10 +175,10 @@ func DeleteGroupPolicyAttachments(conn *iam.IAM, groupName string) error {
 		return fmt.Errorf("error listing IAM Group (%s) policy attachments for deletion: %w", groupName, err)
 	}
 
-	for _, attachedPolicy := range attachedPolicies {
+	for _, policyARN := range policyARNs {
 		input := &iam.DetachGroupPolicyInput{
 			GroupName: aws.String(groupName),
-			PolicyArn: attachedPolicy.PolicyArn,
+			PolicyArn: aws.String(policyARN),
 		}
 
 		_, err := conn.DetachGroupPolicy(input)
@@ -197,7 +188,7 @@ func DeleteGroupPolicyAttachments(conn *iam.IAM, groupName string) error {
 		}
 
 		if err != nil {
-			return fmt.Errorf("error detaching IAM Group (%s) policy (%s): %w", groupName, aws.StringValue(attachedPolicy.PolicyArn), err)
+			return fmt.Errorf("error detaching IAM Group (%s) policy (%s): %w", groupName, policyARN, err)
 		}
 	}
 
@@ -205,17 +196,9 @@ func DeleteGroupPolicyAttachments(conn *iam.IAM, groupName string) error {
 }
 
 func DeleteGroupPolicies(conn *iam.IAM, groupName string) error {
-	var inlinePolicies []*string
-	input := &iam.ListGroupPoliciesInput{
-		GroupName: aws.String(groupName),
-	}
-
-	err := conn.ListGroupPoliciesPages(input, func(page *iam.ListGroupPoliciesOutput, lastPage bool) bool {
-		inlinePolicies = append(inlinePolicies, page.PolicyNames...)
-		return !lastPage
-	})
+	policyNames, err := FindGroupPolicyNames(conn, groupName)
 
-	if tfawserr.ErrMessageContains(err, iam.ErrCodeNoSuchEntityException, "") {
+	if tfresource.NotFound(err) {
 		return nil
 	}
 
@@ -223,10 +206,10 @@ func DeleteGroupPolicies(conn *iam.IAM, groupName string) error {
 		return fmt.Errorf("error listing IAM Group (%s) inline policies for deletion: %w", groupName, err)
 	}
 
-	for _, policyName := range inlinePolicies {
+	for _, policyName := range policyNames {
 		input := &iam.DeleteGroupPolicyInput{
 			GroupName:  aws.String(groupName),
-			PolicyName: policyName,
+			PolicyName: aws.String(policyName),
 		}
 
 		_, err := conn.DeleteGroupPolicy(input)
@@ -236,7 +219,7 @@ func DeleteGroupPolicies(conn *iam.IAM, groupName string) error {
 		}
 
 		if err != nil {
-			return fmt.Errorf("error deleting IAM Group (%s) inline policy (%s): %w", groupName, aws.StringValue(policyName), err)
+			return fmt.Errorf("error deleting IAM Group (%s) inline policy (%s): %w", groupName, policyName, err)
 		}
 	}
 
@@ -33,7 +33,7 @@ func ResourceGroupPolicy() *schema.Resource {
 			"policy": {
 				Type:             schema.TypeString,
 				Required:         true,
-				ValidateFunc:     verify.ValidIAMPolicyJSON,
+				ValidateFunc:     verify.ValidIAMIdentityPolicyJSON,
 				DiffSuppressFunc: verify.SuppressEquivalentPolicyDiffs,
 			},
 			"name": {
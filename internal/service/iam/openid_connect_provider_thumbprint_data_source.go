@@ -0,0 +1,183 @@
+package iam
+
+import (
+	"crypto/sha1"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"log"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+)
+
+const (
+	openIDConfigurationPath    = "/.well-known/openid-configuration"
+	openIDConfigurationMaxSize = 1 << 20 // 1 MiB
+	openIDThumbprintTimeout    = 30 * time.Second
+)
+
+func DataSourceOpenIDConnectProviderThumbprint() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceOpenIDConnectProviderThumbprintRead,
+
+		Schema: map[string]*schema.Schema{
+			"jwks_uri": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"thumbprint": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"url": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validOpenIDURL,
+			},
+		},
+	}
+}
+
+func dataSourceOpenIDConnectProviderThumbprintRead(d *schema.ResourceData, meta interface{}) error {
+	issuerURL := d.Get("url").(string)
+
+	// Use the provider's HTTP client so that its proxy and TLS settings apply.
+	client := *meta.(*conns.AWSClient).HTTPClient
+	client.Timeout = openIDThumbprintTimeout
+
+	output, err := FindOpenIDConnectProviderThumbprint(&client, issuerURL)
+
+	if err != nil {
+		return fmt.Errorf("error reading IAM OpenID Connect Provider (%s) thumbprint: %w", issuerURL, err)
+	}
+
+	d.SetId(issuerURL)
+	d.Set("jwks_uri", output.JWKSURI)
+	d.Set("thumbprint", output.Thumbprint)
+
+	return nil
+}
+
+// OpenIDConnectProviderThumbprint is the thumbprint of an OpenID Connect identity provider's certificate chain.
+type OpenIDConnectProviderThumbprint struct {
+	// JWKSURI is the URL of the provider's JSON Web Key Set, from its discovery document.
+	JWKSURI string
+	// Thumbprint is the hex-encoded SHA-1 fingerprint of the last certificate presented by the JWKS host,
+	// i.e. the top intermediate or root certificate authority.
+	Thumbprint string
+}
+
+// FindOpenIDConnectProviderThumbprint reads the OpenID Connect discovery document of the specified issuer and
+// returns the thumbprint that IAM expects for the certificate chain of the host serving the issuer's keys.
+// See https://docs.aws.amazon.com/IAM/latest/UserGuide/id_roles_providers_create_oidc_verify-thumbprint.html.
+// The client, including any proxy and TLS configuration, is also used to connect to the JWKS host.
+func FindOpenIDConnectProviderThumbprint(client *http.Client, issuerURL string) (*OpenIDConnectProviderThumbprint, error) {
+	jwksURI, err := findOpenIDConfigurationJWKSURI(client, issuerURL)
+
+	if err != nil {
+		return nil, err
+	}
+
+	thumbprint, err := findTLSCertificateChainThumbprint(client, jwksURI)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return &OpenIDConnectProviderThumbprint{
+		JWKSURI:    jwksURI,
+		Thumbprint: thumbprint,
+	}, nil
+}
+
+func findOpenIDConfigurationJWKSURI(client *http.Client, issuerURL string) (string, error) {
+	configurationURL := strings.TrimSuffix(issuerURL, "/") + openIDConfigurationPath
+
+	log.Printf("[DEBUG] Reading OpenID Connect discovery document from %s", configurationURL)
+
+	resp, err := client.Get(configurationURL)
+
+	if err != nil {
+		return "", fmt.Errorf("error reading OpenID Connect discovery document (%s): %w", configurationURL, err)
+	}
+
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return "", fmt.Errorf("error reading OpenID Connect discovery document (%s): unexpected HTTP status %s", configurationURL, resp.Status)
+	}
+
+	body, err := io.ReadAll(io.LimitReader(resp.Body, openIDConfigurationMaxSize+1))
+
+	if err != nil {
+		return "", fmt.Errorf("error reading OpenID Connect discovery document (%s): %w", configurationURL, err)
+	}
+
+	if len(body) > openIDConfigurationMaxSize {
+		return "", fmt.Errorf("OpenID Connect discovery document (%s) is larger than %d bytes", configurationURL, openIDConfigurationMaxSize)
+	}
+
+	var configuration struct {
+		JWKSURI string `json:"jwks_uri"`
+	}
+
+	if err := json.Unmarshal(body, &configuration); err != nil {
+		return "", fmt.Errorf("error parsing OpenID Connect discovery document (%s): %w", configurationURL, err)
+	}
+
+	if configuration.JWKSURI == "" {
+		return "", fmt.Errorf("OpenID Connect discovery document (%s) does not contain jwks_uri", configurationURL)
+	}
+
+	return configuration.JWKSURI, nil
+}
+
+// findTLSCertificateChainThumbprint requests the specified HTTPS URL and returns the hex-encoded
+// SHA-1 fingerprint of the last certificate in the chain that the host presents.
+// Redirects are not followed so that the certificates are those of the URL's host.
+func findTLSCertificateChainThumbprint(client *http.Client, rawURL string) (string, error) {
+	u, err := url.Parse(rawURL)
+
+	if err != nil {
+		return "", fmt.Errorf("error parsing URL (%s): %w", rawURL, err)
+	}
+
+	if u.Scheme != "https" {
+		return "", fmt.Errorf("URL (%s) must use the https scheme", rawURL)
+	}
+
+	noRedirectClient := *client
+	noRedirectClient.CheckRedirect = func(req *http.Request, via []*http.Request) error {
+		return http.ErrUseLastResponse
+	}
+
+	log.Printf("[DEBUG] Reading TLS certificates of %s", u.Host)
+
+	resp, err := noRedirectClient.Get(rawURL)
+
+	if err != nil {
+		return "", fmt.Errorf("error connecting to %s: %w", u.Host, err)
+	}
+
+	defer resp.Body.Close()
+
+	// Read the body so that the connection can be reused.
+	if _, err := io.Copy(io.Discard, io.LimitReader(resp.Body, openIDConfigurationMaxSize)); err != nil {
+		log.Printf("[WARN] Error reading response from %s: %s", rawURL, err)
+	}
+
+	if resp.TLS == nil || len(resp.TLS.PeerCertificates) == 0 {
+		return "", fmt.Errorf("%s presented no TLS certificates", u.Host)
+	}
+
+	certificates := resp.TLS.PeerCertificates
+	fingerprint := sha1.Sum(certificates[len(certificates)-1].Raw)
+
+	return hex.EncodeToString(fingerprint[:]), nil
+}
@@ -0,0 +1,303 @@
+package iam_test
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/sha1"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"math/big"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"regexp"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go/service/iam"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	tfiam "github.com/hashicorp/terraform-provider-aws/internal/service/iam"
+)
+
+func TestFindOpenIDConnectProviderThumbprint(t *testing.T) {
+	caCert, caKey := testGenerateCertificate(t, nil, nil)
+	leafCert, leafKey := testGenerateCertificate(t, caCert, caKey)
+
+	// The JWKS host presents a chain of leaf and CA certificates and is distinct from the issuer host.
+	jwks := httptest.NewUnstartedServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `{"keys":[]}`)
+	}))
+	jwks.TLS = &tls.Config{
+		Certificates: []tls.Certificate{{
+			Certificate: [][]byte{leafCert.Raw, caCert.Raw},
+			PrivateKey:  leafKey,
+		}},
+	}
+	jwks.StartTLS()
+	defer jwks.Close()
+
+	issuer := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/.well-known/openid-configuration":
+			fmt.Fprintf(w, `{"issuer":"https://%s","jwks_uri":"%s/keys"}`, r.Host, jwks.URL)
+		case "/nojwks/.well-known/openid-configuration":
+			fmt.Fprint(w, `{"issuer":"https://example.com"}`)
+		case "/invalid/.well-known/openid-configuration":
+			fmt.Fprint(w, `not json`)
+		case "/large/.well-known/openid-configuration":
+			fmt.Fprintf(w, `{"jwks_uri":"%s/keys","padding":"%s"}`, jwks.URL, strings.Repeat("x", 1<<20))
+		default:
+			http.NotFound(w, r)
+		}
+	}))
+	defer issuer.Close()
+
+	pool := x509.NewCertPool()
+	pool.AddCert(caCert)
+	pool.AddCert(issuer.Certificate())
+
+	client := &http.Client{
+		Transport: &http.Transport{
+			TLSClientConfig: &tls.Config{RootCAs: pool},
+		},
+	}
+
+	caFingerprint := sha1.Sum(caCert.Raw)
+	expectedThumbprint := hex.EncodeToString(caFingerprint[:])
+
+	testCases := []struct {
+		TestName      string
+		IssuerURL     string
+		ExpectedError *regexp.Regexp
+	}{
+		{
+			TestName:  "valid",
+			IssuerURL: issuer.URL,
+		},
+		{
+			TestName:  "trailing slash",
+			IssuerURL: issuer.URL + "/",
+		},
+		{
+			TestName:      "not found",
+			IssuerURL:     issuer.URL + "/missing",
+			ExpectedError: regexp.MustCompile(`unexpected HTTP status 404`),
+		},
+		{
+			TestName:      "no jwks_uri",
+			IssuerURL:     issuer.URL + "/nojwks",
+			ExpectedError: regexp.MustCompile(`does not contain jwks_uri`),
+		},
+		{
+			TestName:      "invalid JSON",
+			IssuerURL:     issuer.URL + "/invalid",
+			ExpectedError: regexp.MustCompile(`error parsing OpenID Connect discovery document`),
+		},
+		{
+			TestName:      "too large",
+			IssuerURL:     issuer.URL + "/large",
+			ExpectedError: regexp.MustCompile(`is larger than 1048576 bytes`),
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.TestName, func(t *testing.T) {
+			got, err := tfiam.FindOpenIDConnectProviderThumbprint(client, testCase.IssuerURL)
+
+			if testCase.ExpectedError != nil {
+				if err == nil || !testCase.ExpectedError.MatchString(err.Error()) {
+					t.Fatalf("expected error matching %q, got %v", testCase.ExpectedError, err)
+				}
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if expected := jwks.URL + "/keys"; got.JWKSURI != expected {
+				t.Errorf("expected JWKS URI %s, got %s", expected, got.JWKSURI)
+			}
+
+			if got.Thumbprint != expectedThumbprint {
+				t.Errorf("expected thumbprint %s, got %s", expectedThumbprint, got.Thumbprint)
+			}
+		})
+	}
+}
+
+func TestFindOpenIDConnectProviderThumbprint_untrusted(t *testing.T) {
+	var server *httptest.Server
+	server = httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprintf(w, `{"jwks_uri":"%s/keys"}`, server.URL)
+	}))
+	defer server.Close()
+
+	_, err := tfiam.FindOpenIDConnectProviderThumbprint(&http.Client{Transport: &http.Transport{}}, server.URL)
+
+	if err == nil || !strings.Contains(err.Error(), "certificate") {
+		t.Fatalf("expected certificate verification error, got %v", err)
+	}
+}
+
+func TestFindOpenIDConnectProviderThumbprint_proxy(t *testing.T) {
+	var server *httptest.Server
+	server = httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprintf(w, `{"jwks_uri":"%s/keys"}`, server.URL)
+	}))
+	defer server.Close()
+
+	var mu sync.Mutex
+	var tunnels []string
+
+	// The proxy only supports the CONNECT method used to tunnel HTTPS requests.
+	proxy := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodConnect {
+			http.Error(w, "unsupported method", http.StatusMethodNotAllowed)
+			return
+		}
+
+		mu.Lock()
+		tunnels = append(tunnels, r.Host)
+		mu.Unlock()
+
+		upstream, err := net.Dial("tcp", r.Host)
+
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusBadGateway)
+			return
+		}
+
+		defer upstream.Close()
+
+		conn, _, err := w.(http.Hijacker).Hijack()
+
+		if err != nil {
+			return
+		}
+
+		defer conn.Close()
+
+		fmt.Fprint(conn, "HTTP/1.1 200 Connection Established\r\n\r\n")
+
+		go io.Copy(upstream, conn) //nolint:errcheck
+		io.Copy(conn, upstream)    //nolint:errcheck
+	}))
+	defer proxy.Close()
+
+	proxyURL, err := url.Parse(proxy.URL)
+
+	if err != nil {
+		t.Fatalf("error parsing proxy URL: %s", err)
+	}
+
+	pool := x509.NewCertPool()
+	pool.AddCert(server.Certificate())
+
+	client := &http.Client{
+		Transport: &http.Transport{
+			DisableKeepAlives: true,
+			Proxy:             http.ProxyURL(proxyURL),
+			TLSClientConfig:   &tls.Config{RootCAs: pool},
+		},
+	}
+
+	got, err := tfiam.FindOpenIDConnectProviderThumbprint(client, server.URL)
+
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	fingerprint := sha1.Sum(server.Certificate().Raw)
+
+	if expected := hex.EncodeToString(fingerprint[:]); got.Thumbprint != expected {
+		t.Errorf("expected thumbprint %s, got %s", expected, got.Thumbprint)
+	}
+
+	mu.Lock()
+	defer mu.Unlock()
+
+	// Both the discovery document and the JWKS host certificates are requested through the proxy.
+	if host := strings.TrimPrefix(server.URL, "https://"); len(tunnels) != 2 || tunnels[0] != host || tunnels[1] != host {
+		t.Errorf("expected 2 tunnels to %s, got %v", host, tunnels)
+	}
+}
+
+func TestAccIAMOpenIDConnectProviderThumbprintDataSource_basic(t *testing.T) {
+	dataSourceName := "data.aws_iam_openid_connect_provider_thumbprint.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:   func() { acctest.PreCheck(t) },
+		ErrorCheck: acctest.ErrorCheck(t, iam.EndpointsID),
+		Providers:  acctest.Providers,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccOpenIDConnectProviderThumbprintDataSourceConfig,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestMatchResourceAttr(dataSourceName, "jwks_uri", regexp.MustCompile(`^https://`)),
+					resource.TestMatchResourceAttr(dataSourceName, "thumbprint", regexp.MustCompile(`^[0-9a-f]{40}$`)),
+				),
+			},
+		},
+	})
+}
+
+// testGenerateCertificate returns a certificate for 127.0.0.1 signed by the specified parent,
+// or a self-signed certificate authority if parent is nil.
+func testGenerateCertificate(t *testing.T, parent *x509.Certificate, parentKey *ecdsa.PrivateKey) (*x509.Certificate, *ecdsa.PrivateKey) {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+
+	if err != nil {
+		t.Fatalf("error generating key: %s", err)
+	}
+
+	template := &x509.Certificate{
+		SerialNumber: big.NewInt(time.Now().UnixNano()),
+		Subject:      pkix.Name{CommonName: "leaf"},
+		NotBefore:    time.Now().Add(-time.Hour),
+		NotAfter:     time.Now().Add(time.Hour),
+		KeyUsage:     x509.KeyUsageDigitalSignature,
+		ExtKeyUsage:  []x509.ExtKeyUsage{x509.ExtKeyUsageServerAuth},
+		IPAddresses:  []net.IP{net.ParseIP("127.0.0.1")},
+	}
+
+	if parent == nil {
+		template.Subject = pkix.Name{CommonName: "root"}
+		template.IsCA = true
+		template.BasicConstraintsValid = true
+		template.KeyUsage = x509.KeyUsageCertSign | x509.KeyUsageDigitalSignature
+		template.ExtKeyUsage = nil
+		template.IPAddresses = nil
+		parent = template
+		parentKey = key
+	}
+
+	der, err := x509.CreateCertificate(rand.Reader, template, parent, &key.PublicKey, parentKey)
+
+	if err != nil {
+		t.Fatalf("error creating certificate: %s", err)
+	}
+
+	certificate, err := x509.ParseCertificate(der)
+
+	if err != nil {
+		t.Fatalf("error parsing certificate: %s", err)
+	}
+
+	return certificate, key
+}
+
+const testAccOpenIDConnectProviderThumbprintDataSourceConfig = `
+data "aws_iam_openid_connect_provider_thumbprint" "test" {
+  url = "https://token.actions.githubusercontent.com"
+}
+`
@@ -0,0 +1,169 @@
+package iam
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/iam"
+	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+// policiesExclusiveEntity describes the IAM role, user or group whose inline policies are exclusively managed.
+type policiesExclusiveEntity struct {
+	entityType      string
+	nameAttribute   string
+	findPolicyNames func(conn *iam.IAM, name string) ([]string, error)
+	deletePolicy    func(conn *iam.IAM, name, policyName string) error
+}
+
+var (
+	groupPoliciesExclusiveEntity = &policiesExclusiveEntity{
+		entityType:      "Group",
+		nameAttribute:   "group_name",
+		findPolicyNames: FindGroupPolicyNames,
+		deletePolicy: func(conn *iam.IAM, name, policyName string) error {
+			_, err := conn.DeleteGroupPolicy(&iam.DeleteGroupPolicyInput{
+				GroupName:  aws.String(name),
+				PolicyName: aws.String(policyName),
+			})
+			return err
+		},
+	}
+	rolePoliciesExclusiveEntity = &policiesExclusiveEntity{
+		entityType:      "Role",
+		nameAttribute:   "role_name",
+		findPolicyNames: FindRolePolicyNames,
+		deletePolicy: func(conn *iam.IAM, name, policyName string) error {
+			_, err := conn.DeleteRolePolicy(&iam.DeleteRolePolicyInput{
+				RoleName:   aws.String(name),
+				PolicyName: aws.String(policyName),
+			})
+			return err
+		},
+	}
+	userPoliciesExclusiveEntity = &policiesExclusiveEntity{
+		entityType:      "User",
+		nameAttribute:   "user_name",
+		findPolicyNames: FindUserPolicyNames,
+		deletePolicy: func(conn *iam.IAM, name, policyName string) error {
+			_, err := conn.DeleteUserPolicy(&iam.DeleteUserPolicyInput{
+				UserName:   aws.String(name),
+				PolicyName: aws.String(policyName),
+			})
+			return err
+		},
+	}
+)
+
+func ResourceGroupPoliciesExclusive() *schema.Resource {
+	return resourcePoliciesExclusive(groupPoliciesExclusiveEntity)
+}
+
+func ResourceRolePoliciesExclusive() *schema.Resource {
+	return resourcePoliciesExclusive(rolePoliciesExclusiveEntity)
+}
+
+func ResourceUserPoliciesExclusive() *schema.Resource {
+	return resourcePoliciesExclusive(userPoliciesExclusiveEntity)
+}
+
+func resourcePoliciesExclusive(entity *policiesExclusiveEntity) *schema.Resource {
+	return &schema.Resource{
+		Create: entity.resourcePut,
+		Read:   entity.resourceRead,
+		Update: entity.resourcePut,
+		Delete: entity.resourceDelete,
+
+		Importer: &schema.ResourceImporter{
+			State: entity.resourceImport,
+		},
+
+		Schema: map[string]*schema.Schema{
+			entity.nameAttribute: {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"policy_names": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+		},
+	}
+}
+
+// resourcePut deletes any inline policy that is not declared in policy_names.
+// Declared policies are created by the aws_iam_<entity>_policy resources; any that are missing show up as a diff on the next plan.
+func (entity *policiesExclusiveEntity) resourcePut(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).IAMConn
+
+	name := d.Get(entity.nameAttribute).(string)
+	want := aws.StringValueSlice(flex.ExpandStringSet(d.Get("policy_names").(*schema.Set)))
+
+	have, err := entity.findPolicyNames(conn, name)
+
+	if err != nil {
+		return fmt.Errorf("error reading IAM %s (%s) inline policies: %w", entity.entityType, name, err)
+	}
+
+	_, del := policyExclusiveDifference(want, have)
+
+	for _, policyName := range del {
+		err := entity.deletePolicy(conn, name, policyName)
+
+		if tfawserr.ErrCodeEquals(err, iam.ErrCodeNoSuchEntityException) {
+			continue
+		}
+
+		if err != nil {
+			return fmt.Errorf("error deleting IAM %s (%s) inline policy (%s): %w", entity.entityType, name, policyName, err)
+		}
+	}
+
+	d.SetId(name)
+
+	return entity.resourceRead(d, meta)
+}
+
+func (entity *policiesExclusiveEntity) resourceRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).IAMConn
+
+	policyNames, err := entity.findPolicyNames(conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] IAM %s (%s) not found, removing inline policies from state", entity.entityType, d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error reading IAM %s (%s) inline policies: %w", entity.entityType, d.Id(), err)
+	}
+
+	d.Set(entity.nameAttribute, d.Id())
+
+	if err := d.Set("policy_names", policyNames); err != nil {
+		return fmt.Errorf("error setting policy_names: %w", err)
+	}
+
+	return nil
+}
+
+// resourceDelete removes the resource from state only. Inline policies are not deleted.
+func (entity *policiesExclusiveEntity) resourceDelete(d *schema.ResourceData, meta interface{}) error {
+	log.Printf("[DEBUG] Removing IAM %s (%s) exclusive inline policies from state; inline policies are not deleted", entity.entityType, d.Id())
+
+	return nil
+}
+
+func (entity *policiesExclusiveEntity) resourceImport(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	d.Set(entity.nameAttribute, d.Id())
+
+	return []*schema.ResourceData{d}, nil
+}
@@ -0,0 +1,298 @@
+package iam_test
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/iam"
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfiam "github.com/hashicorp/terraform-provider-aws/internal/service/iam"
+)
+
+func TestAccIAMRolePoliciesExclusive_basic(t *testing.T) {
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_iam_role_policies_exclusive.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, iam.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckRoleDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccRolePoliciesExclusiveConfig(rName, 2),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckPoliciesExclusive(resourceName, tfiam.FindRolePolicyNames, rName+"-0", rName+"-1"),
+					resource.TestCheckResourceAttrPair(resourceName, "role_name", "aws_iam_role.test", "name"),
+					resource.TestCheckResourceAttr(resourceName, "policy_names.#", "2"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccRolePoliciesExclusiveConfig(rName, 1),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckPoliciesExclusive(resourceName, tfiam.FindRolePolicyNames, rName+"-0"),
+					resource.TestCheckResourceAttr(resourceName, "policy_names.#", "1"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccIAMRolePoliciesExclusive_outOfBandAddition(t *testing.T) {
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_iam_role_policies_exclusive.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, iam.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckRoleDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccRolePoliciesExclusiveConfig(rName, 1),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckPoliciesExclusive(resourceName, tfiam.FindRolePolicyNames, rName+"-0"),
+					testAccCheckRolePoliciesExclusivePutOutOfBand(resourceName, rName+"-extra"),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+			{
+				Config: testAccRolePoliciesExclusiveConfig(rName, 1),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckPoliciesExclusive(resourceName, tfiam.FindRolePolicyNames, rName+"-0"),
+					resource.TestCheckResourceAttr(resourceName, "policy_names.#", "1"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccIAMUserPoliciesExclusive_basic(t *testing.T) {
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_iam_user_policies_exclusive.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, iam.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckUserDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccUserPoliciesExclusiveConfig(rName, 2),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckPoliciesExclusive(resourceName, tfiam.FindUserPolicyNames, rName+"-0", rName+"-1"),
+					resource.TestCheckResourceAttrPair(resourceName, "user_name", "aws_iam_user.test", "name"),
+					resource.TestCheckResourceAttr(resourceName, "policy_names.#", "2"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccUserPoliciesExclusiveConfig(rName, 0),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckPoliciesExclusive(resourceName, tfiam.FindUserPolicyNames),
+					resource.TestCheckResourceAttr(resourceName, "policy_names.#", "0"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccIAMGroupPoliciesExclusive_basic(t *testing.T) {
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_iam_group_policies_exclusive.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, iam.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckGroupDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccGroupPoliciesExclusiveConfig(rName, 2),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckPoliciesExclusive(resourceName, tfiam.FindGroupPolicyNames, rName+"-0", rName+"-1"),
+					resource.TestCheckResourceAttrPair(resourceName, "group_name", "aws_iam_group.test", "name"),
+					resource.TestCheckResourceAttr(resourceName, "policy_names.#", "2"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccGroupPoliciesExclusiveConfig(rName, 1),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckPoliciesExclusive(resourceName, tfiam.FindGroupPolicyNames, rName+"-0"),
+					resource.TestCheckResourceAttr(resourceName, "policy_names.#", "1"),
+				),
+			},
+		},
+	})
+}
+
+// testAccCheckPoliciesExclusive checks that exactly the specified inline policies exist.
+func testAccCheckPoliciesExclusive(n string, find func(*iam.IAM, string) ([]string, error), want ...string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No IAM inline policies ID is set")
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).IAMConn
+
+		got, err := find(conn, rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		sort.Strings(want)
+		sort.Strings(got)
+
+		if strings.Join(got, ",") != strings.Join(want, ",") {
+			return fmt.Errorf("expected inline policies %v, got %v", want, got)
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckRolePoliciesExclusivePutOutOfBand(n, policyName string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).IAMConn
+
+		_, err := conn.PutRolePolicy(&iam.PutRolePolicyInput{
+			PolicyDocument: aws.String(`{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Action":"s3:ListAllMyBuckets","Resource":"*"}]}`),
+			PolicyName:     aws.String(policyName),
+			RoleName:       aws.String(rs.Primary.ID),
+		})
+
+		return err
+	}
+}
+
+func testAccRolePoliciesExclusiveConfig(rName string, n int) string {
+	return fmt.Sprintf(`
+data "aws_partition" "current" {}
+
+resource "aws_iam_role" "test" {
+  name = %[1]q
+
+  assume_role_policy = jsonencode({
+    Version = "2012-10-17"
+    Statement = [{
+      Action = "sts:AssumeRole"
+      Effect = "Allow"
+      Principal = {
+        Service = "ec2.${data.aws_partition.current.dns_suffix}"
+      }
+    }]
+  })
+}
+
+resource "aws_iam_role_policy" "test" {
+  count = %[2]d
+
+  name = "%[1]s-${count.index}"
+  role = aws_iam_role.test.name
+
+  policy = jsonencode({
+    Version = "2012-10-17"
+    Statement = [{
+      Action   = "ec2:Describe*"
+      Effect   = "Allow"
+      Resource = "*"
+    }]
+  })
+}
+
+resource "aws_iam_role_policies_exclusive" "test" {
+  role_name    = aws_iam_role.test.name
+  policy_names = aws_iam_role_policy.test[*].name
+}
+`, rName, n)
+}
+
+func testAccUserPoliciesExclusiveConfig(rName string, n int) string {
+	return fmt.Sprintf(`
+resource "aws_iam_user" "test" {
+  name = %[1]q
+}
+
+resource "aws_iam_user_policy" "test" {
+  count = %[2]d
+
+  name = "%[1]s-${count.index}"
+  user = aws_iam_user.test.name
+
+  policy = jsonencode({
+    Version = "2012-10-17"
+    Statement = [{
+      Action   = "ec2:Describe*"
+      Effect   = "Allow"
+      Resource = "*"
+    }]
+  })
+}
+
+resource "aws_iam_user_policies_exclusive" "test" {
+  user_name    = aws_iam_user.test.name
+  policy_names = aws_iam_user_policy.test[*].name
+}
+`, rName, n)
+}
+
+func testAccGroupPoliciesExclusiveConfig(rName string, n int) string {
+	return fmt.Sprintf(`
+resource "aws_iam_group" "test" {
+  name = %[1]q
+}
+
+resource "aws_iam_group_policy" "test" {
+  count = %[2]d
+
+  name  = "%[1]s-${count.index}"
+  group = aws_iam_group.test.name
+
+  policy = jsonencode({
+    Version = "2012-10-17"
+    Statement = [{
+      Action   = "ec2:Describe*"
+      Effect   = "Allow"
+      Resource = "*"
+    }]
+  })
+}
+
+resource "aws_iam_group_policies_exclusive" "test" {
+  group_name   = aws_iam_group.test.name
+  policy_names = aws_iam_group_policy.test[*].name
+}
+`, rName, n)
+}
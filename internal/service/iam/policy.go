@@ -44,7 +44,7 @@ func ResourcePolicy() *schema.Resource {
 			"policy": {
 				Type:             schema.TypeString,
 				Required:         true,
-				ValidateFunc:     verify.ValidIAMPolicyJSON,
+				ValidateFunc:     verify.ValidIAMIdentityPolicyJSON,
 				DiffSuppressFunc: verify.SuppressEquivalentPolicyDiffs,
 			},
 			"name": {
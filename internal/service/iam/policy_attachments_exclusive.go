@@ -0,0 +1,188 @@
+package iam
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/iam"
+	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+// policyAttachmentsExclusiveEntity describes the IAM role, user or group whose managed policy attachments are exclusively managed.
+type policyAttachmentsExclusiveEntity struct {
+	entityType     string
+	nameAttribute  string
+	findPolicyARNs func(conn *iam.IAM, name string) ([]string, error)
+	attachPolicy   func(conn *iam.IAM, name, policyARN string) error
+	detachPolicy   func(conn *iam.IAM, name, policyARN string) error
+}
+
+var (
+	groupPolicyAttachmentsExclusiveEntity = &policyAttachmentsExclusiveEntity{
+		entityType:     "Group",
+		nameAttribute:  "group_name",
+		findPolicyARNs: FindGroupAttachedPolicyARNs,
+		attachPolicy:   attachPolicyToGroup,
+		detachPolicy:   detachPolicyFromGroup,
+	}
+	rolePolicyAttachmentsExclusiveEntity = &policyAttachmentsExclusiveEntity{
+		entityType:     "Role",
+		nameAttribute:  "role_name",
+		findPolicyARNs: FindRoleAttachedPolicyARNs,
+		attachPolicy:   attachPolicyToRole,
+		detachPolicy:   DetachPolicyFromRole,
+	}
+	userPolicyAttachmentsExclusiveEntity = &policyAttachmentsExclusiveEntity{
+		entityType:     "User",
+		nameAttribute:  "user_name",
+		findPolicyARNs: FindUserAttachedPolicyARNs,
+		attachPolicy:   attachPolicyToUser,
+		detachPolicy:   DetachPolicyFromUser,
+	}
+)
+
+func ResourceGroupPolicyAttachmentsExclusive() *schema.Resource {
+	return resourcePolicyAttachmentsExclusive(groupPolicyAttachmentsExclusiveEntity)
+}
+
+func ResourceRolePolicyAttachmentsExclusive() *schema.Resource {
+	return resourcePolicyAttachmentsExclusive(rolePolicyAttachmentsExclusiveEntity)
+}
+
+func ResourceUserPolicyAttachmentsExclusive() *schema.Resource {
+	return resourcePolicyAttachmentsExclusive(userPolicyAttachmentsExclusiveEntity)
+}
+
+func resourcePolicyAttachmentsExclusive(entity *policyAttachmentsExclusiveEntity) *schema.Resource {
+	return &schema.Resource{
+		Create: entity.resourcePut,
+		Read:   entity.resourceRead,
+		Update: entity.resourcePut,
+		Delete: entity.resourceDelete,
+
+		Importer: &schema.ResourceImporter{
+			State: entity.resourceImport,
+		},
+
+		Schema: map[string]*schema.Schema{
+			entity.nameAttribute: {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"policy_arns": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: verify.ValidARN,
+				},
+			},
+		},
+	}
+}
+
+func (entity *policyAttachmentsExclusiveEntity) resourcePut(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).IAMConn
+
+	name := d.Get(entity.nameAttribute).(string)
+	want := aws.StringValueSlice(flex.ExpandStringSet(d.Get("policy_arns").(*schema.Set)))
+
+	have, err := entity.findPolicyARNs(conn, name)
+
+	if err != nil {
+		return fmt.Errorf("error reading IAM %s (%s) policy attachments: %w", entity.entityType, name, err)
+	}
+
+	add, del := policyExclusiveDifference(want, have)
+
+	for _, policyARN := range add {
+		if err := entity.attachPolicy(conn, name, policyARN); err != nil {
+			return fmt.Errorf("error attaching IAM Policy (%s) to IAM %s (%s): %w", policyARN, entity.entityType, name, err)
+		}
+	}
+
+	for _, policyARN := range del {
+		err := entity.detachPolicy(conn, name, policyARN)
+
+		if tfawserr.ErrCodeEquals(err, iam.ErrCodeNoSuchEntityException) {
+			continue
+		}
+
+		if err != nil {
+			return fmt.Errorf("error detaching IAM Policy (%s) from IAM %s (%s): %w", policyARN, entity.entityType, name, err)
+		}
+	}
+
+	d.SetId(name)
+
+	return entity.resourceRead(d, meta)
+}
+
+func (entity *policyAttachmentsExclusiveEntity) resourceRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).IAMConn
+
+	policyARNs, err := entity.findPolicyARNs(conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] IAM %s (%s) not found, removing policy attachments from state", entity.entityType, d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error reading IAM %s (%s) policy attachments: %w", entity.entityType, d.Id(), err)
+	}
+
+	d.Set(entity.nameAttribute, d.Id())
+
+	if err := d.Set("policy_arns", policyARNs); err != nil {
+		return fmt.Errorf("error setting policy_arns: %w", err)
+	}
+
+	return nil
+}
+
+// resourceDelete removes the resource from state only. Policies remain attached.
+func (entity *policyAttachmentsExclusiveEntity) resourceDelete(d *schema.ResourceData, meta interface{}) error {
+	log.Printf("[DEBUG] Removing IAM %s (%s) exclusive policy attachments from state; attached policies are not detached", entity.entityType, d.Id())
+
+	return nil
+}
+
+func (entity *policyAttachmentsExclusiveEntity) resourceImport(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	d.Set(entity.nameAttribute, d.Id())
+
+	return []*schema.ResourceData{d}, nil
+}
+
+// policyExclusiveDifference returns the values in want that are not in have, and the values in have that are not in want.
+func policyExclusiveDifference(want, have []string) (add, del []string) {
+	wantSet := make(map[string]struct{}, len(want))
+	for _, v := range want {
+		wantSet[v] = struct{}{}
+	}
+
+	haveSet := make(map[string]struct{}, len(have))
+	for _, v := range have {
+		haveSet[v] = struct{}{}
+
+		if _, ok := wantSet[v]; !ok {
+			del = append(del, v)
+		}
+	}
+
+	for _, v := range want {
+		if _, ok := haveSet[v]; !ok {
+			add = append(add, v)
+		}
+	}
+
+	return add, del
+}
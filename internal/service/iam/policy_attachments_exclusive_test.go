@@ -0,0 +1,446 @@
+package iam_test
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/iam"
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfiam "github.com/hashicorp/terraform-provider-aws/internal/service/iam"
+)
+
+func TestAccIAMRolePolicyAttachmentsExclusive_basic(t *testing.T) {
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_iam_role_policy_attachments_exclusive.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, iam.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckRoleDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccRolePolicyAttachmentsExclusiveConfig(rName, 2),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckPolicyAttachmentsExclusive(resourceName, tfiam.FindRoleAttachedPolicyARNs, "aws_iam_policy.test.0", "aws_iam_policy.test.1"),
+					resource.TestCheckResourceAttrPair(resourceName, "role_name", "aws_iam_role.test", "name"),
+					resource.TestCheckResourceAttr(resourceName, "policy_arns.#", "2"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccRolePolicyAttachmentsExclusiveConfig(rName, 1),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckPolicyAttachmentsExclusive(resourceName, tfiam.FindRoleAttachedPolicyARNs, "aws_iam_policy.test.0"),
+					resource.TestCheckResourceAttr(resourceName, "policy_arns.#", "1"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccIAMRolePolicyAttachmentsExclusive_empty(t *testing.T) {
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_iam_role_policy_attachments_exclusive.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, iam.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckRoleDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccRolePolicyAttachmentsExclusiveConfig(rName, 0),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckPolicyAttachmentsExclusive(resourceName, tfiam.FindRoleAttachedPolicyARNs),
+					resource.TestCheckResourceAttr(resourceName, "policy_arns.#", "0"),
+				),
+			},
+			{
+				Config: testAccRolePolicyAttachmentsExclusiveNoPolicyARNsConfig(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckRolePolicyAttachmentsExclusiveAttachOutOfBand(resourceName, "aws_iam_policy.extra"),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+			{
+				Config: testAccRolePolicyAttachmentsExclusiveNoPolicyARNsConfig(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckPolicyAttachmentsExclusive(resourceName, tfiam.FindRoleAttachedPolicyARNs),
+					resource.TestCheckResourceAttr(resourceName, "policy_arns.#", "0"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccIAMRolePolicyAttachmentsExclusive_attachMissing(t *testing.T) {
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_iam_role_policy_attachments_exclusive.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, iam.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckRoleDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccRolePolicyAttachmentsExclusiveDirectConfig(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckPolicyAttachmentsExclusive(resourceName, tfiam.FindRoleAttachedPolicyARNs, "aws_iam_policy.extra"),
+					resource.TestCheckResourceAttr(resourceName, "policy_arns.#", "1"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccIAMRolePolicyAttachmentsExclusive_outOfBandAddition(t *testing.T) {
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_iam_role_policy_attachments_exclusive.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, iam.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckRoleDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccRolePolicyAttachmentsExclusiveConfig(rName, 1),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckPolicyAttachmentsExclusive(resourceName, tfiam.FindRoleAttachedPolicyARNs, "aws_iam_policy.test.0"),
+					testAccCheckRolePolicyAttachmentsExclusiveAttachOutOfBand(resourceName, "aws_iam_policy.extra"),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+			{
+				Config: testAccRolePolicyAttachmentsExclusiveConfig(rName, 1),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckPolicyAttachmentsExclusive(resourceName, tfiam.FindRoleAttachedPolicyARNs, "aws_iam_policy.test.0"),
+					resource.TestCheckResourceAttr(resourceName, "policy_arns.#", "1"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccIAMRolePolicyAttachmentsExclusive_roleDisappears(t *testing.T) {
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_iam_role_policy_attachments_exclusive.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, iam.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckRoleDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccRolePolicyAttachmentsExclusiveConfig(rName, 0),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckPolicyAttachmentsExclusive(resourceName, tfiam.FindRoleAttachedPolicyARNs),
+					acctest.CheckResourceDisappears(acctest.Provider, tfiam.ResourceRole(), "aws_iam_role.test"),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccIAMUserPolicyAttachmentsExclusive_basic(t *testing.T) {
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_iam_user_policy_attachments_exclusive.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, iam.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckUserDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccUserPolicyAttachmentsExclusiveConfig(rName, 2),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckPolicyAttachmentsExclusive(resourceName, tfiam.FindUserAttachedPolicyARNs, "aws_iam_policy.test.0", "aws_iam_policy.test.1"),
+					resource.TestCheckResourceAttrPair(resourceName, "user_name", "aws_iam_user.test", "name"),
+					resource.TestCheckResourceAttr(resourceName, "policy_arns.#", "2"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccUserPolicyAttachmentsExclusiveConfig(rName, 1),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckPolicyAttachmentsExclusive(resourceName, tfiam.FindUserAttachedPolicyARNs, "aws_iam_policy.test.0"),
+					resource.TestCheckResourceAttr(resourceName, "policy_arns.#", "1"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccIAMGroupPolicyAttachmentsExclusive_basic(t *testing.T) {
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_iam_group_policy_attachments_exclusive.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, iam.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckGroupDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccGroupPolicyAttachmentsExclusiveConfig(rName, 2),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckPolicyAttachmentsExclusive(resourceName, tfiam.FindGroupAttachedPolicyARNs, "aws_iam_policy.test.0", "aws_iam_policy.test.1"),
+					resource.TestCheckResourceAttrPair(resourceName, "group_name", "aws_iam_group.test", "name"),
+					resource.TestCheckResourceAttr(resourceName, "policy_arns.#", "2"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccGroupPolicyAttachmentsExclusiveConfig(rName, 1),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckPolicyAttachmentsExclusive(resourceName, tfiam.FindGroupAttachedPolicyARNs, "aws_iam_policy.test.0"),
+					resource.TestCheckResourceAttr(resourceName, "policy_arns.#", "1"),
+				),
+			},
+		},
+	})
+}
+
+// testAccCheckPolicyAttachmentsExclusive checks that exactly the ARNs of the specified aws_iam_policy resources are attached.
+func testAccCheckPolicyAttachmentsExclusive(n string, find func(*iam.IAM, string) ([]string, error), policyResourceNames ...string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No IAM policy attachments ID is set")
+		}
+
+		var want []string
+		for _, policyResourceName := range policyResourceNames {
+			policy, ok := s.RootModule().Resources[policyResourceName]
+			if !ok {
+				return fmt.Errorf("Not found: %s", policyResourceName)
+			}
+
+			want = append(want, policy.Primary.Attributes["arn"])
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).IAMConn
+
+		got, err := find(conn, rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		sort.Strings(want)
+		sort.Strings(got)
+
+		if strings.Join(got, ",") != strings.Join(want, ",") {
+			return fmt.Errorf("expected attached policies %v, got %v", want, got)
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckRolePolicyAttachmentsExclusiveAttachOutOfBand(n, policyResourceName string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		policy, ok := s.RootModule().Resources[policyResourceName]
+		if !ok {
+			return fmt.Errorf("Not found: %s", policyResourceName)
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).IAMConn
+
+		_, err := conn.AttachRolePolicy(&iam.AttachRolePolicyInput{
+			PolicyArn: aws.String(policy.Primary.Attributes["arn"]),
+			RoleName:  aws.String(rs.Primary.ID),
+		})
+
+		return err
+	}
+}
+
+func testAccPolicyAttachmentsExclusiveBaseConfig(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_iam_policy" "test" {
+  count = 2
+
+  name = "%[1]s-${count.index}"
+
+  policy = jsonencode({
+    Version = "2012-10-17"
+    Statement = [{
+      Action   = "ec2:Describe*"
+      Effect   = "Allow"
+      Resource = "*"
+    }]
+  })
+}
+
+resource "aws_iam_policy" "extra" {
+  name = "%[1]s-extra"
+
+  policy = jsonencode({
+    Version = "2012-10-17"
+    Statement = [{
+      Action   = "s3:ListAllMyBuckets"
+      Effect   = "Allow"
+      Resource = "*"
+    }]
+  })
+}
+`, rName)
+}
+
+func testAccRolePolicyAttachmentsExclusiveConfig(rName string, n int) string {
+	return acctest.ConfigCompose(testAccPolicyAttachmentsExclusiveBaseConfig(rName), fmt.Sprintf(`
+data "aws_partition" "current" {}
+
+resource "aws_iam_role" "test" {
+  name = %[1]q
+
+  assume_role_policy = jsonencode({
+    Version = "2012-10-17"
+    Statement = [{
+      Action = "sts:AssumeRole"
+      Effect = "Allow"
+      Principal = {
+        Service = "ec2.${data.aws_partition.current.dns_suffix}"
+      }
+    }]
+  })
+}
+
+resource "aws_iam_role_policy_attachment" "test" {
+  count = %[2]d
+
+  role       = aws_iam_role.test.name
+  policy_arn = aws_iam_policy.test[count.index].arn
+}
+
+resource "aws_iam_role_policy_attachments_exclusive" "test" {
+  role_name   = aws_iam_role.test.name
+  policy_arns = aws_iam_role_policy_attachment.test[*].policy_arn
+}
+`, rName, n))
+}
+
+func testAccRolePolicyAttachmentsExclusiveNoPolicyARNsConfig(rName string) string {
+	return acctest.ConfigCompose(testAccPolicyAttachmentsExclusiveBaseConfig(rName), fmt.Sprintf(`
+data "aws_partition" "current" {}
+
+resource "aws_iam_role" "test" {
+  name = %[1]q
+
+  assume_role_policy = jsonencode({
+    Version = "2012-10-17"
+    Statement = [{
+      Action = "sts:AssumeRole"
+      Effect = "Allow"
+      Principal = {
+        Service = "ec2.${data.aws_partition.current.dns_suffix}"
+      }
+    }]
+  })
+}
+
+resource "aws_iam_role_policy_attachments_exclusive" "test" {
+  role_name = aws_iam_role.test.name
+}
+`, rName))
+}
+
+func testAccRolePolicyAttachmentsExclusiveDirectConfig(rName string) string {
+	return acctest.ConfigCompose(testAccPolicyAttachmentsExclusiveBaseConfig(rName), fmt.Sprintf(`
+data "aws_partition" "current" {}
+
+resource "aws_iam_role" "test" {
+  name = %[1]q
+
+  assume_role_policy = jsonencode({
+    Version = "2012-10-17"
+    Statement = [{
+      Action = "sts:AssumeRole"
+      Effect = "Allow"
+      Principal = {
+        Service = "ec2.${data.aws_partition.current.dns_suffix}"
+      }
+    }]
+  })
+}
+
+resource "aws_iam_role_policy_attachments_exclusive" "test" {
+  role_name   = aws_iam_role.test.name
+  policy_arns = [aws_iam_policy.extra.arn]
+}
+`, rName))
+}
+
+func testAccUserPolicyAttachmentsExclusiveConfig(rName string, n int) string {
+	return acctest.ConfigCompose(testAccPolicyAttachmentsExclusiveBaseConfig(rName), fmt.Sprintf(`
+resource "aws_iam_user" "test" {
+  name = %[1]q
+}
+
+resource "aws_iam_user_policy_attachment" "test" {
+  count = %[2]d
+
+  user       = aws_iam_user.test.name
+  policy_arn = aws_iam_policy.test[count.index].arn
+}
+
+resource "aws_iam_user_policy_attachments_exclusive" "test" {
+  user_name   = aws_iam_user.test.name
+  policy_arns = aws_iam_user_policy_attachment.test[*].policy_arn
+}
+`, rName, n))
+}
+
+func testAccGroupPolicyAttachmentsExclusiveConfig(rName string, n int) string {
+	return acctest.ConfigCompose(testAccPolicyAttachmentsExclusiveBaseConfig(rName), fmt.Sprintf(`
+resource "aws_iam_group" "test" {
+  name = %[1]q
+}
+
+resource "aws_iam_group_policy_attachment" "test" {
+  count = %[2]d
+
+  group      = aws_iam_group.test.name
+  policy_arn = aws_iam_policy.test[count.index].arn
+}
+
+resource "aws_iam_group_policy_attachments_exclusive" "test" {
+  group_name  = aws_iam_group.test.name
+  policy_arns = aws_iam_group_policy_attachment.test[*].policy_arn
+}
+`, rName, n))
+}
@@ -1,16 +1,21 @@
 package iam
 
 import (
+	"context"
 	"encoding/json"
 	"errors"
 	"fmt"
 	"strconv"
 	"strings"
+	"unicode"
 
 	"github.com/aws/aws-sdk-go/aws"
+	"github.com/hashicorp/go-multierror"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/hashicorp/terraform-provider-aws/internal/create"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
 )
 
 var dataSourcePolicyDocumentVarReplacer = strings.NewReplacer("&{", "${")
@@ -25,7 +30,7 @@ func DataSourcePolicyDocument() *schema.Resource {
 	}
 
 	return &schema.Resource{
-		Read: dataSourcePolicyDocumentRead,
+		ReadContext: dataSourcePolicyDocumentRead,
 
 		Schema: map[string]*schema.Schema{
 			"json": {
@@ -114,12 +119,12 @@ func DataSourcePolicyDocument() *schema.Resource {
 	}
 }
 
-func dataSourcePolicyDocumentRead(d *schema.ResourceData, meta interface{}) error {
+func dataSourcePolicyDocumentRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	mergedDoc := &IAMPolicyDoc{}
 
 	if v, ok := d.GetOk("source_json"); ok {
 		if err := json.Unmarshal([]byte(v.(string)), mergedDoc); err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 	}
 
@@ -136,14 +141,14 @@ func dataSourcePolicyDocumentRead(d *schema.ResourceData, meta interface{}) erro
 		for sourceJSONIndex, sourceJSON := range v.([]interface{}) {
 			sourceDoc := &IAMPolicyDoc{}
 			if err := json.Unmarshal([]byte(sourceJSON.(string)), sourceDoc); err != nil {
-				return err
+				return diag.FromErr(err)
 			}
 
 			// assure all statements in sourceDoc are unique before merging
 			for stmtIndex, stmt := range sourceDoc.Statements {
 				if stmt.Sid != "" {
 					if _, sidExists := sidMap[stmt.Sid]; sidExists {
-						return fmt.Errorf("duplicate Sid (%s) in source_policy_documents (item %d; statement %d). Remove the Sid or ensure Sids are unique.", stmt.Sid, sourceJSONIndex, stmtIndex)
+						return diag.Errorf("duplicate Sid (%s) in source_policy_documents (item %d; statement %d). Remove the Sid or ensure Sids are unique.", stmt.Sid, sourceJSONIndex, stmtIndex)
 					}
 					sidMap[stmt.Sid] = struct{}{}
 				}
@@ -176,7 +181,7 @@ func dataSourcePolicyDocumentRead(d *schema.ResourceData, meta interface{}) erro
 
 			if sid, ok := cfgStmt["sid"]; ok {
 				if _, ok := sidMap[sid.(string)]; ok {
-					return fmt.Errorf("duplicate Sid (%s). Remove the Sid or ensure the Sid is unique.", sid.(string))
+					return diag.Errorf("duplicate Sid (%s). Remove the Sid or ensure the Sid is unique.", sid.(string))
 				}
 				stmt.Sid = sid.(string)
 				if len(stmt.Sid) > 0 {
@@ -197,7 +202,7 @@ func dataSourcePolicyDocumentRead(d *schema.ResourceData, meta interface{}) erro
 					iamPolicyDecodeConfigStringList(resources), doc.Version,
 				)
 				if err != nil {
-					return fmt.Errorf("error reading resources: %w", err)
+					return diag.Errorf("error reading resources: %s", err)
 				}
 			}
 			if notResources := cfgStmt["not_resources"].(*schema.Set).List(); len(notResources) > 0 {
@@ -206,7 +211,7 @@ func dataSourcePolicyDocumentRead(d *schema.ResourceData, meta interface{}) erro
 					iamPolicyDecodeConfigStringList(notResources), doc.Version,
 				)
 				if err != nil {
-					return fmt.Errorf("error reading not_resources: %w", err)
+					return diag.Errorf("error reading not_resources: %s", err)
 				}
 			}
 
@@ -214,7 +219,7 @@ func dataSourcePolicyDocumentRead(d *schema.ResourceData, meta interface{}) erro
 				var err error
 				stmt.Principals, err = dataSourcePolicyDocumentMakePrincipals(principals, doc.Version)
 				if err != nil {
-					return fmt.Errorf("error reading principals: %w", err)
+					return diag.Errorf("error reading principals: %s", err)
 				}
 			}
 
@@ -222,7 +227,7 @@ func dataSourcePolicyDocumentRead(d *schema.ResourceData, meta interface{}) erro
 				var err error
 				stmt.NotPrincipals, err = dataSourcePolicyDocumentMakePrincipals(notPrincipals, doc.Version)
 				if err != nil {
-					return fmt.Errorf("error reading not_principals: %w", err)
+					return diag.Errorf("error reading not_principals: %s", err)
 				}
 			}
 
@@ -230,7 +235,7 @@ func dataSourcePolicyDocumentRead(d *schema.ResourceData, meta interface{}) erro
 				var err error
 				stmt.Conditions, err = dataSourcePolicyDocumentMakeConditions(conditions, doc.Version)
 				if err != nil {
-					return fmt.Errorf("error reading condition: %w", err)
+					return diag.Errorf("error reading condition: %s", err)
 				}
 			}
 
@@ -249,7 +254,7 @@ func dataSourcePolicyDocumentRead(d *schema.ResourceData, meta interface{}) erro
 		for _, overrideJSON := range v.([]interface{}) {
 			overrideDoc := &IAMPolicyDoc{}
 			if err := json.Unmarshal([]byte(overrideJSON.(string)), overrideDoc); err != nil {
-				return err
+				return diag.FromErr(err)
 			}
 
 			mergedDoc.Merge(overrideDoc)
@@ -261,7 +266,7 @@ func dataSourcePolicyDocumentRead(d *schema.ResourceData, meta interface{}) erro
 	if v, ok := d.GetOk("override_json"); ok {
 		overrideDoc := &IAMPolicyDoc{}
 		if err := json.Unmarshal([]byte(v.(string)), overrideDoc); err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 
 		mergedDoc.Merge(overrideDoc)
@@ -270,14 +275,37 @@ func dataSourcePolicyDocumentRead(d *schema.ResourceData, meta interface{}) erro
 	jsonDoc, err := json.MarshalIndent(mergedDoc, "", "  ")
 	if err != nil {
 		// should never happen if the above code is correct
-		return err
+		return diag.FromErr(err)
 	}
 	jsonString := string(jsonDoc)
 
+	var diags diag.Diagnostics
+
+	ws, errs := verify.ValidateIAMPolicyDocument(jsonString)
+
+	if len(errs) > 0 {
+		return diag.Errorf("error validating IAM policy document: %s", &multierror.Error{Errors: errs})
+	}
+
+	for _, w := range ws {
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Warning,
+			Summary:  fmt.Sprintf("IAM policy document %s", w),
+		})
+	}
+
+	if n := policyDocumentSize(jsonString); n > policyDocumentManagedPolicyMaxSize {
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Warning,
+			Summary:  fmt.Sprintf("IAM policy document size (%d) exceeds the managed policy limit (%d)", n, policyDocumentManagedPolicyMaxSize),
+			Detail:   "Whitespace is not counted. Inline and resource-based policies have different size limits.",
+		})
+	}
+
 	d.Set("json", jsonString)
 	d.SetId(strconv.Itoa(create.StringHashcode(jsonString)))
 
-	return nil
+	return diags
 }
 
 func dataSourcePolicyDocumentReplaceVarsInList(in interface{}, version string) (interface{}, error) {
@@ -366,3 +394,19 @@ func dataSourcePolicyPrincipalSchema() *schema.Schema {
 		},
 	}
 }
+
+// policyDocumentManagedPolicyMaxSize is the maximum size, in non-whitespace characters, of a managed policy document.
+const policyDocumentManagedPolicyMaxSize = 6144
+
+// policyDocumentSize returns the size of the specified policy document as counted by IAM, i.e. excluding whitespace.
+func policyDocumentSize(policy string) int {
+	n := 0
+
+	for _, r := range policy {
+		if !unicode.IsSpace(r) {
+			n++
+		}
+	}
+
+	return n
+}
@@ -129,6 +129,50 @@ func TestAccIAMPolicyDocumentDataSource_sourceListConflicting(t *testing.T) {
 	})
 }
 
+func TestAccIAMPolicyDocumentDataSource_invalidConditionOperator(t *testing.T) {
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:   func() { acctest.PreCheck(t) },
+		ErrorCheck: acctest.ErrorCheck(t, iam.EndpointsID),
+		Providers:  acctest.Providers,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccPolicyDocumentInvalidConditionOperatorConfig,
+				ExpectError: regexp.MustCompile(`unsupported condition operator \(StringEqual\)`),
+			},
+		},
+	})
+}
+
+func TestAccIAMPolicyDocumentDataSource_actionsAndNotActions(t *testing.T) {
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:   func() { acctest.PreCheck(t) },
+		ErrorCheck: acctest.ErrorCheck(t, iam.EndpointsID),
+		Providers:  acctest.Providers,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccPolicyDocumentActionsAndNotActionsConfig,
+				ExpectError: regexp.MustCompile(`cannot contain both Action and NotAction`),
+			},
+		},
+	})
+}
+
+func TestAccIAMPolicyDocumentDataSource_nonARNResource(t *testing.T) {
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:   func() { acctest.PreCheck(t) },
+		ErrorCheck: acctest.ErrorCheck(t, iam.EndpointsID),
+		Providers:  acctest.Providers,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccPolicyDocumentNonARNResourceConfig,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestMatchResourceAttr("data.aws_iam_policy_document.test", "json", regexp.MustCompile(`"Resource": "execute-api:/\*"`)),
+				),
+			},
+		},
+	})
+}
+
 func TestAccIAMPolicyDocumentDataSource_override(t *testing.T) {
 	resource.ParallelTest(t, resource.TestCase{
 		PreCheck:   func() { acctest.PreCheck(t) },
@@ -1320,3 +1364,42 @@ func testAccPolicyDocumentExpectedJSONStatementPrincipalIdentifiersMultiplePrinc
   ]
 }`, acctest.Partition())
 }
+
+var testAccPolicyDocumentInvalidConditionOperatorConfig = `
+data "aws_iam_policy_document" "test" {
+  statement {
+    actions   = ["s3:ListBucket"]
+    resources = ["*"]
+
+    condition {
+      test     = "StringEqual"
+      variable = "s3:prefix"
+      values   = ["home/"]
+    }
+  }
+}
+`
+
+var testAccPolicyDocumentActionsAndNotActionsConfig = `
+data "aws_iam_policy_document" "test" {
+  statement {
+    actions     = ["s3:GetObject"]
+    not_actions = ["s3:PutObject"]
+    resources   = ["*"]
+  }
+}
+`
+
+var testAccPolicyDocumentNonARNResourceConfig = `
+data "aws_iam_policy_document" "test" {
+  statement {
+    actions   = ["execute-api:Invoke"]
+    resources = ["execute-api:/*"]
+
+    principals {
+      type        = "*"
+      identifiers = ["*"]
+    }
+  }
+}
+`
@@ -0,0 +1,843 @@
+package iam
+
+import (
+	"encoding/json"
+	"fmt"
+	"net"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws/arn"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+// Policy evaluation decisions, as returned by the IAM policy simulator.
+const (
+	PolicyEvaluationDecisionAllowed      = "allowed"
+	PolicyEvaluationDecisionExplicitDeny = "explicitDeny"
+	PolicyEvaluationDecisionImplicitDeny = "implicitDeny"
+)
+
+// Policy types, in the order in which they are evaluated for explicit denies.
+const (
+	PolicyTypeIdentity            = "identity"
+	PolicyTypeResource            = "resource"
+	PolicyTypePermissionsBoundary = "permissions_boundary"
+	PolicyTypeServiceControl      = "service_control"
+)
+
+// PolicyEvaluationInput is the set of policies evaluated by a PolicyEvaluator.
+type PolicyEvaluationInput struct {
+	IdentityPolicies            []string
+	ResourcePolicies            []string
+	PermissionsBoundaryPolicies []string
+	ServiceControlPolicies      []string
+
+	// PrincipalARN is the principal making requests.
+	// It is matched against the Principal and NotPrincipal elements of resource-based policies.
+	PrincipalARN string
+}
+
+// PolicyEvaluationResult is the decision for a single action and resource.
+type PolicyEvaluationResult struct {
+	Action   string
+	Resource string
+	Decision string
+
+	// MatchedStatementSid and MatchedPolicyType identify the statement that determined the decision.
+	// Both are empty for an implicit deny that no statement matched.
+	MatchedStatementSid string
+	MatchedPolicyType   string
+
+	// LimitingPolicyType is set for an implicit deny caused by service control policies or
+	// permissions boundaries that don't allow the request.
+	LimitingPolicyType string
+}
+
+func (r *PolicyEvaluationResult) Allowed() bool {
+	return r.Decision == PolicyEvaluationDecisionAllowed
+}
+
+// PolicyEvaluator evaluates requests against IAM policies without calling AWS.
+// It implements the subset of the AWS policy evaluation logic that applies within a single account
+// (https://docs.aws.amazon.com/IAM/latest/UserGuide/reference_policies_evaluation-logic.html):
+// an explicit deny in any policy overrides any allow, service control policies and permissions boundaries
+// must allow a request, and either an identity-based or a resource-based policy must allow a request.
+// A resource-based policy that names the principal's ARN is not limited by a permissions boundary.
+// Session policies and cross-account access are not modelled.
+type PolicyEvaluator struct {
+	identity            []*policyEvaluationDocument
+	resource            []*policyEvaluationDocument
+	permissionsBoundary []*policyEvaluationDocument
+	serviceControl      []*policyEvaluationDocument
+
+	principalARN string
+}
+
+func NewPolicyEvaluator(input *PolicyEvaluationInput) (*PolicyEvaluator, error) {
+	e := &PolicyEvaluator{
+		principalARN: input.PrincipalARN,
+	}
+
+	for _, v := range []struct {
+		policies  []string
+		documents *[]*policyEvaluationDocument
+		name      string
+	}{
+		{input.IdentityPolicies, &e.identity, "identity"},
+		{input.ResourcePolicies, &e.resource, "resource"},
+		{input.PermissionsBoundaryPolicies, &e.permissionsBoundary, "permissions boundary"},
+		{input.ServiceControlPolicies, &e.serviceControl, "service control"},
+	} {
+		for i, policy := range v.policies {
+			document, err := parsePolicyEvaluationDocument(policy)
+
+			if err != nil {
+				return nil, fmt.Errorf("error parsing %s policy %d: %w", v.name, i, err)
+			}
+
+			*v.documents = append(*v.documents, document)
+		}
+	}
+
+	return e, nil
+}
+
+// Evaluate returns the decision for the specified action on the specified resource.
+// Context keys are matched case-insensitively.
+func (e *PolicyEvaluator) Evaluate(action, resource string, context map[string][]string) *PolicyEvaluationResult {
+	result := &PolicyEvaluationResult{
+		Action:   action,
+		Resource: resource,
+		Decision: PolicyEvaluationDecisionImplicitDeny,
+	}
+
+	request := &policyEvaluationRequest{
+		action:   action,
+		resource: resource,
+		context:  make(map[string][]string),
+	}
+
+	for k, v := range context {
+		request.context[strings.ToLower(k)] = v
+	}
+
+	if e.principalARN != "" {
+		if _, ok := request.context["aws:principalarn"]; !ok {
+			request.context["aws:principalarn"] = []string{e.principalARN}
+		}
+
+		if v, err := arn.Parse(e.principalARN); err == nil {
+			if _, ok := request.context["aws:principalaccount"]; !ok {
+				request.context["aws:principalaccount"] = []string{v.AccountID}
+			}
+		}
+	}
+
+	policyTypes := []struct {
+		documents    []*policyEvaluationDocument
+		policyType   string
+		usePrincipal bool
+	}{
+		{e.identity, PolicyTypeIdentity, false},
+		{e.resource, PolicyTypeResource, true},
+		{e.permissionsBoundary, PolicyTypePermissionsBoundary, false},
+		{e.serviceControl, PolicyTypeServiceControl, false},
+	}
+
+	// An explicit deny in any policy overrides any allow.
+	for _, v := range policyTypes {
+		if statement := e.match(v.documents, "Deny", request, v.usePrincipal); statement != nil {
+			result.Decision = PolicyEvaluationDecisionExplicitDeny
+			result.MatchedStatementSid = statement.sid
+			result.MatchedPolicyType = v.policyType
+
+			return result
+		}
+	}
+
+	// Service control policies and permissions boundaries limit, but don't grant, permissions.
+	if len(e.serviceControl) > 0 && e.match(e.serviceControl, "Allow", request, false) == nil {
+		result.LimitingPolicyType = PolicyTypeServiceControl
+
+		return result
+	}
+
+	if len(e.permissionsBoundary) > 0 && e.match(e.permissionsBoundary, "Allow", request, false) == nil {
+		// A resource-based policy that names the principal's ARN isn't limited by the permissions boundary.
+		if statement := e.matchPrincipalARN(e.resource, request); statement != nil {
+			result.Decision = PolicyEvaluationDecisionAllowed
+			result.MatchedStatementSid = statement.sid
+			result.MatchedPolicyType = PolicyTypeResource
+
+			return result
+		}
+
+		result.LimitingPolicyType = PolicyTypePermissionsBoundary
+
+		return result
+	}
+
+	for _, v := range policyTypes[:2] {
+		if statement := e.match(v.documents, "Allow", request, v.usePrincipal); statement != nil {
+			result.Decision = PolicyEvaluationDecisionAllowed
+			result.MatchedStatementSid = statement.sid
+			result.MatchedPolicyType = v.policyType
+
+			return result
+		}
+	}
+
+	return result
+}
+
+// match returns the first statement with the specified effect that applies to the request, or nil if there is no such statement.
+func (e *PolicyEvaluator) match(documents []*policyEvaluationDocument, effect string, request *policyEvaluationRequest, usePrincipal bool) *policyEvaluationStatement {
+	for _, document := range documents {
+		for _, statement := range document.statements {
+			if statement.effect != effect {
+				continue
+			}
+
+			if usePrincipal && !statement.matchesPrincipal(e.principalARN) {
+				continue
+			}
+
+			if statement.matches(request) {
+				return statement
+			}
+		}
+	}
+
+	return nil
+}
+
+// matchPrincipalARN returns the first Allow statement that names the principal's ARN in its Principal element
+// and applies to the request, or nil if there is no such statement.
+func (e *PolicyEvaluator) matchPrincipalARN(documents []*policyEvaluationDocument, request *policyEvaluationRequest) *policyEvaluationStatement {
+	if e.principalARN == "" {
+		return nil
+	}
+
+	for _, document := range documents {
+		for _, statement := range document.statements {
+			if statement.effect != "Allow" || statement.hasNotPrincipal {
+				continue
+			}
+
+			for _, identifier := range statement.principals["AWS"] {
+				if identifier == e.principalARN && statement.matches(request) {
+					return statement
+				}
+			}
+		}
+	}
+
+	return nil
+}
+
+type policyEvaluationRequest struct {
+	action   string
+	resource string
+	context  map[string][]string // Keys are lower case.
+}
+
+type policyEvaluationDocument struct {
+	statements []*policyEvaluationStatement
+}
+
+type policyEvaluationStatement struct {
+	sid    string
+	effect string
+
+	actions         []string
+	notActions      []string
+	hasNotAction    bool
+	resources       []string
+	notResources    []string
+	hasNotResource  bool
+	principals      map[string][]string
+	notPrincipals   map[string][]string
+	hasNotPrincipal bool
+
+	conditions []*policyEvaluationCondition
+}
+
+type policyEvaluationCondition struct {
+	operator string
+	key      string
+	values   []string
+}
+
+func parsePolicyEvaluationDocument(policy string) (*policyEvaluationDocument, error) {
+	var raw map[string]interface{}
+
+	if err := json.Unmarshal([]byte(policy), &raw); err != nil {
+		return nil, err
+	}
+
+	var statements []interface{}
+
+	switch v := raw["Statement"].(type) {
+	case nil:
+	case []interface{}:
+		statements = v
+	case map[string]interface{}:
+		statements = []interface{}{v}
+	default:
+		return nil, fmt.Errorf("Statement must be an object or an array of objects")
+	}
+
+	document := &policyEvaluationDocument{}
+
+	for i, v := range statements {
+		m, ok := v.(map[string]interface{})
+
+		if !ok {
+			return nil, fmt.Errorf("statement %d must be an object", i)
+		}
+
+		statement, err := parsePolicyEvaluationStatement(m)
+
+		if err != nil {
+			return nil, fmt.Errorf("statement %d: %w", i, err)
+		}
+
+		document.statements = append(document.statements, statement)
+	}
+
+	return document, nil
+}
+
+func parsePolicyEvaluationStatement(m map[string]interface{}) (*policyEvaluationStatement, error) {
+	statement := &policyEvaluationStatement{}
+	statement.sid, _ = m["Sid"].(string)
+	statement.effect, _ = m["Effect"].(string)
+
+	if statement.effect != "Allow" && statement.effect != "Deny" {
+		return nil, fmt.Errorf("Effect must be Allow or Deny")
+	}
+
+	var err error
+
+	for _, v := range []struct {
+		element string
+		values  *[]string
+		present *bool
+	}{
+		{"Action", &statement.actions, nil},
+		{"NotAction", &statement.notActions, &statement.hasNotAction},
+		{"Resource", &statement.resources, nil},
+		{"NotResource", &statement.notResources, &statement.hasNotResource},
+	} {
+		raw, ok := m[v.element]
+
+		if !ok {
+			continue
+		}
+
+		if *v.values, err = verify.PolicyStringOrStringList(raw); err != nil {
+			return nil, fmt.Errorf("%s: %w", v.element, err)
+		}
+
+		if v.present != nil {
+			*v.present = true
+		}
+	}
+
+	if raw, ok := m["Principal"]; ok {
+		if statement.principals, err = parsePolicyEvaluationPrincipal(raw); err != nil {
+			return nil, fmt.Errorf("Principal: %w", err)
+		}
+	}
+
+	if raw, ok := m["NotPrincipal"]; ok {
+		if statement.notPrincipals, err = parsePolicyEvaluationPrincipal(raw); err != nil {
+			return nil, fmt.Errorf("NotPrincipal: %w", err)
+		}
+		statement.hasNotPrincipal = true
+	}
+
+	if raw, ok := m["Condition"]; ok {
+		operators, ok := raw.(map[string]interface{})
+
+		if !ok {
+			return nil, fmt.Errorf("Condition must be an object")
+		}
+
+		for _, operator := range verify.PolicySortedKeys(operators) {
+			if !verify.ValidIAMPolicyConditionOperator(operator) {
+				return nil, fmt.Errorf("unsupported condition operator (%s)", operator)
+			}
+
+			keys, ok := operators[operator].(map[string]interface{})
+
+			if !ok {
+				return nil, fmt.Errorf("condition operator (%s) must map condition keys to values", operator)
+			}
+
+			for _, key := range verify.PolicySortedKeys(keys) {
+				values, err := policyConditionValues(keys[key])
+
+				if err != nil {
+					return nil, fmt.Errorf("condition %s %s: %w", operator, key, err)
+				}
+
+				statement.conditions = append(statement.conditions, &policyEvaluationCondition{
+					operator: operator,
+					key:      key,
+					values:   values,
+				})
+			}
+		}
+	}
+
+	return statement, nil
+}
+
+// parsePolicyEvaluationPrincipal returns the identifiers by principal type.
+// The "*" principal is returned as the "*" type.
+func parsePolicyEvaluationPrincipal(v interface{}) (map[string][]string, error) {
+	principals := make(map[string][]string)
+
+	switch v := v.(type) {
+	case string:
+		if v != "*" {
+			return nil, fmt.Errorf("must be \"*\" or an object")
+		}
+
+		principals["*"] = []string{"*"}
+	case map[string]interface{}:
+		for k, v := range v {
+			identifiers, err := verify.PolicyStringOrStringList(v)
+
+			if err != nil {
+				return nil, fmt.Errorf("%s: %w", k, err)
+			}
+
+			principals[k] = identifiers
+		}
+	default:
+		return nil, fmt.Errorf("must be \"*\" or an object")
+	}
+
+	return principals, nil
+}
+
+// policyConditionValues returns condition values as strings.
+// Boolean and numeric values are allowed unquoted in policies.
+func policyConditionValues(v interface{}) ([]string, error) {
+	raw, ok := v.([]interface{})
+
+	if !ok {
+		raw = []interface{}{v}
+	}
+
+	values := make([]string, 0, len(raw))
+
+	for _, v := range raw {
+		switch v := v.(type) {
+		case string:
+			values = append(values, v)
+		case bool:
+			values = append(values, strconv.FormatBool(v))
+		case float64:
+			values = append(values, strconv.FormatFloat(v, 'f', -1, 64))
+		default:
+			return nil, fmt.Errorf("unsupported value type %T", v)
+		}
+	}
+
+	return values, nil
+}
+
+func (s *policyEvaluationStatement) matches(request *policyEvaluationRequest) bool {
+	// Action names are case-insensitive.
+	action := strings.ToLower(request.action)
+
+	if s.hasNotAction {
+		if policyWildcardMatchAny(policyLowerAll(s.notActions), action, request) {
+			return false
+		}
+	} else if !policyWildcardMatchAny(policyLowerAll(s.actions), action, request) {
+		return false
+	}
+
+	if s.hasNotResource {
+		if policyWildcardMatchAny(s.notResources, request.resource, request) {
+			return false
+		}
+	} else if !policyWildcardMatchAny(s.resources, request.resource, request) {
+		return false
+	}
+
+	for _, condition := range s.conditions {
+		if !condition.matches(request) {
+			return false
+		}
+	}
+
+	return true
+}
+
+func (s *policyEvaluationStatement) matchesPrincipal(principalARN string) bool {
+	if s.hasNotPrincipal {
+		matches, _ := policyPrincipalMatches(s.notPrincipals, principalARN)
+
+		return !matches
+	}
+
+	matches, accountOnly := policyPrincipalMatches(s.principals, principalARN)
+
+	// An account principal in an Allow statement delegates access to the account's identity-based policies
+	// rather than granting it, so the request must also be allowed by an identity-based policy.
+	if accountOnly && s.effect == "Allow" {
+		return false
+	}
+
+	return matches
+}
+
+// policyPrincipalMatches returns whether the specified principal is one of the principals.
+// An account principal, i.e. an account ID or the account's root user ARN, matches any principal in that account;
+// accountOnly reports whether the principal matched only an account principal.
+// An empty principal ARN only matches the "*" principal.
+func policyPrincipalMatches(principals map[string][]string, principalARN string) (matches bool, accountOnly bool) {
+	if _, ok := principals["*"]; ok {
+		return true, false
+	}
+
+	for _, identifier := range principals["AWS"] {
+		if identifier == "*" {
+			return true, false
+		}
+
+		if principalARN == "" {
+			continue
+		}
+
+		if identifier == principalARN {
+			return true, false
+		}
+
+		v, err := arn.Parse(principalARN)
+
+		if err != nil {
+			continue
+		}
+
+		accountRoot := arn.ARN{
+			Partition: v.Partition,
+			Service:   "iam",
+			AccountID: v.AccountID,
+			Resource:  "root",
+		}.String()
+
+		if identifier == v.AccountID || identifier == accountRoot {
+			accountOnly = true
+		}
+	}
+
+	return accountOnly, accountOnly
+}
+
+func (c *policyEvaluationCondition) matches(request *policyEvaluationRequest) bool {
+	operator := c.operator
+	forAllValues, forAnyValue := false, false
+
+	switch {
+	case len(operator) > len("ForAllValues:") && strings.EqualFold(operator[:len("ForAllValues:")], "ForAllValues:"):
+		operator = operator[len("ForAllValues:"):]
+		forAllValues = true
+	case len(operator) > len("ForAnyValue:") && strings.EqualFold(operator[:len("ForAnyValue:")], "ForAnyValue:"):
+		operator = operator[len("ForAnyValue:"):]
+		forAnyValue = true
+	}
+
+	ifExists := false
+	if n := len(operator) - len(verify.IAMPolicyConditionIfExistsSuffix); n > 0 && strings.EqualFold(operator[n:], verify.IAMPolicyConditionIfExistsSuffix) {
+		operator = operator[:n]
+		ifExists = true
+	}
+
+	contextValues, ok := request.context[strings.ToLower(c.key)]
+
+	if strings.EqualFold(operator, "Null") {
+		for _, v := range c.values {
+			if strings.EqualFold(v, "true") == !ok {
+				return true
+			}
+		}
+
+		return false
+	}
+
+	negated := policyConditionOperatorNegated(operator)
+
+	if !ok || len(contextValues) == 0 {
+		switch {
+		case ifExists, forAllValues:
+			return true
+		case forAnyValue:
+			return false
+		default:
+			return negated
+		}
+	}
+
+	values := make([]string, len(c.values))
+	for i, v := range c.values {
+		values[i] = policyReplaceVariables(v, request)
+	}
+
+	// Each context value satisfies the condition if it matches any of the condition values,
+	// or, for a negated operator, none of them.
+	satisfied := func(contextValue string) bool {
+		for _, value := range values {
+			if policyConditionValueMatches(operator, value, contextValue) {
+				return !negated
+			}
+		}
+
+		return negated
+	}
+
+	if forAllValues {
+		for _, v := range contextValues {
+			if !satisfied(v) {
+				return false
+			}
+		}
+
+		return true
+	}
+
+	if negated && !forAnyValue {
+		// A negated operator on a multivalued key requires that no context value matches.
+		for _, v := range contextValues {
+			if !satisfied(v) {
+				return false
+			}
+		}
+
+		return true
+	}
+
+	for _, v := range contextValues {
+		if satisfied(v) {
+			return true
+		}
+	}
+
+	return false
+}
+
+func policyConditionOperatorNegated(operator string) bool {
+	switch strings.ToLower(operator) {
+	case "arnnotequals", "arnnotlike", "datenotequals", "notipaddress", "numericnotequals", "stringnotequals", "stringnotequalsignorecase", "stringnotlike":
+		return true
+	}
+
+	return false
+}
+
+// policyConditionValueMatches returns whether the context value matches the condition value
+// for the non-negated form of the specified operator.
+func policyConditionValueMatches(operator, value, contextValue string) bool {
+	switch strings.ToLower(operator) {
+	case "stringequals", "stringnotequals", "binaryequals":
+		return policyUnescape(value) == contextValue
+	case "stringequalsignorecase", "stringnotequalsignorecase":
+		return strings.EqualFold(policyUnescape(value), contextValue)
+	case "stringlike", "stringnotlike", "arnequals", "arnnotequals", "arnlike", "arnnotlike":
+		return policyWildcardMatch(value, contextValue)
+	case "bool":
+		return strings.EqualFold(value, contextValue)
+	case "numericequals", "numericnotequals", "numericlessthan", "numericlessthanequals", "numericgreaterthan", "numericgreaterthanequals":
+		v, err1 := strconv.ParseFloat(value, 64)
+		cv, err2 := strconv.ParseFloat(contextValue, 64)
+
+		if err1 != nil || err2 != nil {
+			return false
+		}
+
+		return policyCompare(strings.ToLower(operator)[len("numeric"):], cv, v)
+	case "dateequals", "datenotequals", "datelessthan", "datelessthanequals", "dategreaterthan", "dategreaterthanequals":
+		v, err1 := policyParseDate(value)
+		cv, err2 := policyParseDate(contextValue)
+
+		if err1 != nil || err2 != nil {
+			return false
+		}
+
+		return policyCompare(strings.ToLower(operator)[len("date"):], float64(cv.Unix()), float64(v.Unix()))
+	case "ipaddress", "notipaddress":
+		ip := net.ParseIP(contextValue)
+
+		if ip == nil {
+			return false
+		}
+
+		if _, ipNet, err := net.ParseCIDR(value); err == nil {
+			return ipNet.Contains(ip)
+		}
+
+		return ip.Equal(net.ParseIP(value))
+	}
+
+	return false
+}
+
+func policyCompare(comparison string, a, b float64) bool {
+	switch comparison {
+	case "equals", "notequals":
+		return a == b
+	case "lessthan":
+		return a < b
+	case "lessthanequals":
+		return a <= b
+	case "greaterthan":
+		return a > b
+	case "greaterthanequals":
+		return a >= b
+	}
+
+	return false
+}
+
+// policyParseDate parses an ISO 8601 date or a number of seconds since the epoch.
+func policyParseDate(s string) (time.Time, error) {
+	if v, err := strconv.ParseInt(s, 10, 64); err == nil {
+		return time.Unix(v, 0), nil
+	}
+
+	for _, layout := range []string{time.RFC3339, "2006-01-02T15:04Z07:00", "2006-01-02"} {
+		if v, err := time.Parse(layout, s); err == nil {
+			return v, nil
+		}
+	}
+
+	return time.Time{}, fmt.Errorf("invalid date (%s)", s)
+}
+
+var policyVariableRegexp = regexp.MustCompile(`\$\{([^}]+)\}`)
+
+// policyReplaceVariables replaces policy variables with values from the request context.
+// Variables for keys that are not in the context are left unchanged, as are the ${*}, ${?} and ${$}
+// escapes, which are resolved by policyWildcardMatch and policyUnescape.
+func policyReplaceVariables(s string, request *policyEvaluationRequest) string {
+	return policyVariableRegexp.ReplaceAllStringFunc(s, func(match string) string {
+		key := match[2 : len(match)-1]
+
+		if policyEscape(key) {
+			return match
+		}
+
+		if v, ok := request.context[strings.ToLower(key)]; ok && len(v) == 1 {
+			return v[0]
+		}
+
+		return match
+	})
+}
+
+func policyWildcardMatchAny(patterns []string, s string, request *policyEvaluationRequest) bool {
+	for _, pattern := range patterns {
+		if policyWildcardMatch(policyReplaceVariables(pattern, request), s) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// policyEscape returns whether key, the name of a policy variable, is one of the escapes
+// for a literal "*", "?" or "$".
+func policyEscape(key string) bool {
+	switch key {
+	case "*", "?", "$":
+		return true
+	}
+
+	return false
+}
+
+// policyUnescape replaces the ${*}, ${?} and ${$} escapes in s with the characters they stand for.
+func policyUnescape(s string) string {
+	return policyVariableRegexp.ReplaceAllStringFunc(s, func(match string) string {
+		if key := match[2 : len(match)-1]; policyEscape(key) {
+			return key
+		}
+
+		return match
+	})
+}
+
+// policyPatternChar is a character of a wildcard pattern.
+// A literal "*" or "?" comes from an escape and matches only itself.
+type policyPatternChar struct {
+	c       byte
+	literal bool
+}
+
+// policyPatternChars splits pattern into characters, resolving the ${*}, ${?} and ${$} escapes to literals.
+func policyPatternChars(pattern string) []policyPatternChar {
+	var chars []policyPatternChar
+
+	for i := 0; i < len(pattern); i++ {
+		if i+3 < len(pattern) && pattern[i] == '$' && pattern[i+1] == '{' && pattern[i+3] == '}' && policyEscape(pattern[i+2:i+3]) {
+			chars = append(chars, policyPatternChar{c: pattern[i+2], literal: true})
+			i += 3
+
+			continue
+		}
+
+		chars = append(chars, policyPatternChar{c: pattern[i]})
+	}
+
+	return chars
+}
+
+// policyWildcardMatch returns whether s matches pattern, in which "*" matches any sequence of characters
+// and "?" matches any single character. The ${*}, ${?} and ${$} escapes match the literal character.
+func policyWildcardMatch(pattern, s string) bool {
+	chars := policyPatternChars(pattern)
+	p, i := 0, 0
+	star, match := -1, 0
+
+	for i < len(s) {
+		switch {
+		case p < len(chars) && ((chars[p].c == '?' && !chars[p].literal) || chars[p].c == s[i]):
+			p++
+			i++
+		case p < len(chars) && chars[p].c == '*' && !chars[p].literal:
+			star = p
+			match = i
+			p++
+		case star != -1:
+			p = star + 1
+			match++
+			i = match
+		default:
+			return false
+		}
+	}
+
+	for p < len(chars) && chars[p].c == '*' && !chars[p].literal {
+		p++
+	}
+
+	return p == len(chars)
+}
+
+func policyLowerAll(in []string) []string {
+	out := make([]string, len(in))
+
+	for i, v := range in {
+		out[i] = strings.ToLower(v)
+	}
+
+	return out
+}
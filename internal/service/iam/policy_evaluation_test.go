@@ -0,0 +1,525 @@
+package iam_test
+
+import (
+	"testing"
+
+	tfiam "github.com/hashicorp/terraform-provider-aws/internal/service/iam"
+)
+
+func TestPolicyEvaluator(t *testing.T) {
+	const identityPolicy = `{
+  "Version": "2012-10-17",
+  "Statement": [
+    {
+      "Sid": "ReadObjects",
+      "Effect": "Allow",
+      "Action": ["s3:Get*", "s3:List*"],
+      "Resource": ["arn:aws:s3:::my-bucket", "arn:aws:s3:::my-bucket/*"]
+    },
+    {
+      "Sid": "DenySecrets",
+      "Effect": "Deny",
+      "Action": "s3:*",
+      "Resource": "arn:aws:s3:::my-bucket/secrets/*"
+    },
+    {
+      "Sid": "HomeDirectory",
+      "Effect": "Allow",
+      "Action": "s3:PutObject",
+      "Resource": "arn:aws:s3:::my-bucket/home/${aws:username}/*"
+    },
+    {
+      "Sid": "NotEC2",
+      "Effect": "Allow",
+      "NotAction": "ec2:*",
+      "NotResource": "arn:aws:s3:::*",
+      "Condition": {
+        "StringEquals": {"aws:RequestedRegion": ["us-west-2", "eu-west-1"]},
+        "Bool": {"aws:MultiFactorAuthPresent": true}
+      }
+    }
+  ]
+}`
+
+	const resourcePolicy = `{
+  "Version": "2012-10-17",
+  "Statement": [
+    {
+      "Sid": "UserDelete",
+      "Effect": "Allow",
+      "Principal": {"AWS": "arn:aws:iam::123456789012:user/alice"},
+      "Action": "s3:DeleteObject",
+      "Resource": "arn:aws:s3:::my-bucket/*",
+      "Condition": {
+        "IpAddress": {"aws:SourceIp": "10.0.0.0/8"}
+      }
+    },
+    {
+      "Sid": "UserEncrypt",
+      "Effect": "Allow",
+      "Principal": {"AWS": "arn:aws:iam::123456789012:user/alice"},
+      "Action": "kms:Encrypt",
+      "Resource": "arn:aws:kms:us-west-2:123456789012:key/1234"
+    },
+    {
+      "Sid": "AccountRestore",
+      "Effect": "Allow",
+      "Principal": {"AWS": "arn:aws:iam::123456789012:root"},
+      "Action": "s3:RestoreObject",
+      "Resource": "arn:aws:s3:::my-bucket/*"
+    },
+    {
+      "Sid": "AccountTagging",
+      "Effect": "Allow",
+      "Principal": {"AWS": "123456789012"},
+      "Action": ["s3:GetObjectTagging", "s3:PutObjectTagging"],
+      "Resource": "arn:aws:s3:::my-bucket/*"
+    },
+    {
+      "Sid": "DenyAccountLegalHold",
+      "Effect": "Deny",
+      "Principal": {"AWS": "arn:aws:iam::123456789012:root"},
+      "Action": "s3:GetObjectLegalHold",
+      "Resource": "arn:aws:s3:::my-bucket/*"
+    },
+    {
+      "Sid": "OtherAccount",
+      "Effect": "Allow",
+      "Principal": {"AWS": "210987654321"},
+      "Action": "s3:PutObjectAcl",
+      "Resource": "arn:aws:s3:::my-bucket/*"
+    }
+  ]
+}`
+
+	const permissionsBoundary = `{
+  "Version": "2012-10-17",
+  "Statement": {
+    "Effect": "Allow",
+    "Action": ["s3:*", "sqs:*", "dynamodb:*"],
+    "Resource": "*"
+  }
+}`
+
+	evaluator, err := tfiam.NewPolicyEvaluator(&tfiam.PolicyEvaluationInput{
+		IdentityPolicies:            []string{identityPolicy},
+		PermissionsBoundaryPolicies: []string{permissionsBoundary},
+		PrincipalARN:                "arn:aws:iam::123456789012:user/alice",
+		ResourcePolicies:            []string{resourcePolicy},
+	})
+
+	if err != nil {
+		t.Fatalf("error creating evaluator: %s", err)
+	}
+
+	testCases := []struct {
+		TestName           string
+		Action             string
+		Resource           string
+		Context            map[string][]string
+		ExpectedDecision   string
+		ExpectedSid        string
+		ExpectedPolicyType string
+		ExpectedLimiting   string
+	}{
+		{
+			TestName:           "wildcard action",
+			Action:             "s3:GetObject",
+			Resource:           "arn:aws:s3:::my-bucket/data.csv",
+			ExpectedDecision:   tfiam.PolicyEvaluationDecisionAllowed,
+			ExpectedSid:        "ReadObjects",
+			ExpectedPolicyType: tfiam.PolicyTypeIdentity,
+		},
+		{
+			TestName:           "action case insensitive",
+			Action:             "S3:listbucket",
+			Resource:           "arn:aws:s3:::my-bucket",
+			ExpectedDecision:   tfiam.PolicyEvaluationDecisionAllowed,
+			ExpectedSid:        "ReadObjects",
+			ExpectedPolicyType: tfiam.PolicyTypeIdentity,
+		},
+		{
+			TestName:         "no matching statement",
+			Action:           "s3:GetObject",
+			Resource:         "arn:aws:s3:::other-bucket/data.csv",
+			ExpectedDecision: tfiam.PolicyEvaluationDecisionImplicitDeny,
+		},
+		{
+			TestName:           "explicit deny",
+			Action:             "s3:GetObject",
+			Resource:           "arn:aws:s3:::my-bucket/secrets/key.pem",
+			ExpectedDecision:   tfiam.PolicyEvaluationDecisionExplicitDeny,
+			ExpectedSid:        "DenySecrets",
+			ExpectedPolicyType: tfiam.PolicyTypeIdentity,
+		},
+		{
+			TestName:           "policy variable",
+			Action:             "s3:PutObject",
+			Resource:           "arn:aws:s3:::my-bucket/home/alice/notes.txt",
+			Context:            map[string][]string{"aws:username": {"alice"}},
+			ExpectedDecision:   tfiam.PolicyEvaluationDecisionAllowed,
+			ExpectedSid:        "HomeDirectory",
+			ExpectedPolicyType: tfiam.PolicyTypeIdentity,
+		},
+		{
+			TestName:         "policy variable mismatch",
+			Action:           "s3:PutObject",
+			Resource:         "arn:aws:s3:::my-bucket/home/bob/notes.txt",
+			Context:          map[string][]string{"aws:username": {"alice"}},
+			ExpectedDecision: tfiam.PolicyEvaluationDecisionImplicitDeny,
+		},
+		{
+			TestName:           "NotAction NotResource with conditions",
+			Action:             "sqs:SendMessage",
+			Resource:           "arn:aws:sqs:us-west-2:123456789012:queue",
+			Context:            map[string][]string{"AWS:RequestedRegion": {"us-west-2"}, "aws:MultiFactorAuthPresent": {"true"}},
+			ExpectedDecision:   tfiam.PolicyEvaluationDecisionAllowed,
+			ExpectedSid:        "NotEC2",
+			ExpectedPolicyType: tfiam.PolicyTypeIdentity,
+		},
+		{
+			TestName:         "condition not satisfied",
+			Action:           "sqs:SendMessage",
+			Resource:         "arn:aws:sqs:us-east-1:123456789012:queue",
+			Context:          map[string][]string{"aws:RequestedRegion": {"us-east-1"}, "aws:MultiFactorAuthPresent": {"true"}},
+			ExpectedDecision: tfiam.PolicyEvaluationDecisionImplicitDeny,
+		},
+		{
+			TestName:         "condition key missing",
+			Action:           "sqs:SendMessage",
+			Resource:         "arn:aws:sqs:us-west-2:123456789012:queue",
+			Context:          map[string][]string{"aws:RequestedRegion": {"us-west-2"}},
+			ExpectedDecision: tfiam.PolicyEvaluationDecisionImplicitDeny,
+		},
+		{
+			TestName:         "permissions boundary",
+			Action:           "kms:Decrypt",
+			Resource:         "arn:aws:kms:us-west-2:123456789012:key/1234",
+			Context:          map[string][]string{"aws:RequestedRegion": {"us-west-2"}, "aws:MultiFactorAuthPresent": {"true"}},
+			ExpectedDecision: tfiam.PolicyEvaluationDecisionImplicitDeny,
+			ExpectedLimiting: tfiam.PolicyTypePermissionsBoundary,
+		},
+		{
+			TestName:           "resource policy naming principal not limited by permissions boundary",
+			Action:             "kms:Encrypt",
+			Resource:           "arn:aws:kms:us-west-2:123456789012:key/1234",
+			ExpectedDecision:   tfiam.PolicyEvaluationDecisionAllowed,
+			ExpectedSid:        "UserEncrypt",
+			ExpectedPolicyType: tfiam.PolicyTypeResource,
+		},
+		{
+			TestName:           "resource policy",
+			Action:             "s3:DeleteObject",
+			Resource:           "arn:aws:s3:::my-bucket/data.csv",
+			Context:            map[string][]string{"aws:SourceIp": {"10.1.2.3"}},
+			ExpectedDecision:   tfiam.PolicyEvaluationDecisionAllowed,
+			ExpectedSid:        "UserDelete",
+			ExpectedPolicyType: tfiam.PolicyTypeResource,
+		},
+		{
+			TestName:         "resource policy IP address mismatch",
+			Action:           "s3:DeleteObject",
+			Resource:         "arn:aws:s3:::my-bucket/data.csv",
+			Context:          map[string][]string{"aws:SourceIp": {"192.168.1.1"}},
+			ExpectedDecision: tfiam.PolicyEvaluationDecisionImplicitDeny,
+		},
+		{
+			TestName:         "resource policy account root principal",
+			Action:           "s3:RestoreObject",
+			Resource:         "arn:aws:s3:::my-bucket/data.csv",
+			ExpectedDecision: tfiam.PolicyEvaluationDecisionImplicitDeny,
+		},
+		{
+			TestName:         "resource policy account ID principal",
+			Action:           "s3:PutObjectTagging",
+			Resource:         "arn:aws:s3:::my-bucket/data.csv",
+			ExpectedDecision: tfiam.PolicyEvaluationDecisionImplicitDeny,
+		},
+		{
+			TestName:           "resource policy account principal with identity policy",
+			Action:             "s3:GetObjectTagging",
+			Resource:           "arn:aws:s3:::my-bucket/data.csv",
+			ExpectedDecision:   tfiam.PolicyEvaluationDecisionAllowed,
+			ExpectedSid:        "ReadObjects",
+			ExpectedPolicyType: tfiam.PolicyTypeIdentity,
+		},
+		{
+			TestName:           "resource policy account principal deny",
+			Action:             "s3:GetObjectLegalHold",
+			Resource:           "arn:aws:s3:::my-bucket/data.csv",
+			ExpectedDecision:   tfiam.PolicyEvaluationDecisionExplicitDeny,
+			ExpectedSid:        "DenyAccountLegalHold",
+			ExpectedPolicyType: tfiam.PolicyTypeResource,
+		},
+		{
+			TestName:         "resource policy principal mismatch",
+			Action:           "s3:PutObjectAcl",
+			Resource:         "arn:aws:s3:::my-bucket/data.csv",
+			ExpectedDecision: tfiam.PolicyEvaluationDecisionImplicitDeny,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.TestName, func(t *testing.T) {
+			result := evaluator.Evaluate(testCase.Action, testCase.Resource, testCase.Context)
+
+			if result.Decision != testCase.ExpectedDecision {
+				t.Errorf("got decision %s, expected %s", result.Decision, testCase.ExpectedDecision)
+			}
+
+			if result.MatchedStatementSid != testCase.ExpectedSid {
+				t.Errorf("got matched statement Sid %q, expected %q", result.MatchedStatementSid, testCase.ExpectedSid)
+			}
+
+			if result.MatchedPolicyType != testCase.ExpectedPolicyType {
+				t.Errorf("got matched policy type %q, expected %q", result.MatchedPolicyType, testCase.ExpectedPolicyType)
+			}
+
+			if result.LimitingPolicyType != testCase.ExpectedLimiting {
+				t.Errorf("got limiting policy type %q, expected %q", result.LimitingPolicyType, testCase.ExpectedLimiting)
+			}
+		})
+	}
+}
+
+func TestPolicyEvaluator_conditionOperators(t *testing.T) {
+	testCases := []struct {
+		TestName  string
+		Condition string
+		Context   map[string][]string
+		Expected  bool
+	}{
+		{
+			TestName:  "StringLike",
+			Condition: `{"StringLike": {"s3:prefix": ["home/*", "public/*"]}}`,
+			Context:   map[string][]string{"s3:prefix": {"public/images"}},
+			Expected:  true,
+		},
+		{
+			TestName:  "StringNotEquals missing key",
+			Condition: `{"StringNotEquals": {"aws:PrincipalOrgID": "o-1234567890"}}`,
+			Expected:  true,
+		},
+		{
+			TestName:  "StringNotEquals matching value",
+			Condition: `{"StringNotEquals": {"aws:PrincipalOrgID": "o-1234567890"}}`,
+			Context:   map[string][]string{"aws:PrincipalOrgID": {"o-1234567890"}},
+			Expected:  false,
+		},
+		{
+			TestName:  "StringEqualsIgnoreCase",
+			Condition: `{"StringEqualsIgnoreCase": {"aws:ResourceTag/Environment": "Production"}}`,
+			Context:   map[string][]string{"aws:ResourceTag/Environment": {"production"}},
+			Expected:  true,
+		},
+		{
+			TestName:  "StringEqualsIfExists missing key",
+			Condition: `{"StringEqualsIfExists": {"ec2:InstanceType": "t3.micro"}}`,
+			Expected:  true,
+		},
+		{
+			TestName:  "NumericLessThanEquals",
+			Condition: `{"NumericLessThanEquals": {"s3:max-keys": 10}}`,
+			Context:   map[string][]string{"s3:max-keys": {"10"}},
+			Expected:  true,
+		},
+		{
+			TestName:  "NumericGreaterThan",
+			Condition: `{"NumericGreaterThan": {"aws:MultiFactorAuthAge": "3600"}}`,
+			Context:   map[string][]string{"aws:MultiFactorAuthAge": {"60"}},
+			Expected:  false,
+		},
+		{
+			TestName:  "DateLessThan",
+			Condition: `{"DateLessThan": {"aws:CurrentTime": "2030-01-01T00:00:00Z"}}`,
+			Context:   map[string][]string{"aws:CurrentTime": {"2026-10-17T12:00:00Z"}},
+			Expected:  true,
+		},
+		{
+			TestName:  "DateGreaterThan epoch",
+			Condition: `{"DateGreaterThan": {"aws:EpochTime": "1893456000"}}`,
+			Context:   map[string][]string{"aws:EpochTime": {"1792238400"}},
+			Expected:  false,
+		},
+		{
+			TestName:  "NotIpAddress",
+			Condition: `{"NotIpAddress": {"aws:SourceIp": ["10.0.0.0/8", "192.168.0.1"]}}`,
+			Context:   map[string][]string{"aws:SourceIp": {"192.168.0.1"}},
+			Expected:  false,
+		},
+		{
+			TestName:  "ArnLike",
+			Condition: `{"ArnLike": {"aws:SourceArn": "arn:aws:sns:*:123456789012:*"}}`,
+			Context:   map[string][]string{"aws:SourceArn": {"arn:aws:sns:us-west-2:123456789012:topic"}},
+			Expected:  true,
+		},
+		{
+			TestName:  "Null true",
+			Condition: `{"Null": {"aws:TokenIssueTime": "true"}}`,
+			Expected:  true,
+		},
+		{
+			TestName:  "Null false",
+			Condition: `{"Null": {"aws:TokenIssueTime": "false"}}`,
+			Expected:  false,
+		},
+		{
+			TestName:  "ForAllValues",
+			Condition: `{"ForAllValues:StringEquals": {"aws:TagKeys": ["Environment", "Owner"]}}`,
+			Context:   map[string][]string{"aws:TagKeys": {"Environment", "CostCenter"}},
+			Expected:  false,
+		},
+		{
+			TestName:  "ForAllValues missing key",
+			Condition: `{"ForAllValues:StringEquals": {"aws:TagKeys": ["Environment", "Owner"]}}`,
+			Expected:  true,
+		},
+		{
+			TestName:  "ForAnyValue",
+			Condition: `{"ForAnyValue:StringEquals": {"aws:TagKeys": ["Environment", "Owner"]}}`,
+			Context:   map[string][]string{"aws:TagKeys": {"Environment", "CostCenter"}},
+			Expected:  true,
+		},
+		{
+			TestName:  "multiple keys",
+			Condition: `{"StringEquals": {"aws:RequestedRegion": "us-west-2", "aws:PrincipalAccount": "123456789012"}}`,
+			Context:   map[string][]string{"aws:RequestedRegion": {"us-west-2"}, "aws:PrincipalAccount": {"210987654321"}},
+			Expected:  false,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.TestName, func(t *testing.T) {
+			evaluator, err := tfiam.NewPolicyEvaluator(&tfiam.PolicyEvaluationInput{
+				IdentityPolicies: []string{`{"Statement": {"Effect": "Allow", "Action": "*", "Resource": "*", "Condition": ` + testCase.Condition + `}}`},
+			})
+
+			if err != nil {
+				t.Fatalf("error creating evaluator: %s", err)
+			}
+
+			if got := evaluator.Evaluate("s3:ListBucket", "arn:aws:s3:::my-bucket", testCase.Context).Allowed(); got != testCase.Expected {
+				t.Errorf("got allowed %t, expected %t", got, testCase.Expected)
+			}
+		})
+	}
+}
+
+func TestPolicyEvaluator_policyVariableEscapes(t *testing.T) {
+	evaluator, err := tfiam.NewPolicyEvaluator(&tfiam.PolicyEvaluationInput{
+		IdentityPolicies: []string{`{
+  "Statement": [
+    {"Sid": "LiteralStar", "Effect": "Allow", "Action": "s3:GetObject", "Resource": "arn:aws:s3:::b/${*}"},
+    {"Sid": "LiteralQuestionMark", "Effect": "Allow", "Action": "s3:PutObject", "Resource": "arn:aws:s3:::b/a${?}"},
+    {"Sid": "LiteralDollar", "Effect": "Allow", "Action": "s3:ListBucket", "Resource": "arn:aws:s3:::b", "Condition": {"StringLike": {"s3:prefix": "${$}${*}*"}}}
+  ]
+}`},
+	})
+
+	if err != nil {
+		t.Fatalf("error creating evaluator: %s", err)
+	}
+
+	testCases := []struct {
+		TestName string
+		Action   string
+		Resource string
+		Context  map[string][]string
+		Expected bool
+	}{
+		{
+			TestName: "escaped star matches star",
+			Action:   "s3:GetObject",
+			Resource: "arn:aws:s3:::b/*",
+			Expected: true,
+		},
+		{
+			TestName: "escaped star is not a wildcard",
+			Action:   "s3:GetObject",
+			Resource: "arn:aws:s3:::b/data.csv",
+			Expected: false,
+		},
+		{
+			TestName: "escaped question mark matches question mark",
+			Action:   "s3:PutObject",
+			Resource: "arn:aws:s3:::b/a?",
+			Expected: true,
+		},
+		{
+			TestName: "escaped question mark is not a wildcard",
+			Action:   "s3:PutObject",
+			Resource: "arn:aws:s3:::b/ab",
+			Expected: false,
+		},
+		{
+			TestName: "escapes in condition",
+			Action:   "s3:ListBucket",
+			Resource: "arn:aws:s3:::b",
+			Context:  map[string][]string{"s3:prefix": {"$*home"}},
+			Expected: true,
+		},
+		{
+			TestName: "escapes in condition are not wildcards",
+			Action:   "s3:ListBucket",
+			Resource: "arn:aws:s3:::b",
+			Context:  map[string][]string{"s3:prefix": {"$home"}},
+			Expected: false,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.TestName, func(t *testing.T) {
+			if got := evaluator.Evaluate(testCase.Action, testCase.Resource, testCase.Context).Allowed(); got != testCase.Expected {
+				t.Errorf("got allowed %t, expected %t", got, testCase.Expected)
+			}
+		})
+	}
+}
+
+func TestPolicyEvaluator_serviceControlPolicy(t *testing.T) {
+	evaluator, err := tfiam.NewPolicyEvaluator(&tfiam.PolicyEvaluationInput{
+		IdentityPolicies:       []string{`{"Statement": {"Sid": "All", "Effect": "Allow", "Action": "*", "Resource": "*"}}`},
+		ServiceControlPolicies: []string{`{"Statement": [{"Effect": "Allow", "Action": "*", "Resource": "*"}, {"Sid": "DenyLeave", "Effect": "Deny", "Action": "organizations:LeaveOrganization", "Resource": "*"}]}`},
+	})
+
+	if err != nil {
+		t.Fatalf("error creating evaluator: %s", err)
+	}
+
+	result := evaluator.Evaluate("organizations:LeaveOrganization", "*", nil)
+
+	if result.Decision != tfiam.PolicyEvaluationDecisionExplicitDeny || result.MatchedStatementSid != "DenyLeave" || result.MatchedPolicyType != tfiam.PolicyTypeServiceControl {
+		t.Errorf("got %+v, expected explicit deny by service control policy statement DenyLeave", result)
+	}
+
+	if result := evaluator.Evaluate("s3:ListAllMyBuckets", "*", nil); !result.Allowed() {
+		t.Errorf("got %+v, expected allowed", result)
+	}
+}
+
+func TestPolicyEvaluator_serviceControlPolicyImplicitDeny(t *testing.T) {
+	evaluator, err := tfiam.NewPolicyEvaluator(&tfiam.PolicyEvaluationInput{
+		IdentityPolicies:       []string{`{"Statement": {"Sid": "All", "Effect": "Allow", "Action": "*", "Resource": "*"}}`},
+		ServiceControlPolicies: []string{`{"Statement": {"Effect": "Allow", "Action": "s3:*", "Resource": "*"}}`},
+	})
+
+	if err != nil {
+		t.Fatalf("error creating evaluator: %s", err)
+	}
+
+	result := evaluator.Evaluate("ec2:RunInstances", "*", nil)
+
+	if result.Decision != tfiam.PolicyEvaluationDecisionImplicitDeny || result.MatchedStatementSid != "" || result.MatchedPolicyType != "" || result.LimitingPolicyType != tfiam.PolicyTypeServiceControl {
+		t.Errorf("got %+v, expected implicit deny limited by service control policies", result)
+	}
+}
+
+func TestNewPolicyEvaluator_invalidPolicy(t *testing.T) {
+	_, err := tfiam.NewPolicyEvaluator(&tfiam.PolicyEvaluationInput{
+		IdentityPolicies: []string{`{"Statement": {"Effect": "Allow", "Action": "*", "Resource": "*", "Condition": {"StringEqual": {"s3:prefix": "home/"}}}}`},
+	})
+
+	if err == nil {
+		t.Fatal("expected error, got none")
+	}
+}
@@ -0,0 +1,177 @@
+package iam
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/create"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+func DataSourcePolicySimulation() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourcePolicySimulationRead,
+
+		Schema: map[string]*schema.Schema{
+			"actions": {
+				Type:     schema.TypeList,
+				Required: true,
+				MinItems: 1,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"all_allowed": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+			"context": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"key": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						"values": {
+							Type:     schema.TypeList,
+							Required: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+					},
+				},
+			},
+			"identity_policies":             policySimulationPolicyListSchema(),
+			"permissions_boundary_policies": policySimulationPolicyListSchema(),
+			"principal_arn": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: verify.ValidARN,
+			},
+			"resource_policies": policySimulationPolicyListSchema(),
+			"resources": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"results": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"action": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"allowed": {
+							Type:     schema.TypeBool,
+							Computed: true,
+						},
+						"decision": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"limiting_policy_type": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"matched_policy_type": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"matched_statement_sid": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"resource": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+			"service_control_policies": policySimulationPolicyListSchema(),
+		},
+	}
+}
+
+func policySimulationPolicyListSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeList,
+		Optional: true,
+		Elem: &schema.Schema{
+			Type:         schema.TypeString,
+			ValidateFunc: verify.ValidIAMPolicyJSON,
+		},
+	}
+}
+
+func dataSourcePolicySimulationRead(d *schema.ResourceData, meta interface{}) error {
+	evaluator, err := NewPolicyEvaluator(&PolicyEvaluationInput{
+		IdentityPolicies:            aws.StringValueSlice(flex.ExpandStringList(d.Get("identity_policies").([]interface{}))),
+		PermissionsBoundaryPolicies: aws.StringValueSlice(flex.ExpandStringList(d.Get("permissions_boundary_policies").([]interface{}))),
+		PrincipalARN:                d.Get("principal_arn").(string),
+		ResourcePolicies:            aws.StringValueSlice(flex.ExpandStringList(d.Get("resource_policies").([]interface{}))),
+		ServiceControlPolicies:      aws.StringValueSlice(flex.ExpandStringList(d.Get("service_control_policies").([]interface{}))),
+	})
+
+	if err != nil {
+		return fmt.Errorf("error simulating IAM policies: %w", err)
+	}
+
+	context := make(map[string][]string)
+
+	for _, v := range d.Get("context").([]interface{}) {
+		tfMap, ok := v.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		key := tfMap["key"].(string)
+		context[key] = append(context[key], aws.StringValueSlice(flex.ExpandStringList(tfMap["values"].([]interface{})))...)
+	}
+
+	resources := aws.StringValueSlice(flex.ExpandStringList(d.Get("resources").([]interface{})))
+
+	if len(resources) == 0 {
+		resources = []string{"*"}
+	}
+
+	allAllowed := true
+	var ids []string
+	var results []interface{}
+
+	for _, action := range aws.StringValueSlice(flex.ExpandStringList(d.Get("actions").([]interface{}))) {
+		for _, resource := range resources {
+			result := evaluator.Evaluate(action, resource, context)
+
+			if !result.Allowed() {
+				allAllowed = false
+			}
+
+			ids = append(ids, fmt.Sprintf("%s:%s:%s", result.Action, result.Resource, result.Decision))
+			results = append(results, map[string]interface{}{
+				"action":                result.Action,
+				"allowed":               result.Allowed(),
+				"decision":              result.Decision,
+				"limiting_policy_type":  result.LimitingPolicyType,
+				"matched_policy_type":   result.MatchedPolicyType,
+				"matched_statement_sid": result.MatchedStatementSid,
+				"resource":              result.Resource,
+			})
+		}
+	}
+
+	d.SetId(strconv.Itoa(create.StringHashcode(strings.Join(ids, ","))))
+	d.Set("all_allowed", allAllowed)
+
+	if err := d.Set("results", results); err != nil {
+		return fmt.Errorf("error setting results: %w", err)
+	}
+
+	return nil
+}
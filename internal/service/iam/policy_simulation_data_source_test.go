@@ -0,0 +1,122 @@
+package iam_test
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/iam"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+)
+
+func TestAccIAMPolicySimulationDataSource_basic(t *testing.T) {
+	dataSourceName := "data.aws_iam_policy_simulation.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:   func() { acctest.PreCheck(t) },
+		ErrorCheck: acctest.ErrorCheck(t, iam.EndpointsID),
+		Providers:  acctest.Providers,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccPolicySimulationDataSourceConfig,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "all_allowed", "false"),
+					resource.TestCheckResourceAttr(dataSourceName, "results.#", "4"),
+					resource.TestCheckResourceAttr(dataSourceName, "results.0.action", "s3:GetObject"),
+					resource.TestCheckResourceAttr(dataSourceName, "results.0.resource", "arn:aws:s3:::example-bucket/reports/2021.csv"),
+					resource.TestCheckResourceAttr(dataSourceName, "results.0.allowed", "true"),
+					resource.TestCheckResourceAttr(dataSourceName, "results.0.decision", "allowed"),
+					resource.TestCheckResourceAttr(dataSourceName, "results.0.matched_policy_type", "identity"),
+					resource.TestCheckResourceAttr(dataSourceName, "results.0.matched_statement_sid", "ReadReports"),
+					resource.TestCheckResourceAttr(dataSourceName, "results.1.resource", "arn:aws:s3:::example-bucket/private/key.pem"),
+					resource.TestCheckResourceAttr(dataSourceName, "results.1.allowed", "false"),
+					resource.TestCheckResourceAttr(dataSourceName, "results.1.decision", "explicitDeny"),
+					resource.TestCheckResourceAttr(dataSourceName, "results.1.matched_statement_sid", "DenyPrivate"),
+					resource.TestCheckResourceAttr(dataSourceName, "results.2.action", "s3:PutObject"),
+					resource.TestCheckResourceAttr(dataSourceName, "results.2.decision", "implicitDeny"),
+					resource.TestCheckResourceAttr(dataSourceName, "results.2.matched_statement_sid", ""),
+				),
+			},
+		},
+	})
+}
+
+func TestAccIAMPolicySimulationDataSource_context(t *testing.T) {
+	dataSourceName := "data.aws_iam_policy_simulation.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:   func() { acctest.PreCheck(t) },
+		ErrorCheck: acctest.ErrorCheck(t, iam.EndpointsID),
+		Providers:  acctest.Providers,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccPolicySimulationDataSourceContextConfig,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "all_allowed", "true"),
+					resource.TestCheckResourceAttr(dataSourceName, "results.#", "1"),
+					resource.TestCheckResourceAttr(dataSourceName, "results.0.matched_policy_type", "resource"),
+					resource.TestCheckResourceAttr(dataSourceName, "results.0.matched_statement_sid", "AllowFromVPC"),
+				),
+			},
+		},
+	})
+}
+
+const testAccPolicySimulationDataSourceConfig = `
+data "aws_iam_policy_document" "test" {
+  statement {
+    sid       = "ReadReports"
+    actions   = ["s3:Get*"]
+    resources = ["arn:aws:s3:::example-bucket/*"]
+  }
+
+  statement {
+    sid       = "DenyPrivate"
+    effect    = "Deny"
+    actions   = ["s3:*"]
+    resources = ["arn:aws:s3:::example-bucket/private/*"]
+  }
+}
+
+data "aws_iam_policy_simulation" "test" {
+  identity_policies = [data.aws_iam_policy_document.test.json]
+  actions           = ["s3:GetObject", "s3:PutObject"]
+
+  resources = [
+    "arn:aws:s3:::example-bucket/reports/2021.csv",
+    "arn:aws:s3:::example-bucket/private/key.pem",
+  ]
+}
+`
+
+const testAccPolicySimulationDataSourceContextConfig = `
+data "aws_iam_policy_document" "test" {
+  statement {
+    sid       = "AllowFromVPC"
+    actions   = ["sqs:SendMessage"]
+    resources = ["arn:aws:sqs:us-west-2:123456789012:example"]
+
+    principals {
+      type        = "AWS"
+      identifiers = ["123456789012"]
+    }
+
+    condition {
+      test     = "StringEquals"
+      variable = "aws:SourceVpc"
+      values   = ["vpc-12345678"]
+    }
+  }
+}
+
+data "aws_iam_policy_simulation" "test" {
+  resource_policies = [data.aws_iam_policy_document.test.json]
+  principal_arn     = "arn:aws:iam::123456789012:role/example"
+  actions           = ["sqs:SendMessage"]
+  resources         = ["arn:aws:sqs:us-west-2:123456789012:example"]
+
+  context {
+    key    = "aws:SourceVpc"
+    values = ["vpc-12345678"]
+  }
+}
+`
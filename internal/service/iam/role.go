@@ -136,7 +136,7 @@ func ResourceRole() *schema.Resource {
 						"policy": {
 							Type:             schema.TypeString,
 							Optional:         true, // semantically required but syntactically optional to allow empty inline_policy
-							ValidateFunc:     verify.ValidIAMPolicyJSON,
+							ValidateFunc:     verify.ValidIAMIdentityPolicyJSON,
 							DiffSuppressFunc: verify.SuppressEquivalentPolicyDiffs,
 						},
 					},
@@ -583,22 +583,13 @@ func deleteRoleInstanceProfiles(conn *iam.IAM, roleName string) error {
 }
 
 func readRolePolicyAttachments(conn *iam.IAM, roleName string) ([]*string, error) {
-	managedPolicies := make([]*string, 0)
-	input := &iam.ListAttachedRolePoliciesInput{
-		RoleName: aws.String(roleName),
-	}
+	policyARNs, err := FindRoleAttachedPolicyARNs(conn, roleName)
 
-	err := conn.ListAttachedRolePoliciesPages(input, func(page *iam.ListAttachedRolePoliciesOutput, lastPage bool) bool {
-		for _, v := range page.AttachedPolicies {
-			managedPolicies = append(managedPolicies, v.PolicyArn)
-		}
-		return !lastPage
-	})
-	if err != nil && !tfawserr.ErrCodeEquals(err, iam.ErrCodeNoSuchEntityException) {
+	if err != nil && !tfresource.NotFound(err) {
 		return nil, err
 	}
 
-	return managedPolicies, nil
+	return aws.StringSlice(policyARNs), nil
 }
 
 func deleteRolePolicyAttachments(conn *iam.IAM, roleName string, managedPolicies []*string) error {
@@ -621,21 +612,13 @@ func deleteRolePolicyAttachments(conn *iam.IAM, roleName string, managedPolicies
 }
 
 func readRolePolicyNames(conn *iam.IAM, roleName string) ([]*string, error) {
-	inlinePolicies := make([]*string, 0)
-	input := &iam.ListRolePoliciesInput{
-		RoleName: aws.String(roleName),
-	}
-
-	err := conn.ListRolePoliciesPages(input, func(page *iam.ListRolePoliciesOutput, lastPage bool) bool {
-		inlinePolicies = append(inlinePolicies, page.PolicyNames...)
-		return !lastPage
-	})
+	policyNames, err := FindRolePolicyNames(conn, roleName)
 
-	if err != nil && !tfawserr.ErrCodeEquals(err, iam.ErrCodeNoSuchEntityException) {
+	if err != nil && !tfresource.NotFound(err) {
 		return nil, err
 	}
 
-	return inlinePolicies, nil
+	return aws.StringSlice(policyNames), nil
 }
 
 func deleteRolePolicies(conn *iam.IAM, roleName string, policyNames []*string) error {
@@ -32,7 +32,7 @@ func ResourceRolePolicy() *schema.Resource {
 			"policy": {
 				Type:             schema.TypeString,
 				Required:         true,
-				ValidateFunc:     verify.ValidIAMPolicyJSON,
+				ValidateFunc:     verify.ValidIAMIdentityPolicyJSON,
 				DiffSuppressFunc: verify.SuppressEquivalentPolicyDiffs,
 			},
 			"name": {
@@ -162,21 +162,17 @@ func DetachPolicyFromRole(conn *iam.IAM, role string, arn string) error {
 }
 
 func RoleHasPolicyARNAttachment(conn *iam.IAM, role string, policyARN string) (bool, error) {
-	hasPolicyAttachment := false
-	input := &iam.ListAttachedRolePoliciesInput{
-		RoleName: aws.String(role),
+	policyARNs, err := FindRoleAttachedPolicyARNs(conn, role)
+
+	if err != nil {
+		return false, err
 	}
 
-	err := conn.ListAttachedRolePoliciesPages(input, func(page *iam.ListAttachedRolePoliciesOutput, lastPage bool) bool {
-		for _, p := range page.AttachedPolicies {
-			if aws.StringValue(p.PolicyArn) == policyARN {
-				hasPolicyAttachment = true
-				return false
-			}
+	for _, v := range policyARNs {
+		if v == policyARN {
+			return true, nil
 		}
+	}
 
-		return !lastPage
-	})
-
-	return hasPolicyAttachment, err
+	return false, nil
 }
@@ -19,7 +19,7 @@ import (
 )
 
 func init() {
-	resource.AddTestSweepers("aws_iam_group", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_iam_group", &resource.Sweeper{
 		Name: "aws_iam_group",
 		F:    sweepGroups,
 		Dependencies: []string{
@@ -27,18 +27,18 @@ func init() {
 		},
 	})
 
-	resource.AddTestSweepers("aws_iam_instance_profile", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_iam_instance_profile", &resource.Sweeper{
 		Name:         "aws_iam_instance_profile",
 		F:            sweepInstanceProfile,
 		Dependencies: []string{"aws_iam_role"},
 	})
 
-	resource.AddTestSweepers("aws_iam_openid_connect_provider", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_iam_openid_connect_provider", &resource.Sweeper{
 		Name: "aws_iam_openid_connect_provider",
 		F:    sweepOpenIDConnectProvider,
 	})
 
-	resource.AddTestSweepers("aws_iam_policy", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_iam_policy", &resource.Sweeper{
 		Name: "aws_iam_policy",
 		F:    sweepPolicies,
 		Dependencies: []string{
@@ -48,7 +48,7 @@ func init() {
 		},
 	})
 
-	resource.AddTestSweepers("aws_iam_role", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_iam_role", &resource.Sweeper{
 		Name: "aws_iam_role",
 		Dependencies: []string{
 			"aws_batch_compute_environment",
@@ -76,22 +76,22 @@ func init() {
 		F: sweepRoles,
 	})
 
-	resource.AddTestSweepers("aws_iam_saml_provider", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_iam_saml_provider", &resource.Sweeper{
 		Name: "aws_iam_saml_provider",
 		F:    sweepSamlProvider,
 	})
 
-	resource.AddTestSweepers("aws_iam_server_certificate", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_iam_server_certificate", &resource.Sweeper{
 		Name: "aws_iam_server_certificate",
 		F:    sweepServerCertificates,
 	})
 
-	resource.AddTestSweepers("aws_iam_service_linked_role", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_iam_service_linked_role", &resource.Sweeper{
 		Name: "aws_iam_service_linked_role",
 		F:    sweepServiceLinkedRoles,
 	})
 
-	resource.AddTestSweepers("aws_iam_user", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_iam_user", &resource.Sweeper{
 		Name: "aws_iam_user",
 		F:    sweepUsers,
 	})
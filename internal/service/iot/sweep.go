@@ -17,7 +17,7 @@ import (
 )
 
 func init() {
-	resource.AddTestSweepers("aws_iot_certificate", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_iot_certificate", &resource.Sweeper{
 		Name: "aws_iot_certificate",
 		F:    sweepCertifcates,
 		Dependencies: []string{
@@ -26,12 +26,12 @@ func init() {
 		},
 	})
 
-	resource.AddTestSweepers("aws_iot_policy_attachment", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_iot_policy_attachment", &resource.Sweeper{
 		Name: "aws_iot_policy_attachment",
 		F:    sweepPolicyAttachments,
 	})
 
-	resource.AddTestSweepers("aws_iot_policy", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_iot_policy", &resource.Sweeper{
 		Name: "aws_iot_policy",
 		F:    sweepPolicies,
 		Dependencies: []string{
@@ -39,34 +39,34 @@ func init() {
 		},
 	})
 
-	resource.AddTestSweepers("aws_iot_role_alias", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_iot_role_alias", &resource.Sweeper{
 		Name: "aws_iot_role_alias",
 		F:    sweepRoleAliases,
 	})
 
-	resource.AddTestSweepers("aws_iot_thing_principal_attachment", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_iot_thing_principal_attachment", &resource.Sweeper{
 		Name: "aws_iot_thing_principal_attachment",
 		F:    sweepThingPrincipalAttachments,
 	})
 
-	resource.AddTestSweepers("aws_iot_thing", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_iot_thing", &resource.Sweeper{
 		Name:         "aws_iot_thing",
 		F:            sweepThings,
 		Dependencies: []string{"aws_iot_thing_principal_attachment"},
 	})
 
-	resource.AddTestSweepers("aws_iot_thing_group", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_iot_thing_group", &resource.Sweeper{
 		Name: "aws_iot_policy_attachment",
 		F:    sweepThingGroups,
 	})
 
-	resource.AddTestSweepers("aws_iot_thing_type", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_iot_thing_type", &resource.Sweeper{
 		Name:         "aws_iot_thing_type",
 		F:            sweepThingTypes,
 		Dependencies: []string{"aws_iot_thing"},
 	})
 
-	resource.AddTestSweepers("aws_iot_topic_rule", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_iot_topic_rule", &resource.Sweeper{
 		Name: "aws_iot_topic_rule",
 		F:    sweepTopicRules,
 	})
@@ -106,7 +106,7 @@ func sweepCertifcates(region string) error {
 		errs = multierror.Append(errs, fmt.Errorf("error listing IoT Certificate for %s: %w", region, err))
 	}
 
-	if err := sweep.SweepOrchestrator(sweepResources); err != nil {
+	if err := sweep.SweepOrchestrator("aws_iot_certificate", sweepResources); err != nil {
 		errs = multierror.Append(errs, fmt.Errorf("error sweeping IoT Certificate for %s: %w", region, err))
 	}
 
@@ -172,7 +172,7 @@ func sweepPolicyAttachments(region string) error {
 		errs = multierror.Append(errs, fmt.Errorf("error listing IoT Policy Attachment for %s: %w", region, err))
 	}
 
-	if err := sweep.SweepOrchestrator(sweepResources); err != nil {
+	if err := sweep.SweepOrchestrator("aws_iot_policy_attachment", sweepResources); err != nil {
 		errs = multierror.Append(errs, fmt.Errorf("error sweeping IoT Policy Attachment for %s: %w", region, err))
 	}
 
@@ -218,7 +218,7 @@ func sweepPolicies(region string) error {
 		errs = multierror.Append(errs, fmt.Errorf("error listing IoT Policy for %s: %w", region, err))
 	}
 
-	if err := sweep.SweepOrchestrator(sweepResources); err != nil {
+	if err := sweep.SweepOrchestrator("aws_iot_policy", sweepResources); err != nil {
 		errs = multierror.Append(errs, fmt.Errorf("error sweeping IoT Policy for %s: %w", region, err))
 	}
 
@@ -264,7 +264,7 @@ func sweepRoleAliases(region string) error {
 		errs = multierror.Append(errs, fmt.Errorf("error listing IoT Role Alias for %s: %w", region, err))
 	}
 
-	if err := sweep.SweepOrchestrator(sweepResources); err != nil {
+	if err := sweep.SweepOrchestrator("aws_iot_role_alias", sweepResources); err != nil {
 		errs = multierror.Append(errs, fmt.Errorf("error sweeping IoT Role Alias for %s: %w", region, err))
 	}
 
@@ -330,7 +330,7 @@ func sweepThingPrincipalAttachments(region string) error {
 		errs = multierror.Append(errs, fmt.Errorf("error listing IoT Thing Principal Attachment for %s: %w", region, err))
 	}
 
-	if err := sweep.SweepOrchestrator(sweepResources); err != nil {
+	if err := sweep.SweepOrchestrator("aws_iot_thing_principal_attachment", sweepResources); err != nil {
 		errs = multierror.Append(errs, fmt.Errorf("error sweeping IoT Thing Principal Attachment for %s: %w", region, err))
 	}
 
@@ -376,7 +376,7 @@ func sweepThings(region string) error {
 		errs = multierror.Append(errs, fmt.Errorf("error listing IoT Thing for %s: %w", region, err))
 	}
 
-	if err := sweep.SweepOrchestrator(sweepResources); err != nil {
+	if err := sweep.SweepOrchestrator("aws_iot_thing", sweepResources); err != nil {
 		errs = multierror.Append(errs, fmt.Errorf("error sweeping IoT Thing for %s: %w", region, err))
 	}
 
@@ -422,7 +422,7 @@ func sweepThingTypes(region string) error {
 		errs = multierror.Append(errs, fmt.Errorf("error listing IoT Thing Type for %s: %w", region, err))
 	}
 
-	if err := sweep.SweepOrchestrator(sweepResources); err != nil {
+	if err := sweep.SweepOrchestrator("aws_iot_thing_type", sweepResources); err != nil {
 		errs = multierror.Append(errs, fmt.Errorf("error sweeping IoT Thing Type for %s: %w", region, err))
 	}
 
@@ -515,7 +515,7 @@ func sweepThingGroups(region string) error {
 		return fmt.Errorf("error listing IoT Thing Groups (%s): %w", region, err)
 	}
 
-	err = sweep.SweepOrchestrator(sweepResources)
+	err = sweep.SweepOrchestrator("aws_iot_thing_group", sweepResources)
 
 	if err != nil {
 		return fmt.Errorf("error sweeping IoT Thing Groups (%s): %w", region, err)
@@ -16,12 +16,12 @@ import (
 )
 
 func init() {
-	resource.AddTestSweepers("aws_msk_cluster", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_msk_cluster", &resource.Sweeper{
 		Name: "aws_msk_cluster",
 		F:    sweepClusters,
 	})
 
-	resource.AddTestSweepers("aws_msk_configuration", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_msk_configuration", &resource.Sweeper{
 		Name: "aws_msk_configuration",
 		F:    sweepConfigurations,
 		Dependencies: []string{
@@ -64,7 +64,7 @@ func sweepClusters(region string) error {
 		return fmt.Errorf("error listing MSK Clusters (%s): %w", region, err)
 	}
 
-	err = sweep.SweepOrchestrator(sweepResources)
+	err = sweep.SweepOrchestrator("aws_msk_cluster", sweepResources)
 
 	if err != nil {
 		return fmt.Errorf("error sweeping MSK Clusters (%s): %w", region, err)
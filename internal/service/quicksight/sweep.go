@@ -17,7 +17,7 @@ import (
 )
 
 func init() {
-	resource.AddTestSweepers("aws_quicksight_data_source", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_quicksight_data_source", &resource.Sweeper{
 		Name: "aws_quicksight_data_source",
 		F:    sweepsDataSource,
 	})
@@ -67,7 +67,7 @@ func sweepsDataSource(region string) error {
 		errs = multierror.Append(errs, fmt.Errorf("error describing QuickSigth Data Sources: %w", err))
 	}
 
-	if err := sweep.SweepOrchestrator(sweepResources); err != nil {
+	if err := sweep.SweepOrchestrator("aws_quicksight_data_source", sweepResources); err != nil {
 		errs = multierror.Append(errs, fmt.Errorf("error sweeping QuickSight Data Sources for %s: %w", region, err))
 	}
 
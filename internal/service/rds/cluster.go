@@ -1,6 +1,7 @@
 package rds
 
 import (
+	"context"
 	"errors"
 	"fmt"
 	"log"
@@ -11,6 +12,7 @@ import (
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/rds"
 	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
@@ -30,10 +32,10 @@ const (
 
 func ResourceCluster() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceClusterCreate,
-		Read:   resourceClusterRead,
-		Update: resourceClusterUpdate,
-		Delete: resourceClusterDelete,
+		CreateContext: resourceClusterCreate,
+		Read:          resourceClusterRead,
+		Update:        resourceClusterUpdate,
+		Delete:        resourceClusterDelete,
 		Importer: &schema.ResourceImporter{
 			State: resourceClusterImport,
 		},
@@ -493,7 +495,7 @@ func resourceClusterImport(
 	return []*schema.ResourceData{d}, nil
 }
 
-func resourceClusterCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceClusterCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	conn := meta.(*conns.AWSClient).RDSConn
 	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
 	tags := defaultTagsConfig.MergeTags(tftags.New(d.Get("tags").(map[string]interface{})))
@@ -594,8 +596,8 @@ func resourceClusterCreate(d *schema.ResourceData, meta interface{}) error {
 		}
 
 		log.Printf("[DEBUG] RDS Cluster restore from snapshot configuration: %s", opts)
-		err := resource.Retry(tfiam.PropagationTimeout, func() *resource.RetryError {
-			_, err := conn.RestoreDBClusterFromSnapshot(&opts)
+		err := resource.RetryContext(ctx, tfiam.PropagationTimeout, func() *resource.RetryError {
+			_, err := conn.RestoreDBClusterFromSnapshotWithContext(ctx, &opts)
 			if err != nil {
 				if tfawserr.ErrMessageContains(err, "InvalidParameterValue", "IAM role ARN value is invalid or does not include the required permissions") {
 					return resource.RetryableError(err)
@@ -605,17 +607,17 @@ func resourceClusterCreate(d *schema.ResourceData, meta interface{}) error {
 			return nil
 		})
 		if tfresource.TimedOut(err) {
-			_, err = conn.RestoreDBClusterFromSnapshot(&opts)
+			_, err = conn.RestoreDBClusterFromSnapshotWithContext(ctx, &opts)
 		}
 		if err != nil {
-			return fmt.Errorf("Error creating RDS Cluster: %s", err)
+			return diag.FromErr(fmt.Errorf("Error creating RDS Cluster: %s", err))
 		}
 	} else if v, ok := d.GetOk("s3_import"); ok {
 		if _, ok := d.GetOk("master_password"); !ok {
-			return fmt.Errorf(`provider.aws: aws_db_instance: %s: "master_password": required field is not set`, d.Get("name").(string))
+			return diag.FromErr(fmt.Errorf(`provider.aws: aws_db_instance: %s: "master_password": required field is not set`, d.Get("name").(string)))
 		}
 		if _, ok := d.GetOk("master_username"); !ok {
-			return fmt.Errorf(`provider.aws: aws_db_instance: %s: "master_username": required field is not set`, d.Get("name").(string))
+			return diag.FromErr(fmt.Errorf(`provider.aws: aws_db_instance: %s: "master_username": required field is not set`, d.Get("name").(string)))
 		}
 		s3_bucket := v.([]interface{})[0].(map[string]interface{})
 		createOpts := &rds.RestoreDBClusterFromS3Input{
@@ -696,9 +698,9 @@ func resourceClusterCreate(d *schema.ResourceData, meta interface{}) error {
 		log.Printf("[DEBUG] RDS Cluster restore options: %s", createOpts)
 		// Retry for IAM/S3 eventual consistency
 		var resp *rds.RestoreDBClusterFromS3Output
-		err := resource.Retry(5*time.Minute, func() *resource.RetryError {
+		err := resource.RetryContext(ctx, 5*time.Minute, func() *resource.RetryError {
 			var err error
-			resp, err = conn.RestoreDBClusterFromS3(createOpts)
+			resp, err = conn.RestoreDBClusterFromS3WithContext(ctx, createOpts)
 			if err != nil {
 				// InvalidParameterValue: Files from the specified Amazon S3 bucket cannot be downloaded.
 				// Make sure that you have created an AWS Identity and Access Management (IAM) role that lets Amazon RDS access Amazon S3 for you.
@@ -717,12 +719,12 @@ func resourceClusterCreate(d *schema.ResourceData, meta interface{}) error {
 			return nil
 		})
 		if tfresource.TimedOut(err) {
-			resp, err = conn.RestoreDBClusterFromS3(createOpts)
+			resp, err = conn.RestoreDBClusterFromS3WithContext(ctx, createOpts)
 		}
 
 		if err != nil {
 			log.Printf("[ERROR] Error creating RDS Cluster: %s", err)
-			return err
+			return diag.FromErr(err)
 		}
 
 	} else if v, ok := d.GetOk("restore_to_point_in_time"); ok {
@@ -744,7 +746,7 @@ func resourceClusterCreate(d *schema.ResourceData, meta interface{}) error {
 		}
 
 		if createOpts.RestoreToTime == nil && createOpts.UseLatestRestorableTime == nil {
-			return fmt.Errorf(`provider.aws: aws_rds_cluster: %s: Either "restore_to_time" or "use_latest_restorable_time" must be set`, d.Get("database_name").(string))
+			return diag.FromErr(fmt.Errorf(`provider.aws: aws_rds_cluster: %s: Either "restore_to_time" or "use_latest_restorable_time" must be set`, d.Get("database_name").(string)))
 		}
 
 		if attr, ok := pointInTime["restore_type"].(string); ok {
@@ -815,10 +817,10 @@ func resourceClusterCreate(d *schema.ResourceData, meta interface{}) error {
 
 		log.Printf("[DEBUG] RDS Cluster restore options: %s", createOpts)
 
-		resp, err := conn.RestoreDBClusterToPointInTime(createOpts)
+		resp, err := conn.RestoreDBClusterToPointInTimeWithContext(ctx, createOpts)
 		if err != nil {
 			log.Printf("[ERROR] Error restoring RDS Cluster: %s", err)
-			return err
+			return diag.FromErr(err)
 		}
 
 		log.Printf("[DEBUG]: RDS Cluster restore response: %s", resp)
@@ -928,9 +930,9 @@ func resourceClusterCreate(d *schema.ResourceData, meta interface{}) error {
 
 		log.Printf("[DEBUG] RDS Cluster create options: %s", createOpts)
 		var resp *rds.CreateDBClusterOutput
-		err := resource.Retry(tfiam.PropagationTimeout, func() *resource.RetryError {
+		err := resource.RetryContext(ctx, tfiam.PropagationTimeout, func() *resource.RetryError {
 			var err error
-			resp, err = conn.CreateDBCluster(createOpts)
+			resp, err = conn.CreateDBClusterWithContext(ctx, createOpts)
 			if err != nil {
 				if tfawserr.ErrMessageContains(err, "InvalidParameterValue", "IAM role ARN value is invalid or does not include the required permissions") {
 					return resource.RetryableError(err)
@@ -940,10 +942,10 @@ func resourceClusterCreate(d *schema.ResourceData, meta interface{}) error {
 			return nil
 		})
 		if tfresource.TimedOut(err) {
-			resp, err = conn.CreateDBCluster(createOpts)
+			resp, err = conn.CreateDBClusterWithContext(ctx, createOpts)
 		}
 		if err != nil {
-			return fmt.Errorf("error creating RDS cluster: %s", err)
+			return diag.FromErr(fmt.Errorf("error creating RDS cluster: %s", err))
 		}
 
 		log.Printf("[DEBUG]: RDS Cluster create response: %s", resp)
@@ -953,29 +955,15 @@ func resourceClusterCreate(d *schema.ResourceData, meta interface{}) error {
 
 	log.Printf("[INFO] RDS Cluster ID: %s", d.Id())
 
-	log.Println(
-		"[INFO] Waiting for RDS Cluster to be available")
-
-	stateConf := &resource.StateChangeConf{
-		Pending:    resourceClusterCreatePendingStates,
-		Target:     []string{"available"},
-		Refresh:    resourceClusterStateRefreshFunc(conn, d.Id()),
-		Timeout:    d.Timeout(schema.TimeoutCreate),
-		MinTimeout: 10 * time.Second,
-		Delay:      30 * time.Second,
-	}
-
-	// Wait, catching any errors
-	_, err := stateConf.WaitForState()
-	if err != nil {
-		return fmt.Errorf("Error waiting for RDS Cluster state to be \"available\": %s", err)
+	if _, err := waitDBClusterCreated(ctx, conn, d.Id(), d.Timeout(schema.TimeoutCreate)); err != nil {
+		return diag.FromErr(fmt.Errorf("error waiting for RDS Cluster (%s) create: %w", d.Id(), err))
 	}
 
 	if v, ok := d.GetOk("iam_roles"); ok {
 		for _, role := range v.(*schema.Set).List() {
 			err := setIAMRoleToCluster(d.Id(), role.(string), conn)
 			if err != nil {
-				return err
+				return diag.FromErr(err)
 			}
 		}
 	}
@@ -984,19 +972,19 @@ func resourceClusterCreate(d *schema.ResourceData, meta interface{}) error {
 		modifyDbClusterInput.DBClusterIdentifier = aws.String(d.Id())
 
 		log.Printf("[INFO] RDS Cluster (%s) configuration requires ModifyDBCluster: %s", d.Id(), modifyDbClusterInput)
-		_, err := conn.ModifyDBCluster(modifyDbClusterInput)
+		_, err := conn.ModifyDBClusterWithContext(ctx, modifyDbClusterInput)
 		if err != nil {
-			return fmt.Errorf("error modifying RDS Cluster (%s): %s", d.Id(), err)
+			return diag.FromErr(fmt.Errorf("error modifying RDS Cluster (%s): %s", d.Id(), err))
 		}
 
 		log.Printf("[INFO] Waiting for RDS Cluster (%s) to be available", d.Id())
 		err = waitForRDSClusterUpdate(conn, d.Id(), d.Timeout(schema.TimeoutCreate))
 		if err != nil {
-			return fmt.Errorf("error waiting for RDS Cluster (%s) to be available: %s", d.Id(), err)
+			return diag.FromErr(fmt.Errorf("error waiting for RDS Cluster (%s) to be available: %s", d.Id(), err))
 		}
 	}
 
-	return resourceClusterRead(d, meta)
+	return diag.FromErr(resourceClusterRead(d, meta))
 }
 
 func resourceClusterRead(d *schema.ResourceData, meta interface{}) error {
@@ -1,6 +1,7 @@
 package rds
 
 import (
+	"context"
 	"fmt"
 	"log"
 	"strings"
@@ -9,6 +10,7 @@ import (
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/rds"
 	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
@@ -21,12 +23,12 @@ import (
 
 func ResourceClusterInstance() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceClusterInstanceCreate,
-		Read:   resourceClusterInstanceRead,
-		Update: resourceClusterInstanceUpdate,
-		Delete: resourceClusterInstanceDelete,
+		CreateContext: resourceClusterInstanceCreate,
+		ReadContext:   resourceClusterInstanceRead,
+		UpdateContext: resourceClusterInstanceUpdate,
+		DeleteContext: resourceClusterInstanceDelete,
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: schema.ImportStatePassthroughContext,
 		},
 
 		Timeouts: &schema.ResourceTimeout{
@@ -236,7 +238,7 @@ func ResourceClusterInstance() *schema.Resource {
 	}
 }
 
-func resourceClusterInstanceCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceClusterInstanceCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	conn := meta.(*conns.AWSClient).RDSConn
 	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
 	tags := defaultTagsConfig.MergeTags(tftags.New(d.Get("tags").(map[string]interface{})))
@@ -308,9 +310,9 @@ func resourceClusterInstanceCreate(d *schema.ResourceData, meta interface{}) err
 
 	log.Printf("[DEBUG] Creating RDS DB Instance opts: %s", createOpts)
 	var resp *rds.CreateDBInstanceOutput
-	err := resource.Retry(tfiam.PropagationTimeout, func() *resource.RetryError {
+	err := resource.RetryContext(ctx, tfiam.PropagationTimeout, func() *resource.RetryError {
 		var err error
-		resp, err = conn.CreateDBInstance(createOpts)
+		resp, err = conn.CreateDBInstanceWithContext(ctx, createOpts)
 		if err != nil {
 			if tfawserr.ErrMessageContains(err, "InvalidParameterValue", "IAM role ARN value is invalid or does not include the required permissions") {
 				return resource.RetryableError(err)
@@ -320,28 +322,16 @@ func resourceClusterInstanceCreate(d *schema.ResourceData, meta interface{}) err
 		return nil
 	})
 	if tfresource.TimedOut(err) {
-		resp, err = conn.CreateDBInstance(createOpts)
+		resp, err = conn.CreateDBInstanceWithContext(ctx, createOpts)
 	}
 	if err != nil {
-		return fmt.Errorf("error creating RDS Cluster (%s) Instance: %w", d.Get("cluster_identifier").(string), err)
+		return diag.FromErr(fmt.Errorf("error creating RDS Cluster (%s) Instance: %w", d.Get("cluster_identifier").(string), err))
 	}
 
 	d.SetId(aws.StringValue(resp.DBInstance.DBInstanceIdentifier))
 
-	// reuse db_instance refresh func
-	stateConf := &resource.StateChangeConf{
-		Pending:    resourceClusterInstanceCreateUpdatePendingStates,
-		Target:     []string{"available"},
-		Refresh:    resourceInstanceStateRefreshFunc(d.Id(), conn),
-		Timeout:    d.Timeout(schema.TimeoutCreate),
-		MinTimeout: 10 * time.Second,
-		Delay:      30 * time.Second,
-	}
-
-	// Wait, catching any errors
-	_, err = stateConf.WaitForState()
-	if err != nil {
-		return err
+	if _, err := waitDBClusterInstanceCreated(ctx, conn, d.Id(), d.Timeout(schema.TimeoutCreate)); err != nil {
+		return diag.FromErr(fmt.Errorf("error waiting for RDS Cluster Instance (%s) create: %w", d.Id(), err))
 	}
 
 	// See also: resource_aws_db_instance.go
@@ -371,17 +361,17 @@ func resourceClusterInstanceCreate(d *schema.ResourceData, meta interface{}) err
 		modifyDbInstanceInput.DBInstanceIdentifier = aws.String(d.Id())
 
 		log.Printf("[INFO] DB Instance (%s) configuration requires ModifyDBInstance: %s", d.Id(), modifyDbInstanceInput)
-		_, err := conn.ModifyDBInstance(modifyDbInstanceInput)
+		_, err := conn.ModifyDBInstanceWithContext(ctx, modifyDbInstanceInput)
 
 		if err != nil {
-			return fmt.Errorf("error modifying RDS Cluster Instance (%s): %w", d.Id(), err)
+			return diag.FromErr(fmt.Errorf("error modifying RDS Cluster Instance (%s): %w", d.Id(), err))
 		}
 
 		log.Printf("[INFO] Waiting for DB Instance (%s) to be available", d.Id())
 		err = waitUntilDBInstanceAvailableAfterUpdate(d.Id(), conn, d.Timeout(schema.TimeoutUpdate))
 
 		if err != nil {
-			return fmt.Errorf("error waiting for RDS Cluster Instance (%s) to be available: %w", d.Id(), err)
+			return diag.FromErr(fmt.Errorf("error waiting for RDS Cluster Instance (%s) to be available: %w", d.Id(), err))
 		}
 	}
 
@@ -391,24 +381,24 @@ func resourceClusterInstanceCreate(d *schema.ResourceData, meta interface{}) err
 		}
 
 		log.Printf("[INFO] DB Instance (%s) configuration requires RebootDBInstance: %s", d.Id(), rebootDbInstanceInput)
-		_, err := conn.RebootDBInstance(rebootDbInstanceInput)
+		_, err := conn.RebootDBInstanceWithContext(ctx, rebootDbInstanceInput)
 
 		if err != nil {
-			return fmt.Errorf("error rebooting RDS Cluster Instance (%s): %w", d.Id(), err)
+			return diag.FromErr(fmt.Errorf("error rebooting RDS Cluster Instance (%s): %w", d.Id(), err))
 		}
 
 		log.Printf("[INFO] Waiting for DB Instance (%s) to be available", d.Id())
 		err = waitUntilDBInstanceAvailableAfterUpdate(d.Id(), conn, d.Timeout(schema.TimeoutUpdate))
 
 		if err != nil {
-			return fmt.Errorf("error waiting for RDS Cluster Instance (%s) to be available: %w", d.Id(), err)
+			return diag.FromErr(fmt.Errorf("error waiting for RDS Cluster Instance (%s) to be available: %w", d.Id(), err))
 		}
 	}
 
-	return resourceClusterInstanceRead(d, meta)
+	return resourceClusterInstanceRead(ctx, d, meta)
 }
 
-func resourceClusterInstanceRead(d *schema.ResourceData, meta interface{}) error {
+func resourceClusterInstanceRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	conn := meta.(*conns.AWSClient).RDSConn
 	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
 	ignoreTagsConfig := meta.(*conns.AWSClient).IgnoreTagsConfig
@@ -422,19 +412,19 @@ func resourceClusterInstanceRead(d *schema.ResourceData, meta interface{}) error
 	}
 
 	if err != nil {
-		return fmt.Errorf("error reading RDS Cluster Instance (%s): %w", d.Id(), err)
+		return diag.FromErr(fmt.Errorf("error reading RDS Cluster Instance (%s): %w", d.Id(), err))
 	}
 
 	dbClusterID := aws.StringValue(db.DBClusterIdentifier)
 
 	if dbClusterID == "" {
-		return fmt.Errorf("DBClusterIdentifier is missing from RDS Cluster Instance (%s). The aws_db_instance resource should be used for non-Aurora instances", d.Id())
+		return diag.FromErr(fmt.Errorf("DBClusterIdentifier is missing from RDS Cluster Instance (%s). The aws_db_instance resource should be used for non-Aurora instances", d.Id()))
 	}
 
 	dbc, err := FindDBClusterByID(conn, dbClusterID)
 
 	if err != nil {
-		return fmt.Errorf("error reading RDS Cluster (%s): %w", dbClusterID, err)
+		return diag.FromErr(fmt.Errorf("error reading RDS Cluster (%s): %w", dbClusterID, err))
 	}
 
 	for _, m := range dbc.DBClusterMembers {
@@ -486,23 +476,23 @@ func resourceClusterInstanceRead(d *schema.ResourceData, meta interface{}) error
 
 	tags, err := ListTags(conn, aws.StringValue(db.DBInstanceArn))
 	if err != nil {
-		return fmt.Errorf("error listing tags for RDS Cluster Instance (%s): %w", d.Id(), err)
+		return diag.FromErr(fmt.Errorf("error listing tags for RDS Cluster Instance (%s): %w", d.Id(), err))
 	}
 	tags = tags.IgnoreAWS().IgnoreConfig(ignoreTagsConfig)
 
 	//lintignore:AWSR002
 	if err := d.Set("tags", tags.RemoveDefaultConfig(defaultTagsConfig).Map()); err != nil {
-		return fmt.Errorf("error setting tags: %w", err)
+		return diag.FromErr(fmt.Errorf("error setting tags: %w", err))
 	}
 
 	if err := d.Set("tags_all", tags.Map()); err != nil {
-		return fmt.Errorf("error setting tags_all: %w", err)
+		return diag.FromErr(fmt.Errorf("error setting tags_all: %w", err))
 	}
 
 	return nil
 }
 
-func resourceClusterInstanceUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceClusterInstanceUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	conn := meta.(*conns.AWSClient).RDSConn
 	requestUpdate := false
 
@@ -583,8 +573,8 @@ func resourceClusterInstanceUpdate(d *schema.ResourceData, meta interface{}) err
 	log.Printf("[DEBUG] Send DB Instance Modification request: %#v", requestUpdate)
 	if requestUpdate {
 		log.Printf("[DEBUG] DB Instance Modification request: %#v", req)
-		err := resource.Retry(tfiam.PropagationTimeout, func() *resource.RetryError {
-			_, err := conn.ModifyDBInstance(req)
+		err := resource.RetryContext(ctx, tfiam.PropagationTimeout, func() *resource.RetryError {
+			_, err := conn.ModifyDBInstanceWithContext(ctx, req)
 			if err != nil {
 				if tfawserr.ErrMessageContains(err, "InvalidParameterValue", "IAM role ARN value is invalid or does not include the required permissions") {
 					return resource.RetryableError(err)
@@ -594,11 +584,11 @@ func resourceClusterInstanceUpdate(d *schema.ResourceData, meta interface{}) err
 			return nil
 		})
 		if tfresource.TimedOut(err) {
-			_, err = conn.ModifyDBInstance(req)
+			_, err = conn.ModifyDBInstanceWithContext(ctx, req)
 		}
 
 		if err != nil {
-			return fmt.Errorf("error modifying RDS Cluster Instance (%s): %w", d.Id(), err)
+			return diag.FromErr(fmt.Errorf("error modifying RDS Cluster Instance (%s): %w", d.Id(), err))
 		}
 
 		// reuse db_instance refresh func
@@ -612,9 +602,9 @@ func resourceClusterInstanceUpdate(d *schema.ResourceData, meta interface{}) err
 		}
 
 		// Wait, catching any errors
-		_, err = stateConf.WaitForState()
+		_, err = stateConf.WaitForStateContext(ctx)
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 
 	}
@@ -623,14 +613,14 @@ func resourceClusterInstanceUpdate(d *schema.ResourceData, meta interface{}) err
 		o, n := d.GetChange("tags_all")
 
 		if err := UpdateTags(conn, d.Get("arn").(string), o, n); err != nil {
-			return fmt.Errorf("error updating RDS Cluster Instance (%s) tags: %w", d.Id(), err)
+			return diag.FromErr(fmt.Errorf("error updating RDS Cluster Instance (%s) tags: %w", d.Id(), err))
 		}
 	}
 
-	return resourceClusterInstanceRead(d, meta)
+	return resourceClusterInstanceRead(ctx, d, meta)
 }
 
-func resourceClusterInstanceDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceClusterInstanceDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	conn := meta.(*conns.AWSClient).RDSConn
 
 	input := &rds.DeleteDBInstanceInput{
@@ -641,7 +631,7 @@ func resourceClusterInstanceDelete(d *schema.ResourceData, meta interface{}) err
 	_, err := tfresource.RetryWhen(
 		d.Timeout(schema.TimeoutDelete),
 		func() (interface{}, error) {
-			return conn.DeleteDBInstance(input)
+			return conn.DeleteDBInstanceWithContext(ctx, input)
 		},
 		func(err error) (bool, error) {
 			if tfawserr.ErrMessageContains(err, rds.ErrCodeInvalidDBClusterStateFault, "Delete the replica cluster before deleting") {
@@ -657,11 +647,11 @@ func resourceClusterInstanceDelete(d *schema.ResourceData, meta interface{}) err
 	}
 
 	if err != nil && !tfawserr.ErrMessageContains(err, rds.ErrCodeInvalidDBInstanceStateFault, "is already being deleted") {
-		return fmt.Errorf("error deleting RDS Cluster Instance (%s): %w", d.Id(), err)
+		return diag.FromErr(fmt.Errorf("error deleting RDS Cluster Instance (%s): %w", d.Id(), err))
 	}
 
-	if _, err := waitDBClusterInstanceDeleted(conn, d.Id(), d.Timeout(schema.TimeoutDelete)); err != nil {
-		return fmt.Errorf("error waiting for RDS Cluster Instance (%s) delete: %w", d.Id(), err)
+	if _, err := waitDBClusterInstanceDeleted(ctx, conn, d.Id(), d.Timeout(schema.TimeoutDelete)); err != nil {
+		return diag.FromErr(fmt.Errorf("error waiting for RDS Cluster Instance (%s) delete: %w", d.Id(), err))
 	}
 
 	return nil
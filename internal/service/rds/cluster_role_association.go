@@ -1,12 +1,14 @@
 package rds
 
 import (
+	"context"
 	"fmt"
 	"log"
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/rds"
 	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
@@ -15,12 +17,12 @@ import (
 
 func ResourceClusterRoleAssociation() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceClusterRoleAssociationCreate,
-		Read:   resourceClusterRoleAssociationRead,
-		Delete: resourceClusterRoleAssociationDelete,
+		CreateContext: resourceClusterRoleAssociationCreate,
+		ReadContext:   resourceClusterRoleAssociationRead,
+		DeleteContext: resourceClusterRoleAssociationDelete,
 
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: schema.ImportStatePassthroughContext,
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -44,7 +46,7 @@ func ResourceClusterRoleAssociation() *schema.Resource {
 	}
 }
 
-func resourceClusterRoleAssociationCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceClusterRoleAssociationCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	conn := meta.(*conns.AWSClient).RDSConn
 
 	dbClusterID := d.Get("db_cluster_identifier").(string)
@@ -56,30 +58,30 @@ func resourceClusterRoleAssociationCreate(d *schema.ResourceData, meta interface
 	}
 
 	log.Printf("[DEBUG] Creating RDS DB Cluster IAM Role Association: %s", input)
-	_, err := conn.AddRoleToDBCluster(input)
+	_, err := conn.AddRoleToDBClusterWithContext(ctx, input)
 
 	if err != nil {
-		return fmt.Errorf("error creating RDS DB Cluster (%s) IAM Role (%s) Association: %w", dbClusterID, roleARN, err)
+		return diag.FromErr(fmt.Errorf("error creating RDS DB Cluster (%s) IAM Role (%s) Association: %w", dbClusterID, roleARN, err))
 	}
 
 	d.SetId(ClusterRoleAssociationCreateResourceID(dbClusterID, roleARN))
 
-	_, err = waitDBClusterRoleAssociationCreated(conn, dbClusterID, roleARN)
+	_, err = waitDBClusterRoleAssociationCreated(ctx, conn, dbClusterID, roleARN)
 
 	if err != nil {
-		return fmt.Errorf("error waiting for RDS DB Cluster (%s) IAM Role (%s) Association to create: %w", dbClusterID, roleARN, err)
+		return diag.FromErr(fmt.Errorf("error waiting for RDS DB Cluster (%s) IAM Role (%s) Association to create: %w", dbClusterID, roleARN, err))
 	}
 
-	return resourceClusterRoleAssociationRead(d, meta)
+	return resourceClusterRoleAssociationRead(ctx, d, meta)
 }
 
-func resourceClusterRoleAssociationRead(d *schema.ResourceData, meta interface{}) error {
+func resourceClusterRoleAssociationRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	conn := meta.(*conns.AWSClient).RDSConn
 
 	dbClusterID, roleARN, err := ClusterRoleAssociationParseResourceID(d.Id())
 
 	if err != nil {
-		return fmt.Errorf("error parsing RDS DB Cluster IAM Role Association ID: %s", err)
+		return diag.FromErr(fmt.Errorf("error parsing RDS DB Cluster IAM Role Association ID: %s", err))
 	}
 
 	output, err := FindDBClusterRoleByDBClusterIDAndRoleARN(conn, dbClusterID, roleARN)
@@ -91,7 +93,7 @@ func resourceClusterRoleAssociationRead(d *schema.ResourceData, meta interface{}
 	}
 
 	if err != nil {
-		return fmt.Errorf("error reading RDS DB Cluster (%s) IAM Role (%s) Association: %w", dbClusterID, roleARN, err)
+		return diag.FromErr(fmt.Errorf("error reading RDS DB Cluster (%s) IAM Role (%s) Association: %w", dbClusterID, roleARN, err))
 	}
 
 	d.Set("db_cluster_identifier", dbClusterID)
@@ -101,13 +103,13 @@ func resourceClusterRoleAssociationRead(d *schema.ResourceData, meta interface{}
 	return nil
 }
 
-func resourceClusterRoleAssociationDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceClusterRoleAssociationDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	conn := meta.(*conns.AWSClient).RDSConn
 
 	dbClusterID, roleARN, err := ClusterRoleAssociationParseResourceID(d.Id())
 
 	if err != nil {
-		return fmt.Errorf("error parsing RDS DB Cluster IAM Role Association ID: %s", err)
+		return diag.FromErr(fmt.Errorf("error parsing RDS DB Cluster IAM Role Association ID: %s", err))
 	}
 
 	input := &rds.RemoveRoleFromDBClusterInput{
@@ -117,20 +119,20 @@ func resourceClusterRoleAssociationDelete(d *schema.ResourceData, meta interface
 	}
 
 	log.Printf("[DEBUG] Deleting RDS DB Cluster IAM Role Association: %s", d.Id())
-	_, err = conn.RemoveRoleFromDBCluster(input)
+	_, err = conn.RemoveRoleFromDBClusterWithContext(ctx, input)
 
 	if tfawserr.ErrCodeEquals(err, rds.ErrCodeDBClusterNotFoundFault) || tfawserr.ErrCodeEquals(err, rds.ErrCodeDBClusterRoleNotFoundFault) {
 		return nil
 	}
 
 	if err != nil {
-		return fmt.Errorf("error deleting RDS DB Cluster (%s) IAM Role (%s) Association: %w", dbClusterID, roleARN, err)
+		return diag.FromErr(fmt.Errorf("error deleting RDS DB Cluster (%s) IAM Role (%s) Association: %w", dbClusterID, roleARN, err))
 	}
 
-	_, err = waitDBClusterRoleAssociationDeleted(conn, dbClusterID, roleARN)
+	_, err = waitDBClusterRoleAssociationDeleted(ctx, conn, dbClusterID, roleARN)
 
 	if err != nil {
-		return fmt.Errorf("error waiting for RDS DB Cluster (%s) IAM Role (%s) Association to delete: %w", dbClusterID, roleARN, err)
+		return diag.FromErr(fmt.Errorf("error waiting for RDS DB Cluster (%s) IAM Role (%s) Association to delete: %w", dbClusterID, roleARN, err))
 	}
 
 	return nil
@@ -1,6 +1,7 @@
 package rds
 
 import (
+	"context"
 	"fmt"
 	"log"
 	"time"
@@ -8,6 +9,7 @@ import (
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/rds"
 	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
@@ -20,13 +22,13 @@ import (
 
 func ResourceEventSubscription() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceEventSubscriptionCreate,
-		Read:   resourceEventSubscriptionRead,
-		Update: resourceEventSubscriptionUpdate,
-		Delete: resourceEventSubscriptionDelete,
+		CreateContext: resourceEventSubscriptionCreate,
+		ReadContext:   resourceEventSubscriptionRead,
+		UpdateContext: resourceEventSubscriptionUpdate,
+		DeleteContext: resourceEventSubscriptionDelete,
 
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: schema.ImportStatePassthroughContext,
 		},
 
 		Timeouts: &schema.ResourceTimeout{
@@ -93,7 +95,7 @@ func ResourceEventSubscription() *schema.Resource {
 	}
 }
 
-func resourceEventSubscriptionCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceEventSubscriptionCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	conn := meta.(*conns.AWSClient).RDSConn
 	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
 	tags := defaultTagsConfig.MergeTags(tftags.New(d.Get("tags").(map[string]interface{})))
@@ -122,22 +124,22 @@ func resourceEventSubscriptionCreate(d *schema.ResourceData, meta interface{}) e
 	}
 
 	log.Printf("[DEBUG] Creating RDS Event Subscription: %s", input)
-	output, err := conn.CreateEventSubscription(input)
+	output, err := conn.CreateEventSubscriptionWithContext(ctx, input)
 
 	if err != nil {
-		return fmt.Errorf("error creating RDS Event Subscription (%s): %w", name, err)
+		return diag.FromErr(fmt.Errorf("error creating RDS Event Subscription (%s): %w", name, err))
 	}
 
 	d.SetId(aws.StringValue(output.EventSubscription.CustSubscriptionId))
 
-	if _, err = waitEventSubscriptionCreated(conn, d.Id(), d.Timeout(schema.TimeoutCreate)); err != nil {
-		return fmt.Errorf("error waiting for RDS Event Subscription (%s) create: %w", d.Id(), err)
+	if _, err = waitEventSubscriptionCreated(ctx, conn, d.Id(), d.Timeout(schema.TimeoutCreate)); err != nil {
+		return diag.FromErr(fmt.Errorf("error waiting for RDS Event Subscription (%s) create: %w", d.Id(), err))
 	}
 
-	return resourceEventSubscriptionRead(d, meta)
+	return resourceEventSubscriptionRead(ctx, d, meta)
 }
 
-func resourceEventSubscriptionRead(d *schema.ResourceData, meta interface{}) error {
+func resourceEventSubscriptionRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	conn := meta.(*conns.AWSClient).RDSConn
 	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
 	ignoreTagsConfig := meta.(*conns.AWSClient).IgnoreTagsConfig
@@ -151,7 +153,7 @@ func resourceEventSubscriptionRead(d *schema.ResourceData, meta interface{}) err
 	}
 
 	if err != nil {
-		return fmt.Errorf("error reading RDS Event Subscription (%s): %w", d.Id(), err)
+		return diag.FromErr(fmt.Errorf("error reading RDS Event Subscription (%s): %w", d.Id(), err))
 	}
 
 	arn := aws.StringValue(sub.EventSubscriptionArn)
@@ -168,24 +170,24 @@ func resourceEventSubscriptionRead(d *schema.ResourceData, meta interface{}) err
 	tags, err := ListTags(conn, arn)
 
 	if err != nil {
-		return fmt.Errorf("error listing tags for RDS Event Subscription (%s): %w", arn, err)
+		return diag.FromErr(fmt.Errorf("error listing tags for RDS Event Subscription (%s): %w", arn, err))
 	}
 
 	tags = tags.IgnoreAWS().IgnoreConfig(ignoreTagsConfig)
 
 	//lintignore:AWSR002
 	if err := d.Set("tags", tags.RemoveDefaultConfig(defaultTagsConfig).Map()); err != nil {
-		return fmt.Errorf("error setting tags: %w", err)
+		return diag.FromErr(fmt.Errorf("error setting tags: %w", err))
 	}
 
 	if err := d.Set("tags_all", tags.Map()); err != nil {
-		return fmt.Errorf("error setting tags_all: %w", err)
+		return diag.FromErr(fmt.Errorf("error setting tags_all: %w", err))
 	}
 
 	return nil
 }
 
-func resourceEventSubscriptionUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceEventSubscriptionUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	conn := meta.(*conns.AWSClient).RDSConn
 
 	if d.HasChangesExcept("tags", "tags_all", "source_ids") {
@@ -211,14 +213,14 @@ func resourceEventSubscriptionUpdate(d *schema.ResourceData, meta interface{}) e
 		}
 
 		log.Printf("[DEBUG] Updating RDS Event Subscription: %s", input)
-		_, err := conn.ModifyEventSubscription(input)
+		_, err := conn.ModifyEventSubscriptionWithContext(ctx, input)
 
 		if err != nil {
-			return fmt.Errorf("error updating RDS Event Subscription (%s): %w", d.Id(), err)
+			return diag.FromErr(fmt.Errorf("error updating RDS Event Subscription (%s): %w", d.Id(), err))
 		}
 
-		if _, err = waitEventSubscriptionUpdated(conn, d.Id(), d.Timeout(schema.TimeoutUpdate)); err != nil {
-			return fmt.Errorf("error waiting for RDS Event Subscription (%s) update: %w", d.Id(), err)
+		if _, err = waitEventSubscriptionUpdated(ctx, conn, d.Id(), d.Timeout(schema.TimeoutUpdate)); err != nil {
+			return diag.FromErr(fmt.Errorf("error waiting for RDS Event Subscription (%s) update: %w", d.Id(), err))
 		}
 	}
 
@@ -226,7 +228,7 @@ func resourceEventSubscriptionUpdate(d *schema.ResourceData, meta interface{}) e
 		o, n := d.GetChange("tags_all")
 
 		if err := UpdateTags(conn, d.Get("arn").(string), o, n); err != nil {
-			return fmt.Errorf("error updating RDS Event Subscription (%s) tags: %w", d.Get("arn").(string), err)
+			return diag.FromErr(fmt.Errorf("error updating RDS Event Subscription (%s) tags: %w", d.Get("arn").(string), err))
 		}
 	}
 
@@ -239,25 +241,25 @@ func resourceEventSubscriptionUpdate(d *schema.ResourceData, meta interface{}) e
 
 		for _, del := range del {
 			del := del.(string)
-			_, err := conn.RemoveSourceIdentifierFromSubscription(&rds.RemoveSourceIdentifierFromSubscriptionInput{
+			_, err := conn.RemoveSourceIdentifierFromSubscriptionWithContext(ctx, &rds.RemoveSourceIdentifierFromSubscriptionInput{
 				SourceIdentifier: aws.String(del),
 				SubscriptionName: aws.String(d.Id()),
 			})
 
 			if err != nil {
-				return fmt.Errorf("error removing RDS Event Subscription (%s) source ID (%s): %w", d.Id(), del, err)
+				return diag.FromErr(fmt.Errorf("error removing RDS Event Subscription (%s) source ID (%s): %w", d.Id(), del, err))
 			}
 		}
 
 		for _, add := range add {
 			add := add.(string)
-			_, err := conn.AddSourceIdentifierToSubscription(&rds.AddSourceIdentifierToSubscriptionInput{
+			_, err := conn.AddSourceIdentifierToSubscriptionWithContext(ctx, &rds.AddSourceIdentifierToSubscriptionInput{
 				SourceIdentifier: aws.String(add),
 				SubscriptionName: aws.String(d.Id()),
 			})
 
 			if err != nil {
-				return fmt.Errorf("error adding RDS Event Subscription (%s) source ID (%s): %w", d.Id(), add, err)
+				return diag.FromErr(fmt.Errorf("error adding RDS Event Subscription (%s) source ID (%s): %w", d.Id(), add, err))
 			}
 		}
 	}
@@ -265,11 +267,11 @@ func resourceEventSubscriptionUpdate(d *schema.ResourceData, meta interface{}) e
 	return nil
 }
 
-func resourceEventSubscriptionDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceEventSubscriptionDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	conn := meta.(*conns.AWSClient).RDSConn
 
 	log.Printf("[DEBUG] Deleting RDS Event Subscription: (%s)", d.Id())
-	_, err := conn.DeleteEventSubscription(&rds.DeleteEventSubscriptionInput{
+	_, err := conn.DeleteEventSubscriptionWithContext(ctx, &rds.DeleteEventSubscriptionInput{
 		SubscriptionName: aws.String(d.Id()),
 	})
 
@@ -278,11 +280,11 @@ func resourceEventSubscriptionDelete(d *schema.ResourceData, meta interface{}) e
 	}
 
 	if err != nil {
-		return fmt.Errorf("error deleting RDS Event Subscription (%s): %w", d.Id(), err)
+		return diag.FromErr(fmt.Errorf("error deleting RDS Event Subscription (%s): %w", d.Id(), err))
 	}
 
-	if _, err = waitEventSubscriptionDeleted(conn, d.Id(), d.Timeout(schema.TimeoutDelete)); err != nil {
-		return fmt.Errorf("error waiting for RDS Event Subscription (%s) delete: %w", d.Id(), err)
+	if _, err = waitEventSubscriptionDeleted(ctx, conn, d.Id(), d.Timeout(schema.TimeoutDelete)); err != nil {
+		return diag.FromErr(fmt.Errorf("error waiting for RDS Event Subscription (%s) delete: %w", d.Id(), err))
 	}
 
 	return nil
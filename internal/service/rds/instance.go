@@ -1,6 +1,7 @@
 package rds
 
 import (
+	"context"
 	"fmt"
 	"log"
 	"regexp"
@@ -11,6 +12,7 @@ import (
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/rds"
 	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
@@ -24,10 +26,10 @@ import (
 
 func ResourceInstance() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceInstanceCreate,
-		Read:   resourceInstanceRead,
-		Update: resourceInstanceUpdate,
-		Delete: resourceInstanceDelete,
+		CreateContext: resourceInstanceCreate,
+		ReadContext:   resourceInstanceRead,
+		UpdateContext: resourceInstanceUpdate,
+		DeleteContext: resourceInstanceDelete,
 		Importer: &schema.ResourceImporter{
 			State: resourceInstanceImport,
 		},
@@ -490,7 +492,7 @@ func ResourceInstance() *schema.Resource {
 	}
 }
 
-func resourceInstanceCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceInstanceCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	conn := meta.(*conns.AWSClient).RDSConn
 	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
 	tags := defaultTagsConfig.MergeTags(tftags.New(d.Get("tags").(map[string]interface{})))
@@ -663,23 +665,23 @@ func resourceInstanceCreate(d *schema.ResourceData, meta interface{}) error {
 		}
 
 		log.Printf("[DEBUG] DB Instance Replica create configuration: %#v", opts)
-		_, err := conn.CreateDBInstanceReadReplica(&opts)
+		_, err := conn.CreateDBInstanceReadReplicaWithContext(ctx, &opts)
 		if err != nil {
-			return fmt.Errorf("Error creating DB Instance: %s", err)
+			return diag.FromErr(fmt.Errorf("Error creating DB Instance: %s", err))
 		}
 	} else if v, ok := d.GetOk("s3_import"); ok {
 
 		if _, ok := d.GetOk("allocated_storage"); !ok {
-			return fmt.Errorf(`provider.aws: aws_db_instance: %s: "allocated_storage": required field is not set`, d.Get("name").(string))
+			return diag.FromErr(fmt.Errorf(`provider.aws: aws_db_instance: %s: "allocated_storage": required field is not set`, d.Get("name").(string)))
 		}
 		if _, ok := d.GetOk("engine"); !ok {
-			return fmt.Errorf(`provider.aws: aws_db_instance: %s: "engine": required field is not set`, d.Get("name").(string))
+			return diag.FromErr(fmt.Errorf(`provider.aws: aws_db_instance: %s: "engine": required field is not set`, d.Get("name").(string)))
 		}
 		if _, ok := d.GetOk("password"); !ok {
-			return fmt.Errorf(`provider.aws: aws_db_instance: %s: "password": required field is not set`, d.Get("name").(string))
+			return diag.FromErr(fmt.Errorf(`provider.aws: aws_db_instance: %s: "password": required field is not set`, d.Get("name").(string)))
 		}
 		if _, ok := d.GetOk("username"); !ok {
-			return fmt.Errorf(`provider.aws: aws_db_instance: %s: "username": required field is not set`, d.Get("name").(string))
+			return diag.FromErr(fmt.Errorf(`provider.aws: aws_db_instance: %s: "username": required field is not set`, d.Get("name").(string)))
 		}
 
 		s3_bucket := v.([]interface{})[0].(map[string]interface{})
@@ -710,10 +712,10 @@ func resourceInstanceCreate(d *schema.ResourceData, meta interface{}) error {
 		}
 
 		if _, ok := d.GetOk("character_set_name"); ok {
-			return fmt.Errorf(`provider.aws: aws_db_instance: %s: "character_set_name" doesn't work with with restores"`, d.Get("name").(string))
+			return diag.FromErr(fmt.Errorf(`provider.aws: aws_db_instance: %s: "character_set_name" doesn't work with with restores"`, d.Get("name").(string)))
 		}
 		if _, ok := d.GetOk("timezone"); ok {
-			return fmt.Errorf(`provider.aws: aws_db_instance: %s: "timezone" doesn't work with with restores"`, d.Get("name").(string))
+			return diag.FromErr(fmt.Errorf(`provider.aws: aws_db_instance: %s: "timezone" doesn't work with with restores"`, d.Get("name").(string)))
 		}
 
 		attr := d.Get("backup_retention_period")
@@ -796,8 +798,8 @@ func resourceInstanceCreate(d *schema.ResourceData, meta interface{}) error {
 		log.Printf("[DEBUG] DB Instance S3 Restore configuration: %#v", opts)
 		var err error
 		// Retry for IAM eventual consistency
-		err = resource.Retry(tfiam.PropagationTimeout, func() *resource.RetryError {
-			_, err = conn.RestoreDBInstanceFromS3(&opts)
+		err = resource.RetryContext(ctx, tfiam.PropagationTimeout, func() *resource.RetryError {
+			_, err = conn.RestoreDBInstanceFromS3WithContext(ctx, &opts)
 			if err != nil {
 				if tfawserr.ErrMessageContains(err, "InvalidParameterValue", "ENHANCED_MONITORING") {
 					return resource.RetryableError(err)
@@ -817,35 +819,21 @@ func resourceInstanceCreate(d *schema.ResourceData, meta interface{}) error {
 			return nil
 		})
 		if tfresource.TimedOut(err) {
-			_, err = conn.RestoreDBInstanceFromS3(&opts)
+			_, err = conn.RestoreDBInstanceFromS3WithContext(ctx, &opts)
 		}
 		if err != nil {
-			return fmt.Errorf("Error creating DB Instance: %s", err)
+			return diag.FromErr(fmt.Errorf("Error creating DB Instance: %s", err))
 		}
 
 		d.SetId(d.Get("identifier").(string))
 
 		log.Printf("[INFO] DB Instance ID: %s", d.Id())
 
-		log.Println(
-			"[INFO] Waiting for DB Instance to be available")
-
-		stateConf := &resource.StateChangeConf{
-			Pending:    resourceInstanceCreatePendingStates,
-			Target:     []string{"available", "storage-optimization"},
-			Refresh:    resourceInstanceStateRefreshFunc(d.Id(), conn),
-			Timeout:    d.Timeout(schema.TimeoutCreate),
-			MinTimeout: 10 * time.Second,
-			Delay:      30 * time.Second, // Wait 30 secs before starting
-		}
-
-		// Wait, catching any errors
-		_, err = stateConf.WaitForState()
-		if err != nil {
-			return err
+		if _, err := waitDBInstanceCreated(ctx, conn, d.Id(), d.Timeout(schema.TimeoutCreate)); err != nil {
+			return diag.FromErr(fmt.Errorf("error waiting for RDS DB Instance (%s) create: %w", d.Id(), err))
 		}
 
-		return resourceInstanceRead(d, meta)
+		return resourceInstanceRead(ctx, d, meta)
 	} else if _, ok := d.GetOk("snapshot_identifier"); ok {
 		opts := rds.RestoreDBInstanceFromDBSnapshotInput{
 			AutoMinorVersionUpgrade: aws.Bool(d.Get("auto_minor_version_upgrade").(bool)),
@@ -1020,7 +1008,7 @@ func resourceInstanceCreate(d *schema.ResourceData, meta interface{}) error {
 		}
 
 		log.Printf("[DEBUG] DB Instance restore from snapshot configuration: %s", opts)
-		_, err := conn.RestoreDBInstanceFromDBSnapshot(&opts)
+		_, err := conn.RestoreDBInstanceFromDBSnapshotWithContext(ctx, &opts)
 
 		// When using SQL Server engine with MultiAZ enabled, its not
 		// possible to immediately enable mirroring since
@@ -1034,11 +1022,11 @@ func resourceInstanceCreate(d *schema.ResourceData, meta interface{}) error {
 			opts.MultiAZ = aws.Bool(false)
 			modifyDbInstanceInput.MultiAZ = aws.Bool(true)
 			requiresModifyDbInstance = true
-			_, err = conn.RestoreDBInstanceFromDBSnapshot(&opts)
+			_, err = conn.RestoreDBInstanceFromDBSnapshotWithContext(ctx, &opts)
 		}
 
 		if err != nil {
-			return fmt.Errorf("Error creating DB Instance: %s", err)
+			return diag.FromErr(fmt.Errorf("Error creating DB Instance: %s", err))
 		}
 	} else if v, ok := d.GetOk("restore_to_point_in_time"); ok {
 		if input := expandRestoreToPointInTime(v.([]interface{})); input != nil {
@@ -1128,23 +1116,23 @@ func resourceInstanceCreate(d *schema.ResourceData, meta interface{}) error {
 
 			log.Printf("[DEBUG] DB Instance restore to point in time configuration: %s", input)
 
-			_, err := conn.RestoreDBInstanceToPointInTime(input)
+			_, err := conn.RestoreDBInstanceToPointInTimeWithContext(ctx, input)
 			if err != nil {
-				return fmt.Errorf("error creating DB Instance: %w", err)
+				return diag.FromErr(fmt.Errorf("error creating DB Instance: %w", err))
 			}
 		}
 	} else {
 		if _, ok := d.GetOk("allocated_storage"); !ok {
-			return fmt.Errorf(`provider.aws: aws_db_instance: %s: "allocated_storage": required field is not set`, d.Get("name").(string))
+			return diag.FromErr(fmt.Errorf(`provider.aws: aws_db_instance: %s: "allocated_storage": required field is not set`, d.Get("name").(string)))
 		}
 		if _, ok := d.GetOk("engine"); !ok {
-			return fmt.Errorf(`provider.aws: aws_db_instance: %s: "engine": required field is not set`, d.Get("name").(string))
+			return diag.FromErr(fmt.Errorf(`provider.aws: aws_db_instance: %s: "engine": required field is not set`, d.Get("name").(string)))
 		}
 		if _, ok := d.GetOk("password"); !ok {
-			return fmt.Errorf(`provider.aws: aws_db_instance: %s: "password": required field is not set`, d.Get("name").(string))
+			return diag.FromErr(fmt.Errorf(`provider.aws: aws_db_instance: %s: "password": required field is not set`, d.Get("name").(string)))
 		}
 		if _, ok := d.GetOk("username"); !ok {
-			return fmt.Errorf(`provider.aws: aws_db_instance: %s: "username": required field is not set`, d.Get("name").(string))
+			return diag.FromErr(fmt.Errorf(`provider.aws: aws_db_instance: %s: "username": required field is not set`, d.Get("name").(string)))
 		}
 
 		opts := rds.CreateDBInstanceInput{
@@ -1281,8 +1269,8 @@ func resourceInstanceCreate(d *schema.ResourceData, meta interface{}) error {
 		log.Printf("[DEBUG] DB Instance create configuration: %#v", opts)
 		var err error
 		var createdDBInstanceOutput *rds.CreateDBInstanceOutput
-		err = resource.Retry(5*time.Minute, func() *resource.RetryError {
-			createdDBInstanceOutput, err = conn.CreateDBInstance(&opts)
+		err = resource.RetryContext(ctx, 5*time.Minute, func() *resource.RetryError {
+			createdDBInstanceOutput, err = conn.CreateDBInstanceWithContext(ctx, &opts)
 			if err != nil {
 				if tfawserr.ErrMessageContains(err, "InvalidParameterValue", "ENHANCED_MONITORING") {
 					return resource.RetryableError(err)
@@ -1292,14 +1280,14 @@ func resourceInstanceCreate(d *schema.ResourceData, meta interface{}) error {
 			return nil
 		})
 		if tfresource.TimedOut(err) {
-			createdDBInstanceOutput, err = conn.CreateDBInstance(&opts)
+			createdDBInstanceOutput, err = conn.CreateDBInstanceWithContext(ctx, &opts)
 		}
 		if err != nil {
 			if tfawserr.ErrMessageContains(err, "InvalidParameterValue", "") {
 				opts.MasterUserPassword = aws.String("********")
-				return fmt.Errorf("Error creating DB Instance: %s, %+v", err, opts)
+				return diag.FromErr(fmt.Errorf("Error creating DB Instance: %s, %+v", err, opts))
 			}
-			return fmt.Errorf("Error creating DB Instance: %s", err)
+			return diag.FromErr(fmt.Errorf("Error creating DB Instance: %s", err))
 		}
 		// This is added here to avoid unnecessary modification when ca_cert_identifier is the default one
 		if attr, ok := d.GetOk("ca_cert_identifier"); ok && attr.(string) != aws.StringValue(createdDBInstanceOutput.DBInstance.CACertificateIdentifier) {
@@ -1310,34 +1298,23 @@ func resourceInstanceCreate(d *schema.ResourceData, meta interface{}) error {
 
 	d.SetId(d.Get("identifier").(string))
 
-	stateConf := &resource.StateChangeConf{
-		Pending:    resourceInstanceCreatePendingStates,
-		Target:     []string{"available", "storage-optimization"},
-		Refresh:    resourceInstanceStateRefreshFunc(d.Id(), conn),
-		Timeout:    d.Timeout(schema.TimeoutCreate),
-		MinTimeout: 10 * time.Second,
-		Delay:      30 * time.Second, // Wait 30 secs before starting
-	}
-
-	log.Printf("[INFO] Waiting for DB Instance (%s) to be available", d.Id())
-	_, err := stateConf.WaitForState()
-	if err != nil {
-		return err
+	if _, err := waitDBInstanceCreated(ctx, conn, d.Id(), d.Timeout(schema.TimeoutCreate)); err != nil {
+		return diag.FromErr(fmt.Errorf("error waiting for RDS DB Instance (%s) create: %w", d.Id(), err))
 	}
 
 	if requiresModifyDbInstance {
 		modifyDbInstanceInput.DBInstanceIdentifier = aws.String(d.Id())
 
 		log.Printf("[INFO] DB Instance (%s) configuration requires ModifyDBInstance: %s", d.Id(), modifyDbInstanceInput)
-		_, err := conn.ModifyDBInstance(modifyDbInstanceInput)
+		_, err := conn.ModifyDBInstanceWithContext(ctx, modifyDbInstanceInput)
 		if err != nil {
-			return fmt.Errorf("error modifying DB Instance (%s): %s", d.Id(), err)
+			return diag.FromErr(fmt.Errorf("error modifying DB Instance (%s): %s", d.Id(), err))
 		}
 
 		log.Printf("[INFO] Waiting for DB Instance (%s) to be available", d.Id())
 		err = waitUntilDBInstanceAvailableAfterUpdate(d.Id(), conn, d.Timeout(schema.TimeoutUpdate))
 		if err != nil {
-			return fmt.Errorf("error waiting for DB Instance (%s) to be available: %s", d.Id(), err)
+			return diag.FromErr(fmt.Errorf("error waiting for DB Instance (%s) to be available: %s", d.Id(), err))
 		}
 	}
 
@@ -1347,22 +1324,22 @@ func resourceInstanceCreate(d *schema.ResourceData, meta interface{}) error {
 		}
 
 		log.Printf("[INFO] DB Instance (%s) configuration requires RebootDBInstance: %s", d.Id(), rebootDbInstanceInput)
-		_, err := conn.RebootDBInstance(rebootDbInstanceInput)
+		_, err := conn.RebootDBInstanceWithContext(ctx, rebootDbInstanceInput)
 		if err != nil {
-			return fmt.Errorf("error rebooting DB Instance (%s): %s", d.Id(), err)
+			return diag.FromErr(fmt.Errorf("error rebooting DB Instance (%s): %s", d.Id(), err))
 		}
 
 		log.Printf("[INFO] Waiting for DB Instance (%s) to be available", d.Id())
 		err = waitUntilDBInstanceAvailableAfterUpdate(d.Id(), conn, d.Timeout(schema.TimeoutUpdate))
 		if err != nil {
-			return fmt.Errorf("error waiting for DB Instance (%s) to be available: %s", d.Id(), err)
+			return diag.FromErr(fmt.Errorf("error waiting for DB Instance (%s) to be available: %s", d.Id(), err))
 		}
 	}
 
-	return resourceInstanceRead(d, meta)
+	return resourceInstanceRead(ctx, d, meta)
 }
 
-func resourceInstanceRead(d *schema.ResourceData, meta interface{}) error {
+func resourceInstanceRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	conn := meta.(*conns.AWSClient).RDSConn
 	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
 	ignoreTagsConfig := meta.(*conns.AWSClient).IgnoreTagsConfig
@@ -1376,7 +1353,7 @@ func resourceInstanceRead(d *schema.ResourceData, meta interface{}) error {
 	}
 
 	if err != nil {
-		return fmt.Errorf("error reading DB Instance (%s): %w", d.Id(), err)
+		return diag.FromErr(fmt.Errorf("error reading DB Instance (%s): %w", d.Id(), err))
 	}
 
 	d.Set("name", v.DBName)
@@ -1439,7 +1416,7 @@ func resourceInstanceRead(d *schema.ResourceData, meta interface{}) error {
 	d.Set("monitoring_role_arn", v.MonitoringRoleArn)
 
 	if err := d.Set("enabled_cloudwatch_logs_exports", flex.FlattenStringList(v.EnabledCloudwatchLogsExports)); err != nil {
-		return fmt.Errorf("error setting enabled_cloudwatch_logs_exports: %s", err)
+		return diag.FromErr(fmt.Errorf("error setting enabled_cloudwatch_logs_exports: %s", err))
 	}
 
 	d.Set("domain", "")
@@ -1455,18 +1432,18 @@ func resourceInstanceRead(d *schema.ResourceData, meta interface{}) error {
 	tags, err := ListTags(conn, d.Get("arn").(string))
 
 	if err != nil {
-		return fmt.Errorf("error listing tags for RDS DB Instance (%s): %s", d.Get("arn").(string), err)
+		return diag.FromErr(fmt.Errorf("error listing tags for RDS DB Instance (%s): %s", d.Get("arn").(string), err))
 	}
 
 	tags = tags.IgnoreAWS().IgnoreConfig(ignoreTagsConfig)
 
 	//lintignore:AWSR002
 	if err := d.Set("tags", tags.RemoveDefaultConfig(defaultTagsConfig).Map()); err != nil {
-		return fmt.Errorf("error setting tags: %w", err)
+		return diag.FromErr(fmt.Errorf("error setting tags: %w", err))
 	}
 
 	if err := d.Set("tags_all", tags.Map()); err != nil {
-		return fmt.Errorf("error setting tags_all: %w", err)
+		return diag.FromErr(fmt.Errorf("error setting tags_all: %w", err))
 	}
 
 	// Create an empty schema.Set to hold all vpc security group ids
@@ -1493,7 +1470,7 @@ func resourceInstanceRead(d *schema.ResourceData, meta interface{}) error {
 		replicas = append(replicas, *v)
 	}
 	if err := d.Set("replicas", replicas); err != nil {
-		return fmt.Errorf("Error setting replicas attribute: %#v, error: %#v", replicas, err)
+		return diag.FromErr(fmt.Errorf("Error setting replicas attribute: %#v, error: %#v", replicas, err))
 	}
 
 	d.Set("replica_mode", v.ReplicaMode)
@@ -1506,7 +1483,7 @@ func resourceInstanceRead(d *schema.ResourceData, meta interface{}) error {
 	return nil
 }
 
-func resourceInstanceDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceInstanceDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	conn := meta.(*conns.AWSClient).RDSConn
 
 	input := &rds.DeleteDBInstanceInput{
@@ -1522,23 +1499,23 @@ func resourceInstanceDelete(d *schema.ResourceData, meta interface{}) error {
 		if v, ok := d.GetOk("final_snapshot_identifier"); ok {
 			input.FinalDBSnapshotIdentifier = aws.String(v.(string))
 		} else {
-			return fmt.Errorf("final_snapshot_identifier is required when skip_final_snapshot is false")
+			return diag.FromErr(fmt.Errorf("final_snapshot_identifier is required when skip_final_snapshot is false"))
 		}
 	}
 
 	log.Printf("[DEBUG] Deleting DB Instance: %s", d.Id())
-	_, err := conn.DeleteDBInstance(input)
+	_, err := conn.DeleteDBInstanceWithContext(ctx, input)
 
 	if tfawserr.ErrCodeEquals(err, rds.ErrCodeDBInstanceNotFoundFault) {
 		return nil
 	}
 
 	if err != nil && !tfawserr.ErrMessageContains(err, rds.ErrCodeInvalidDBInstanceStateFault, "is already being deleted") {
-		return fmt.Errorf("error deleting DB Instance (%s): %w", d.Id(), err)
+		return diag.FromErr(fmt.Errorf("error deleting DB Instance (%s): %w", d.Id(), err))
 	}
 
-	if _, err := waitDBInstanceDeleted(conn, d.Id(), d.Timeout(schema.TimeoutDelete)); err != nil {
-		return fmt.Errorf("error waiting for DB Instance (%s) delete: %w", d.Id(), err)
+	if _, err := waitDBInstanceDeleted(ctx, conn, d.Id(), d.Timeout(schema.TimeoutDelete)); err != nil {
+		return diag.FromErr(fmt.Errorf("error waiting for DB Instance (%s) delete: %w", d.Id(), err))
 	}
 
 	return nil
@@ -1557,7 +1534,7 @@ func waitUntilDBInstanceAvailableAfterUpdate(id string, conn *rds.RDS, timeout t
 	return err
 }
 
-func resourceInstanceUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceInstanceUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	conn := meta.(*conns.AWSClient).RDSConn
 
 	req := &rds.ModifyDBInstanceInput{
@@ -1751,8 +1728,8 @@ func resourceInstanceUpdate(d *schema.ResourceData, meta interface{}) error {
 	if requestUpdate {
 		log.Printf("[DEBUG] DB Instance Modification request: %s", req)
 
-		err := resource.Retry(tfiam.PropagationTimeout, func() *resource.RetryError {
-			_, err := conn.ModifyDBInstance(req)
+		err := resource.RetryContext(ctx, tfiam.PropagationTimeout, func() *resource.RetryError {
+			_, err := conn.ModifyDBInstanceWithContext(ctx, req)
 
 			// Retry for IAM eventual consistency
 			if tfawserr.ErrMessageContains(err, "InvalidParameterValue", "IAM role ARN value is invalid or does not include the required permissions") {
@@ -1767,17 +1744,17 @@ func resourceInstanceUpdate(d *schema.ResourceData, meta interface{}) error {
 		})
 
 		if tfresource.TimedOut(err) {
-			_, err = conn.ModifyDBInstance(req)
+			_, err = conn.ModifyDBInstanceWithContext(ctx, req)
 		}
 
 		if err != nil {
-			return fmt.Errorf("Error modifying DB Instance %s: %s", d.Id(), err)
+			return diag.FromErr(fmt.Errorf("Error modifying DB Instance %s: %s", d.Id(), err))
 		}
 
 		log.Printf("[DEBUG] Waiting for DB Instance (%s) to be available", d.Id())
 		err = waitUntilDBInstanceAvailableAfterUpdate(d.Id(), conn, d.Timeout(schema.TimeoutUpdate))
 		if err != nil {
-			return fmt.Errorf("error waiting for DB Instance (%s) to be available: %s", d.Id(), err)
+			return diag.FromErr(fmt.Errorf("error waiting for DB Instance (%s) to be available: %s", d.Id(), err))
 		}
 	}
 
@@ -1793,13 +1770,13 @@ func resourceInstanceUpdate(d *schema.ResourceData, meta interface{}) error {
 			if attr, ok := d.GetOk("backup_window"); ok {
 				opts.PreferredBackupWindow = aws.String(attr.(string))
 			}
-			_, err := conn.PromoteReadReplica(&opts)
+			_, err := conn.PromoteReadReplicaWithContext(ctx, &opts)
 			if err != nil {
-				return fmt.Errorf("Error promoting database: %#v", err)
+				return diag.FromErr(fmt.Errorf("Error promoting database: %#v", err))
 			}
 			d.Set("replicate_source_db", "")
 		} else {
-			return fmt.Errorf("cannot elect new source database for replication")
+			return diag.FromErr(fmt.Errorf("cannot elect new source database for replication"))
 		}
 	}
 
@@ -1807,12 +1784,12 @@ func resourceInstanceUpdate(d *schema.ResourceData, meta interface{}) error {
 		o, n := d.GetChange("tags_all")
 
 		if err := UpdateTags(conn, d.Get("arn").(string), o, n); err != nil {
-			return fmt.Errorf("error updating RDS DB Instance (%s) tags: %s", d.Get("arn").(string), err)
+			return diag.FromErr(fmt.Errorf("error updating RDS DB Instance (%s) tags: %s", d.Get("arn").(string), err))
 		}
 
 	}
 
-	return resourceInstanceRead(d, meta)
+	return resourceInstanceRead(ctx, d, meta)
 }
 
 // resourceInstanceRetrieve fetches DBInstance information from the AWS
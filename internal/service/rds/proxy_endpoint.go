@@ -1,6 +1,7 @@
 package rds
 
 import (
+	"context"
 	"fmt"
 	"log"
 	"strings"
@@ -9,6 +10,7 @@ import (
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/rds"
 	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
@@ -19,12 +21,12 @@ import (
 
 func ResourceProxyEndpoint() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceProxyEndpointCreate,
-		Read:   resourceProxyEndpointRead,
-		Delete: resourceProxyEndpointDelete,
-		Update: resourceProxyEndpointUpdate,
+		CreateContext: resourceProxyEndpointCreate,
+		ReadContext:   resourceProxyEndpointRead,
+		DeleteContext: resourceProxyEndpointDelete,
+		UpdateContext: resourceProxyEndpointUpdate,
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: schema.ImportStatePassthroughContext,
 		},
 		CustomizeDiff: verify.SetTagsDiff,
 		Timeouts: &schema.ResourceTimeout{
@@ -87,7 +89,7 @@ func ResourceProxyEndpoint() *schema.Resource {
 	}
 }
 
-func resourceProxyEndpointCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceProxyEndpointCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	conn := meta.(*conns.AWSClient).RDSConn
 	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
 	tags := defaultTagsConfig.MergeTags(tftags.New(d.Get("tags").(map[string]interface{})))
@@ -107,22 +109,22 @@ func resourceProxyEndpointCreate(d *schema.ResourceData, meta interface{}) error
 		params.VpcSecurityGroupIds = flex.ExpandStringSet(v)
 	}
 
-	_, err := conn.CreateDBProxyEndpoint(&params)
+	_, err := conn.CreateDBProxyEndpointWithContext(ctx, &params)
 
 	if err != nil {
-		return fmt.Errorf("error Creating RDS DB Proxy Endpoint (%s/%s): %w", dbProxyName, dbProxyEndpointName, err)
+		return diag.FromErr(fmt.Errorf("error Creating RDS DB Proxy Endpoint (%s/%s): %w", dbProxyName, dbProxyEndpointName, err))
 	}
 
 	d.SetId(strings.Join([]string{dbProxyName, dbProxyEndpointName}, "/"))
 
-	if _, err := waitDBProxyEndpointAvailable(conn, d.Id(), d.Timeout(schema.TimeoutCreate)); err != nil {
-		return fmt.Errorf("error waiting for RDS DB Proxy Endpoint (%s) to become available: %w", d.Id(), err)
+	if _, err := waitDBProxyEndpointAvailable(ctx, conn, d.Id(), d.Timeout(schema.TimeoutCreate)); err != nil {
+		return diag.FromErr(fmt.Errorf("error waiting for RDS DB Proxy Endpoint (%s) to become available: %w", d.Id(), err))
 	}
 
-	return resourceProxyEndpointRead(d, meta)
+	return resourceProxyEndpointRead(ctx, d, meta)
 }
 
-func resourceProxyEndpointRead(d *schema.ResourceData, meta interface{}) error {
+func resourceProxyEndpointRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	conn := meta.(*conns.AWSClient).RDSConn
 	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
 	ignoreTagsConfig := meta.(*conns.AWSClient).IgnoreTagsConfig
@@ -142,12 +144,12 @@ func resourceProxyEndpointRead(d *schema.ResourceData, meta interface{}) error {
 	}
 
 	if err != nil {
-		return fmt.Errorf("error reading RDS DB Proxy Endpoint (%s): %w", d.Id(), err)
+		return diag.FromErr(fmt.Errorf("error reading RDS DB Proxy Endpoint (%s): %w", d.Id(), err))
 	}
 
 	if dbProxyEndpoint == nil {
 		if d.IsNewResource() {
-			return fmt.Errorf("error reading RDS DB Proxy Endpoint (%s): not found after creation", d.Id())
+			return diag.FromErr(fmt.Errorf("error reading RDS DB Proxy Endpoint (%s): not found after creation", d.Id()))
 		}
 
 		log.Printf("[WARN] RDS DB Proxy Endpoint (%s) not found, removing from state", d.Id())
@@ -170,24 +172,24 @@ func resourceProxyEndpointRead(d *schema.ResourceData, meta interface{}) error {
 	tags, err := ListTags(conn, endpointArn)
 
 	if err != nil {
-		return fmt.Errorf("Error listing tags for RDS DB Proxy Endpoint (%s): %w", endpointArn, err)
+		return diag.FromErr(fmt.Errorf("Error listing tags for RDS DB Proxy Endpoint (%s): %w", endpointArn, err))
 	}
 
 	tags = tags.IgnoreAWS().IgnoreConfig(ignoreTagsConfig)
 
 	//lintignore:AWSR002
 	if err := d.Set("tags", tags.RemoveDefaultConfig(defaultTagsConfig).Map()); err != nil {
-		return fmt.Errorf("error setting tags: %w", err)
+		return diag.FromErr(fmt.Errorf("error setting tags: %w", err))
 	}
 
 	if err := d.Set("tags_all", tags.Map()); err != nil {
-		return fmt.Errorf("error setting tags_all: %w", err)
+		return diag.FromErr(fmt.Errorf("error setting tags_all: %w", err))
 	}
 
 	return nil
 }
 
-func resourceProxyEndpointUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceProxyEndpointUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	conn := meta.(*conns.AWSClient).RDSConn
 
 	if d.HasChange("vpc_security_group_ids") {
@@ -196,13 +198,13 @@ func resourceProxyEndpointUpdate(d *schema.ResourceData, meta interface{}) error
 			VpcSecurityGroupIds: flex.ExpandStringSet(d.Get("vpc_security_group_ids").(*schema.Set)),
 		}
 
-		_, err := conn.ModifyDBProxyEndpoint(&params)
+		_, err := conn.ModifyDBProxyEndpointWithContext(ctx, &params)
 		if err != nil {
-			return fmt.Errorf("Error updating DB Proxy Endpoint: %w", err)
+			return diag.FromErr(fmt.Errorf("Error updating DB Proxy Endpoint: %w", err))
 		}
 
-		if _, err := waitDBProxyEndpointAvailable(conn, d.Id(), d.Timeout(schema.TimeoutUpdate)); err != nil {
-			return fmt.Errorf("error waiting for RDS DB Proxy Endpoint (%s) to become modified: %w", d.Id(), err)
+		if _, err := waitDBProxyEndpointAvailable(ctx, conn, d.Id(), d.Timeout(schema.TimeoutUpdate)); err != nil {
+			return diag.FromErr(fmt.Errorf("error waiting for RDS DB Proxy Endpoint (%s) to become modified: %w", d.Id(), err))
 		}
 	}
 
@@ -210,14 +212,14 @@ func resourceProxyEndpointUpdate(d *schema.ResourceData, meta interface{}) error
 		o, n := d.GetChange("tags")
 
 		if err := UpdateTags(conn, d.Get("arn").(string), o, n); err != nil {
-			return fmt.Errorf("Error updating RDS DB Proxy Endpoint (%s) tags: %w", d.Get("arn").(string), err)
+			return diag.FromErr(fmt.Errorf("Error updating RDS DB Proxy Endpoint (%s) tags: %w", d.Get("arn").(string), err))
 		}
 	}
 
-	return resourceProxyEndpointRead(d, meta)
+	return resourceProxyEndpointRead(ctx, d, meta)
 }
 
-func resourceProxyEndpointDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceProxyEndpointDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	conn := meta.(*conns.AWSClient).RDSConn
 
 	params := rds.DeleteDBProxyEndpointInput{
@@ -225,20 +227,20 @@ func resourceProxyEndpointDelete(d *schema.ResourceData, meta interface{}) error
 	}
 
 	log.Printf("[DEBUG] Delete DB Proxy Endpoint: %#v", params)
-	_, err := conn.DeleteDBProxyEndpoint(&params)
+	_, err := conn.DeleteDBProxyEndpointWithContext(ctx, &params)
 
 	if err != nil {
 		if tfawserr.ErrCodeEquals(err, rds.ErrCodeDBProxyNotFoundFault) || tfawserr.ErrCodeEquals(err, rds.ErrCodeDBProxyEndpointNotFoundFault) {
 			return nil
 		}
-		return fmt.Errorf("Error Deleting DB Proxy Endpoint: %w", err)
+		return diag.FromErr(fmt.Errorf("Error Deleting DB Proxy Endpoint: %w", err))
 	}
 
-	if _, err := waitDBProxyEndpointDeleted(conn, d.Id(), d.Timeout(schema.TimeoutDelete)); err != nil {
+	if _, err := waitDBProxyEndpointDeleted(ctx, conn, d.Id(), d.Timeout(schema.TimeoutDelete)); err != nil {
 		if tfawserr.ErrCodeEquals(err, rds.ErrCodeDBProxyNotFoundFault) || tfawserr.ErrCodeEquals(err, rds.ErrCodeDBProxyEndpointNotFoundFault) {
 			return nil
 		}
-		return fmt.Errorf("error waiting for RDS DB Proxy Endpoint (%s) to become deleted: %w", d.Id(), err)
+		return diag.FromErr(fmt.Errorf("error waiting for RDS DB Proxy Endpoint (%s) to become deleted: %w", d.Id(), err))
 	}
 
 	return nil
@@ -1,10 +1,12 @@
 package rds
 
 import (
+	"context"
+	"fmt"
 	"time"
 
 	"github.com/aws/aws-sdk-go/service/rds"
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 )
 
 const (
@@ -14,8 +16,8 @@ const (
 	dbClusterRoleAssociationDeletedTimeout = 5 * time.Minute
 )
 
-func waitEventSubscriptionCreated(conn *rds.RDS, id string, timeout time.Duration) (*rds.EventSubscription, error) {
-	stateConf := &resource.StateChangeConf{
+func waitEventSubscriptionCreated(ctx context.Context, conn *rds.RDS, id string, timeout time.Duration) (*rds.EventSubscription, error) {
+	stateConf := &tfresource.StateChangeConf{
 		Pending:    []string{EventSubscriptionStatusCreating},
 		Target:     []string{EventSubscriptionStatusActive},
 		Refresh:    statusEventSubscription(conn, id),
@@ -24,7 +26,7 @@ func waitEventSubscriptionCreated(conn *rds.RDS, id string, timeout time.Duratio
 		Delay:      30 * time.Second,
 	}
 
-	outputRaw, err := stateConf.WaitForState()
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
 
 	if output, ok := outputRaw.(*rds.EventSubscription); ok {
 		return output, err
@@ -33,8 +35,8 @@ func waitEventSubscriptionCreated(conn *rds.RDS, id string, timeout time.Duratio
 	return nil, err
 }
 
-func waitEventSubscriptionDeleted(conn *rds.RDS, id string, timeout time.Duration) (*rds.EventSubscription, error) {
-	stateConf := &resource.StateChangeConf{
+func waitEventSubscriptionDeleted(ctx context.Context, conn *rds.RDS, id string, timeout time.Duration) (*rds.EventSubscription, error) {
+	stateConf := &tfresource.StateChangeConf{
 		Pending:    []string{EventSubscriptionStatusDeleting},
 		Target:     []string{},
 		Refresh:    statusEventSubscription(conn, id),
@@ -43,7 +45,7 @@ func waitEventSubscriptionDeleted(conn *rds.RDS, id string, timeout time.Duratio
 		Delay:      30 * time.Second,
 	}
 
-	outputRaw, err := stateConf.WaitForState()
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
 
 	if output, ok := outputRaw.(*rds.EventSubscription); ok {
 		return output, err
@@ -52,8 +54,8 @@ func waitEventSubscriptionDeleted(conn *rds.RDS, id string, timeout time.Duratio
 	return nil, err
 }
 
-func waitEventSubscriptionUpdated(conn *rds.RDS, id string, timeout time.Duration) (*rds.EventSubscription, error) {
-	stateConf := &resource.StateChangeConf{
+func waitEventSubscriptionUpdated(ctx context.Context, conn *rds.RDS, id string, timeout time.Duration) (*rds.EventSubscription, error) {
+	stateConf := &tfresource.StateChangeConf{
 		Pending:    []string{EventSubscriptionStatusModifying},
 		Target:     []string{EventSubscriptionStatusActive},
 		Refresh:    statusEventSubscription(conn, id),
@@ -62,7 +64,7 @@ func waitEventSubscriptionUpdated(conn *rds.RDS, id string, timeout time.Duratio
 		Delay:      30 * time.Second,
 	}
 
-	outputRaw, err := stateConf.WaitForState()
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
 
 	if output, ok := outputRaw.(*rds.EventSubscription); ok {
 		return output, err
@@ -72,8 +74,8 @@ func waitEventSubscriptionUpdated(conn *rds.RDS, id string, timeout time.Duratio
 }
 
 // waitDBProxyEndpointAvailable waits for a DBProxyEndpoint to return Available
-func waitDBProxyEndpointAvailable(conn *rds.RDS, id string, timeout time.Duration) (*rds.DBProxyEndpoint, error) { //nolint:unparam
-	stateConf := &resource.StateChangeConf{
+func waitDBProxyEndpointAvailable(ctx context.Context, conn *rds.RDS, id string, timeout time.Duration) (*rds.DBProxyEndpoint, error) { //nolint:unparam
+	stateConf := &tfresource.StateChangeConf{
 		Pending: []string{
 			rds.DBProxyEndpointStatusCreating,
 			rds.DBProxyEndpointStatusModifying,
@@ -83,7 +85,7 @@ func waitDBProxyEndpointAvailable(conn *rds.RDS, id string, timeout time.Duratio
 		Timeout: timeout,
 	}
 
-	outputRaw, err := stateConf.WaitForState()
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
 
 	if output, ok := outputRaw.(*rds.DBProxyEndpoint); ok {
 		return output, err
@@ -93,15 +95,15 @@ func waitDBProxyEndpointAvailable(conn *rds.RDS, id string, timeout time.Duratio
 }
 
 // waitDBProxyEndpointDeleted waits for a DBProxyEndpoint to return Deleted
-func waitDBProxyEndpointDeleted(conn *rds.RDS, id string, timeout time.Duration) (*rds.DBProxyEndpoint, error) {
-	stateConf := &resource.StateChangeConf{
+func waitDBProxyEndpointDeleted(ctx context.Context, conn *rds.RDS, id string, timeout time.Duration) (*rds.DBProxyEndpoint, error) {
+	stateConf := &tfresource.StateChangeConf{
 		Pending: []string{rds.DBProxyEndpointStatusDeleting},
 		Target:  []string{},
 		Refresh: statusDBProxyEndpoint(conn, id),
 		Timeout: timeout,
 	}
 
-	outputRaw, err := stateConf.WaitForState()
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
 
 	if output, ok := outputRaw.(*rds.DBProxyEndpoint); ok {
 		return output, err
@@ -110,15 +112,15 @@ func waitDBProxyEndpointDeleted(conn *rds.RDS, id string, timeout time.Duration)
 	return nil, err
 }
 
-func waitDBClusterRoleAssociationCreated(conn *rds.RDS, dbClusterID, roleARN string) (*rds.DBClusterRole, error) {
-	stateConf := &resource.StateChangeConf{
+func waitDBClusterRoleAssociationCreated(ctx context.Context, conn *rds.RDS, dbClusterID, roleARN string) (*rds.DBClusterRole, error) {
+	stateConf := &tfresource.StateChangeConf{
 		Pending: []string{ClusterRoleStatusPending},
 		Target:  []string{ClusterRoleStatusActive},
 		Refresh: statusDBClusterRole(conn, dbClusterID, roleARN),
 		Timeout: dbClusterRoleAssociationCreatedTimeout,
 	}
 
-	outputRaw, err := stateConf.WaitForState()
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
 
 	if output, ok := outputRaw.(*rds.DBClusterRole); ok {
 		return output, err
@@ -127,15 +129,15 @@ func waitDBClusterRoleAssociationCreated(conn *rds.RDS, dbClusterID, roleARN str
 	return nil, err
 }
 
-func waitDBClusterRoleAssociationDeleted(conn *rds.RDS, dbClusterID, roleARN string) (*rds.DBClusterRole, error) {
-	stateConf := &resource.StateChangeConf{
+func waitDBClusterRoleAssociationDeleted(ctx context.Context, conn *rds.RDS, dbClusterID, roleARN string) (*rds.DBClusterRole, error) {
+	stateConf := &tfresource.StateChangeConf{
 		Pending: []string{ClusterRoleStatusActive, ClusterRoleStatusPending},
 		Target:  []string{},
 		Refresh: statusDBClusterRole(conn, dbClusterID, roleARN),
 		Timeout: dbClusterRoleAssociationDeletedTimeout,
 	}
 
-	outputRaw, err := stateConf.WaitForState()
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
 
 	if output, ok := outputRaw.(*rds.DBClusterRole); ok {
 		return output, err
@@ -144,8 +146,28 @@ func waitDBClusterRoleAssociationDeleted(conn *rds.RDS, dbClusterID, roleARN str
 	return nil, err
 }
 
-func waitDBInstanceDeleted(conn *rds.RDS, id string, timeout time.Duration) (*rds.DBInstance, error) {
-	stateConf := &resource.StateChangeConf{
+func waitDBInstanceCreated(ctx context.Context, conn *rds.RDS, id string, timeout time.Duration) (*rds.DBInstance, error) {
+	stateConf := &tfresource.StateChangeConf{
+		Pending:     resourceInstanceCreatePendingStates,
+		Target:      []string{InstanceStatusAvailable, InstanceStatusStorageOptimization},
+		Refresh:     resourceInstanceStateRefreshFunc(id, conn),
+		Timeout:     timeout,
+		Description: fmt.Sprintf("RDS DB Instance (%s) create", id),
+		MinTimeout:  10 * time.Second,
+		Delay:       30 * time.Second,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*rds.DBInstance); ok {
+		return output, err
+	}
+
+	return nil, err
+}
+
+func waitDBInstanceDeleted(ctx context.Context, conn *rds.RDS, id string, timeout time.Duration) (*rds.DBInstance, error) {
+	stateConf := &tfresource.StateChangeConf{
 		Pending: []string{
 			InstanceStatusAvailable,
 			InstanceStatusBackingUp,
@@ -160,14 +182,15 @@ func waitDBInstanceDeleted(conn *rds.RDS, id string, timeout time.Duration) (*rd
 			InstanceStatusStorageFull,
 			InstanceStatusStorageOptimization,
 		},
-		Target:     []string{},
-		Refresh:    statusDBInstance(conn, id),
-		Timeout:    timeout,
-		MinTimeout: 10 * time.Second,
-		Delay:      30 * time.Second,
+		Target:      []string{},
+		Refresh:     statusDBInstance(conn, id),
+		Timeout:     timeout,
+		Description: fmt.Sprintf("RDS DB Instance (%s) delete", id),
+		MinTimeout:  10 * time.Second,
+		Delay:       30 * time.Second,
 	}
 
-	outputRaw, err := stateConf.WaitForState()
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
 
 	if output, ok := outputRaw.(*rds.DBInstance); ok {
 		return output, err
@@ -176,21 +199,42 @@ func waitDBInstanceDeleted(conn *rds.RDS, id string, timeout time.Duration) (*rd
 	return nil, err
 }
 
-func waitDBClusterInstanceDeleted(conn *rds.RDS, id string, timeout time.Duration) (*rds.DBInstance, error) {
-	stateConf := &resource.StateChangeConf{
+func waitDBClusterInstanceCreated(ctx context.Context, conn *rds.RDS, id string, timeout time.Duration) (*rds.DBInstance, error) {
+	stateConf := &tfresource.StateChangeConf{
+		Pending:     resourceClusterInstanceCreateUpdatePendingStates,
+		Target:      []string{InstanceStatusAvailable},
+		Refresh:     resourceInstanceStateRefreshFunc(id, conn),
+		Timeout:     timeout,
+		Description: fmt.Sprintf("RDS Cluster Instance (%s) create", id),
+		MinTimeout:  10 * time.Second,
+		Delay:       30 * time.Second,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*rds.DBInstance); ok {
+		return output, err
+	}
+
+	return nil, err
+}
+
+func waitDBClusterInstanceDeleted(ctx context.Context, conn *rds.RDS, id string, timeout time.Duration) (*rds.DBInstance, error) {
+	stateConf := &tfresource.StateChangeConf{
 		Pending: []string{
 			InstanceStatusConfiguringLogExports,
 			InstanceStatusDeleting,
 			InstanceStatusModifying,
 		},
-		Target:     []string{},
-		Refresh:    statusDBInstance(conn, id),
-		Timeout:    timeout,
-		MinTimeout: 10 * time.Second,
-		Delay:      30 * time.Second,
+		Target:      []string{},
+		Refresh:     statusDBInstance(conn, id),
+		Timeout:     timeout,
+		Description: fmt.Sprintf("RDS Cluster Instance (%s) delete", id),
+		MinTimeout:  10 * time.Second,
+		Delay:       30 * time.Second,
 	}
 
-	outputRaw, err := stateConf.WaitForState()
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
 
 	if output, ok := outputRaw.(*rds.DBInstance); ok {
 		return output, err
@@ -198,3 +242,23 @@ func waitDBClusterInstanceDeleted(conn *rds.RDS, id string, timeout time.Duratio
 
 	return nil, err
 }
+
+func waitDBClusterCreated(ctx context.Context, conn *rds.RDS, id string, timeout time.Duration) (*rds.DBCluster, error) {
+	stateConf := &tfresource.StateChangeConf{
+		Pending:     resourceClusterCreatePendingStates,
+		Target:      []string{"available"},
+		Refresh:     resourceClusterStateRefreshFunc(conn, id),
+		Timeout:     timeout,
+		Description: fmt.Sprintf("RDS Cluster (%s) create", id),
+		MinTimeout:  10 * time.Second,
+		Delay:       30 * time.Second,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*rds.DBCluster); ok {
+		return output, err
+	}
+
+	return nil, err
+}
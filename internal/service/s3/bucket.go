@@ -245,6 +245,7 @@ func ResourceBucket() *schema.Resource {
 			"logging": {
 				Type:     schema.TypeSet,
 				Optional: true,
+				Computed: true,
 				Elem: &schema.Resource{
 					Schema: map[string]*schema.Schema{
 						"target_bucket": {
@@ -380,6 +381,12 @@ func ResourceBucket() *schema.Resource {
 				Default:  false,
 			},
 
+			"bypass_governance_retention": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+
 			"acceleration_status": {
 				Type:         schema.TypeString,
 				Optional:     true,
@@ -571,6 +578,7 @@ func ResourceBucket() *schema.Resource {
 				Type:     schema.TypeList,
 				MaxItems: 1,
 				Optional: true,
+				Computed: true,
 				Elem: &schema.Resource{
 					Schema: map[string]*schema.Schema{
 						"rule": {
@@ -781,20 +789,20 @@ func resourceBucketUpdate(d *schema.ResourceData, meta interface{}) error {
 
 		if d.IsNewResource() {
 			if versioning := expandVersioningWhenIsNewResource(v); versioning != nil {
-				err := resourceBucketVersioningUpdate(conn, d.Id(), versioning)
+				err := resourceBucketInternalVersioningUpdate(conn, d.Id(), versioning)
 				if err != nil {
 					return err
 				}
 			}
 		} else {
-			if err := resourceBucketVersioningUpdate(conn, d.Id(), expandVersioning(v)); err != nil {
+			if err := resourceBucketInternalVersioningUpdate(conn, d.Id(), expandVersioning(v)); err != nil {
 				return err
 			}
 		}
 	}
 
 	if d.HasChange("acl") && !d.IsNewResource() {
-		if err := resourceBucketACLUpdate(conn, d); err != nil {
+		if err := resourceBucketInternalACLUpdate(conn, d); err != nil {
 			return err
 		}
 	}
@@ -806,7 +814,7 @@ func resourceBucketUpdate(d *schema.ResourceData, meta interface{}) error {
 	}
 
 	if d.HasChange("logging") {
-		if err := resourceBucketLoggingUpdate(conn, d); err != nil {
+		if err := resourceBucketInternalLoggingUpdate(conn, d); err != nil {
 			return err
 		}
 	}
@@ -836,7 +844,7 @@ func resourceBucketUpdate(d *schema.ResourceData, meta interface{}) error {
 	}
 
 	if d.HasChange("server_side_encryption_configuration") {
-		if err := resourceBucketServerSideEncryptionConfigurationUpdate(conn, d); err != nil {
+		if err := resourceBucketInternalServerSideEncryptionConfigurationUpdate(conn, d); err != nil {
 			return err
 		}
 	}
@@ -1424,14 +1432,15 @@ func resourceBucketDelete(d *schema.ResourceData, meta interface{}) error {
 			// bucket may have things delete them
 			log.Printf("[DEBUG] S3 Bucket attempting to forceDestroy %+v", err)
 
-			// Delete everything including locked objects.
+			// Delete everything including locked objects if object lock is configured
+			// or governance-mode retention is to be bypassed.
 			// Don't ignore any object errors or we could recurse infinitely.
-			var objectLockEnabled bool
+			force := d.Get("bypass_governance_retention").(bool)
 			objectLockConfiguration := expandS3ObjectLockConfiguration(d.Get("object_lock_configuration").([]interface{}))
 			if objectLockConfiguration != nil {
-				objectLockEnabled = aws.StringValue(objectLockConfiguration.ObjectLockEnabled) == s3.ObjectLockEnabledEnabled
+				force = force || aws.StringValue(objectLockConfiguration.ObjectLockEnabled) == s3.ObjectLockEnabledEnabled
 			}
-			err = DeleteAllObjectVersions(conn, d.Id(), "", objectLockEnabled, false)
+			err = DeleteAllObjectVersions(conn, d.Id(), "", force, false)
 
 			if err != nil {
 				return fmt.Errorf("error S3 Bucket force_destroy: %s", err)
@@ -1504,7 +1513,7 @@ func resourceBucketGrantsUpdate(conn *s3.S3, d *schema.ResourceData) error {
 
 	if len(rawGrants) == 0 {
 		log.Printf("[DEBUG] S3 bucket: %s, Grants fallback to canned ACL", bucket)
-		if err := resourceBucketACLUpdate(conn, d); err != nil {
+		if err := resourceBucketInternalACLUpdate(conn, d); err != nil {
 			return fmt.Errorf("Error fallback to canned ACL, %s", err)
 		}
 	} else {
@@ -1748,10 +1757,11 @@ func websiteEndpoint(client *conns.AWSClient, d *schema.ResourceData) (*S3Websit
 		return nil, nil
 	}
 
-	bucket := d.Get("bucket").(string)
+	return bucketWebsiteEndpoint(client, d.Get("bucket").(string))
+}
 
+func bucketWebsiteEndpoint(client *conns.AWSClient, bucket string) (*S3Website, error) {
 	// Lookup the region for this bucket
-
 	locationResponse, err := verify.RetryOnAWSCode(s3.ErrCodeNoSuchBucket, func() (interface{}, error) {
 		return client.S3Conn.GetBucketLocation(
 			&s3.GetBucketLocationInput{
@@ -1822,7 +1832,7 @@ func isOldRegion(region string) bool {
 	return false
 }
 
-func resourceBucketACLUpdate(conn *s3.S3, d *schema.ResourceData) error {
+func resourceBucketInternalACLUpdate(conn *s3.S3, d *schema.ResourceData) error {
 	acl := d.Get("acl").(string)
 	bucket := d.Get("bucket").(string)
 
@@ -1842,7 +1852,7 @@ func resourceBucketACLUpdate(conn *s3.S3, d *schema.ResourceData) error {
 	return nil
 }
 
-func resourceBucketVersioningUpdate(conn *s3.S3, bucket string, versioningConfig *s3.VersioningConfiguration) error {
+func resourceBucketInternalVersioningUpdate(conn *s3.S3, bucket string, versioningConfig *s3.VersioningConfiguration) error {
 	input := &s3.PutBucketVersioningInput{
 		Bucket:                  aws.String(bucket),
 		VersioningConfiguration: versioningConfig,
@@ -1859,7 +1869,7 @@ func resourceBucketVersioningUpdate(conn *s3.S3, bucket string, versioningConfig
 	return nil
 }
 
-func resourceBucketLoggingUpdate(conn *s3.S3, d *schema.ResourceData) error {
+func resourceBucketInternalLoggingUpdate(conn *s3.S3, d *schema.ResourceData) error {
 	logging := d.Get("logging").(*schema.Set).List()
 	bucket := d.Get("bucket").(string)
 	loggingStatus := &s3.BucketLoggingStatus{}
@@ -1938,7 +1948,7 @@ func resourceBucketRequestPayerUpdate(conn *s3.S3, d *schema.ResourceData) error
 	return nil
 }
 
-func resourceBucketServerSideEncryptionConfigurationUpdate(conn *s3.S3, d *schema.ResourceData) error {
+func resourceBucketInternalServerSideEncryptionConfigurationUpdate(conn *s3.S3, d *schema.ResourceData) error {
 	bucket := d.Get("bucket").(string)
 	serverSideEncryptionConfiguration := d.Get("server_side_encryption_configuration").([]interface{})
 	if len(serverSideEncryptionConfiguration) == 0 {
@@ -0,0 +1,277 @@
+package s3
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/s3"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func ResourceBucketACL() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceBucketACLCreate,
+		Read:   resourceBucketACLRead,
+		Update: resourceBucketACLUpdate,
+		Delete: resourceBucketACLDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"access_control_policy": {
+				Type:         schema.TypeList,
+				Optional:     true,
+				Computed:     true,
+				MaxItems:     1,
+				ExactlyOneOf: []string{"access_control_policy", "acl"},
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"grant": {
+							Type:     schema.TypeSet,
+							Optional: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"grantee": {
+										Type:     schema.TypeList,
+										Required: true,
+										MaxItems: 1,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"display_name": {
+													Type:     schema.TypeString,
+													Computed: true,
+												},
+												"email_address": {
+													Type:     schema.TypeString,
+													Optional: true,
+												},
+												"id": {
+													Type:     schema.TypeString,
+													Optional: true,
+												},
+												"type": {
+													Type:         schema.TypeString,
+													Required:     true,
+													ValidateFunc: validation.StringInSlice(s3.Type_Values(), false),
+												},
+												"uri": {
+													Type:     schema.TypeString,
+													Optional: true,
+												},
+											},
+										},
+									},
+									"permission": {
+										Type:         schema.TypeString,
+										Required:     true,
+										ValidateFunc: validation.StringInSlice(s3.Permission_Values(), false),
+									},
+								},
+							},
+						},
+						"owner": {
+							Type:     schema.TypeList,
+							Required: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"display_name": {
+										Type:     schema.TypeString,
+										Optional: true,
+										Computed: true,
+									},
+									"id": {
+										Type:     schema.TypeString,
+										Required: true,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			"acl": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ExactlyOneOf: []string{"access_control_policy", "acl"},
+				ValidateFunc: validation.StringInSlice(BucketCannedACL_Values(), false),
+			},
+			"bucket": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(1, 63),
+			},
+		},
+	}
+}
+
+func resourceBucketACLCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).S3Conn
+
+	bucket := d.Get("bucket").(string)
+
+	if err := putBucketACL(conn, bucket, d); err != nil {
+		return fmt.Errorf("error creating S3 Bucket ACL (%s): %w", bucket, err)
+	}
+
+	d.SetId(bucket)
+
+	return resourceBucketACLRead(d, meta)
+}
+
+func resourceBucketACLRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).S3Conn
+
+	outputRaw, err := tfresource.RetryWhenNewResourceNotFound(propagationTimeout, func() (interface{}, error) {
+		return FindBucketACL(conn, d.Id())
+	}, d.IsNewResource())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] S3 Bucket ACL (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error reading S3 Bucket ACL (%s): %w", d.Id(), err)
+	}
+
+	output := outputRaw.(*s3.GetBucketAclOutput)
+
+	d.Set("bucket", d.Id())
+
+	if err := d.Set("access_control_policy", flattenBucketACLAccessControlPolicy(output)); err != nil {
+		return fmt.Errorf("error setting access_control_policy: %w", err)
+	}
+
+	return nil
+}
+
+func resourceBucketACLUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).S3Conn
+
+	if err := putBucketACL(conn, d.Id(), d); err != nil {
+		return fmt.Errorf("error updating S3 Bucket ACL (%s): %w", d.Id(), err)
+	}
+
+	return resourceBucketACLRead(d, meta)
+}
+
+func resourceBucketACLDelete(d *schema.ResourceData, meta interface{}) error {
+	// A bucket always has an ACL, so there is nothing to delete.
+	log.Printf("[WARN] Cannot destroy S3 Bucket ACL (%s). Terraform will remove this resource from the state file, however the bucket's ACL will remain unchanged.", d.Id())
+
+	return nil
+}
+
+func putBucketACL(conn *s3.S3, bucket string, d *schema.ResourceData) error {
+	input := &s3.PutBucketAclInput{
+		Bucket: aws.String(bucket),
+	}
+
+	if v, ok := d.GetOk("acl"); ok {
+		input.ACL = aws.String(v.(string))
+	} else if v, ok := d.GetOk("access_control_policy"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		input.AccessControlPolicy = expandBucketACLAccessControlPolicy(v.([]interface{})[0].(map[string]interface{}))
+	}
+
+	_, err := retryWhenBucketNotFound(func() (interface{}, error) {
+		return conn.PutBucketAcl(input)
+	})
+
+	return err
+}
+
+func expandBucketACLAccessControlPolicy(tfMap map[string]interface{}) *s3.AccessControlPolicy {
+	apiObject := &s3.AccessControlPolicy{}
+
+	if v, ok := tfMap["grant"].(*schema.Set); ok && v.Len() > 0 {
+		apiObject.Grants = expandBucketACLGrants(v.List())
+	}
+
+	if v, ok := tfMap["owner"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		tfMap := v[0].(map[string]interface{})
+
+		apiObject.Owner = &s3.Owner{
+			ID: aws.String(tfMap["id"].(string)),
+		}
+
+		if v, ok := tfMap["display_name"].(string); ok && v != "" {
+			apiObject.Owner.DisplayName = aws.String(v)
+		}
+	}
+
+	return apiObject
+}
+
+func expandBucketACLGrants(tfList []interface{}) []*s3.Grant {
+	var apiObjects []*s3.Grant
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		apiObject := &s3.Grant{}
+
+		if v, ok := tfMap["grantee"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+			apiObject.Grantee = expandBucketGrantee(v[0].(map[string]interface{}))
+		}
+
+		if v, ok := tfMap["permission"].(string); ok && v != "" {
+			apiObject.Permission = aws.String(v)
+		}
+
+		apiObjects = append(apiObjects, apiObject)
+	}
+
+	return apiObjects
+}
+
+func flattenBucketACLAccessControlPolicy(apiObject *s3.GetBucketAclOutput) []interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{
+		"grant": flattenBucketACLGrants(apiObject.Grants),
+	}
+
+	if v := apiObject.Owner; v != nil {
+		tfMap["owner"] = []interface{}{
+			map[string]interface{}{
+				"display_name": aws.StringValue(v.DisplayName),
+				"id":           aws.StringValue(v.ID),
+			},
+		}
+	}
+
+	return []interface{}{tfMap}
+}
+
+func flattenBucketACLGrants(apiObjects []*s3.Grant) []interface{} {
+	var tfList []interface{}
+
+	for _, apiObject := range apiObjects {
+		if apiObject == nil {
+			continue
+		}
+
+		tfMap := map[string]interface{}{
+			"grantee":    flattenBucketGrantee(apiObject.Grantee),
+			"permission": aws.StringValue(apiObject.Permission),
+		}
+
+		tfList = append(tfList, tfMap)
+	}
+
+	return tfList
+}
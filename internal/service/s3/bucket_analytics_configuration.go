@@ -13,7 +13,6 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
-	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
 	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 	"github.com/hashicorp/terraform-provider-aws/internal/verify"
 )
@@ -39,26 +38,7 @@ func ResourceBucketAnalyticsConfiguration() *schema.Resource {
 				Required: true,
 				ForceNew: true,
 			},
-			"filter": {
-				Type:     schema.TypeList,
-				Optional: true,
-				MaxItems: 1,
-				Elem: &schema.Resource{
-					Schema: map[string]*schema.Schema{
-						"prefix": {
-							Type:         schema.TypeString,
-							Optional:     true,
-							AtLeastOneOf: filterAtLeastOneOfKeys,
-						},
-						"tags": {
-							Type:         schema.TypeMap,
-							Optional:     true,
-							AtLeastOneOf: filterAtLeastOneOfKeys,
-							Elem:         &schema.Schema{Type: schema.TypeString},
-						},
-					},
-				},
-			},
+			"filter": filterSchema(filterPredicatePrefix, filterPredicateTags),
 			"storage_class_analysis": {
 				Type:     schema.TypeList,
 				Optional: true,
@@ -125,8 +105,6 @@ func ResourceBucketAnalyticsConfiguration() *schema.Resource {
 	}
 }
 
-var filterAtLeastOneOfKeys = []string{"filter.0.prefix", "filter.0.tags"}
-
 func resourceBucketAnalyticsConfigurationPut(d *schema.ResourceData, meta interface{}) error {
 	conn := meta.(*conns.AWSClient).S3Conn
 
@@ -263,37 +241,7 @@ func ExpandAnalyticsFilter(l []interface{}) *s3.AnalyticsFilter {
 		return nil
 	}
 
-	m := l[0].(map[string]interface{})
-
-	var prefix string
-	if v, ok := m["prefix"]; ok {
-		prefix = v.(string)
-	}
-
-	var tags []*s3.Tag
-	if v, ok := m["tags"]; ok {
-		tags = Tags(tftags.New(v).IgnoreAWS())
-	}
-
-	if prefix == "" && len(tags) == 0 {
-		return nil
-	}
-	analyticsFilter := &s3.AnalyticsFilter{}
-	if prefix != "" && len(tags) > 0 {
-		analyticsFilter.And = &s3.AnalyticsAndOperator{
-			Prefix: aws.String(prefix),
-			Tags:   tags,
-		}
-	} else if len(tags) > 1 {
-		analyticsFilter.And = &s3.AnalyticsAndOperator{
-			Tags: tags,
-		}
-	} else if len(tags) == 1 {
-		analyticsFilter.Tag = tags[0]
-	} else {
-		analyticsFilter.Prefix = aws.String(prefix)
-	}
-	return analyticsFilter
+	return analyticsFilterFromS3Filter(expandS3Filter(l[0].(map[string]interface{})))
 }
 
 func ExpandStorageClassAnalysis(l []interface{}) *s3.StorageClassAnalysis {
@@ -353,30 +301,13 @@ func expandS3AnalyticsS3BucketDestination(bdl []interface{}) *s3.AnalyticsS3Buck
 }
 
 func FlattenAnalyticsFilter(analyticsFilter *s3.AnalyticsFilter) []map[string]interface{} {
-	if analyticsFilter == nil {
-		return nil
-	}
+	filter := s3FilterFromAnalyticsFilter(analyticsFilter)
 
-	result := make(map[string]interface{})
-	if analyticsFilter.And != nil {
-		and := *analyticsFilter.And
-		if and.Prefix != nil {
-			result["prefix"] = *and.Prefix
-		}
-		if and.Tags != nil {
-			result["tags"] = KeyValueTags(and.Tags).IgnoreAWS().Map()
-		}
-	} else if analyticsFilter.Prefix != nil {
-		result["prefix"] = *analyticsFilter.Prefix
-	} else if analyticsFilter.Tag != nil {
-		tags := []*s3.Tag{
-			analyticsFilter.Tag,
-		}
-		result["tags"] = KeyValueTags(tags).IgnoreAWS().Map()
-	} else {
+	if filter.isEmpty() {
 		return nil
 	}
-	return []map[string]interface{}{result}
+
+	return []map[string]interface{}{flattenS3Filter(filter)}
 }
 
 func FlattenStorageClassAnalysis(storageClassAnalysis *s3.StorageClassAnalysis) []map[string]interface{} {
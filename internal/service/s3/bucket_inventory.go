@@ -44,19 +44,7 @@ func ResourceBucketInventory() *schema.Resource {
 				Default:  true,
 				Optional: true,
 			},
-			"filter": {
-				Type:     schema.TypeList,
-				Optional: true,
-				MaxItems: 1,
-				Elem: &schema.Resource{
-					Schema: map[string]*schema.Schema{
-						"prefix": {
-							Type:     schema.TypeString,
-							Optional: true,
-						},
-					},
-				},
-			},
+			"filter": filterSchema(filterPredicatePrefix),
 			"destination": {
 				Type:     schema.TypeList,
 				Required: true,
@@ -203,7 +191,7 @@ func resourceBucketInventoryPut(d *schema.ResourceData, meta interface{}) error
 	if v, ok := d.GetOk("filter"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
 		filterList := v.([]interface{})
 		filterMap := filterList[0].(map[string]interface{})
-		inventoryConfiguration.Filter = expandS3InventoryFilter(filterMap)
+		inventoryConfiguration.Filter = inventoryFilterFromS3Filter(expandS3Filter(filterMap))
 	}
 
 	if v, ok := d.GetOk("destination"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
@@ -371,31 +359,12 @@ func resourceBucketInventoryRead(d *schema.ResourceData, meta interface{}) error
 	return nil
 }
 
-func expandS3InventoryFilter(m map[string]interface{}) *s3.InventoryFilter {
-	v, ok := m["prefix"]
-	if !ok {
-		return nil
-	}
-	return &s3.InventoryFilter{
-		Prefix: aws.String(v.(string)),
-	}
-}
-
-func flattenS3InventoryFilter(filter *s3.InventoryFilter) []map[string]interface{} {
-	if filter == nil {
+func flattenS3InventoryFilter(inventoryFilter *s3.InventoryFilter) []map[string]interface{} {
+	if inventoryFilter == nil {
 		return nil
 	}
 
-	result := make([]map[string]interface{}, 0, 1)
-
-	m := make(map[string]interface{})
-	if filter.Prefix != nil {
-		m["prefix"] = aws.StringValue(filter.Prefix)
-	}
-
-	result = append(result, m)
-
-	return result
+	return []map[string]interface{}{flattenS3Filter(s3FilterFromInventoryFilter(inventoryFilter))}
 }
 
 func flattenS3InventorySchedule(schedule *s3.InventorySchedule) []map[string]interface{} {
@@ -0,0 +1,171 @@
+package s3
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+)
+
+func DataSourceBucketInventory() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceBucketInventoryRead,
+
+		Schema: map[string]*schema.Schema{
+			"bucket": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"destination": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"bucket": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"account_id": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+									"bucket_arn": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+									"encryption": {
+										Type:     schema.TypeList,
+										Computed: true,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"sse_kms": {
+													Type:     schema.TypeList,
+													Computed: true,
+													Elem: &schema.Resource{
+														Schema: map[string]*schema.Schema{
+															"key_id": {
+																Type:     schema.TypeString,
+																Computed: true,
+															},
+														},
+													},
+												},
+												"sse_s3": {
+													Type:     schema.TypeList,
+													Computed: true,
+													Elem: &schema.Resource{
+														Schema: map[string]*schema.Schema{},
+													},
+												},
+											},
+										},
+									},
+									"format": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+									"prefix": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			"enabled": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+			"filter": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"prefix": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+			"included_object_versions": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"optional_fields": {
+				Type:     schema.TypeSet,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"schedule": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"frequency": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceBucketInventoryRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).S3Conn
+
+	bucket := d.Get("bucket").(string)
+	name := d.Get("name").(string)
+
+	inventoryConfiguration, err := FindBucketInventoryConfiguration(conn, bucket, name)
+
+	if err != nil {
+		return fmt.Errorf("error reading S3 Bucket Inventory Configuration (%s:%s): %w", bucket, name, err)
+	}
+
+	d.SetId(fmt.Sprintf("%s:%s", bucket, name))
+
+	if inventoryConfiguration.Destination != nil {
+		destination := map[string]interface{}{
+			"bucket": flattenS3InventoryS3BucketDestination(inventoryConfiguration.Destination.S3BucketDestination),
+		}
+
+		if err := d.Set("destination", []map[string]interface{}{destination}); err != nil {
+			return fmt.Errorf("error setting destination: %w", err)
+		}
+	} else {
+		d.Set("destination", nil)
+	}
+
+	d.Set("enabled", inventoryConfiguration.IsEnabled)
+
+	if err := d.Set("filter", flattenS3InventoryFilter(inventoryConfiguration.Filter)); err != nil {
+		return fmt.Errorf("error setting filter: %w", err)
+	}
+
+	d.Set("included_object_versions", inventoryConfiguration.IncludedObjectVersions)
+
+	if err := d.Set("optional_fields", flex.FlattenStringSet(inventoryConfiguration.OptionalFields)); err != nil {
+		return fmt.Errorf("error setting optional_fields: %w", err)
+	}
+
+	if inventoryConfiguration.Schedule != nil {
+		if err := d.Set("schedule", flattenS3InventorySchedule(inventoryConfiguration.Schedule)); err != nil {
+			return fmt.Errorf("error setting schedule: %w", err)
+		}
+	} else {
+		d.Set("schedule", nil)
+	}
+
+	return nil
+}
@@ -0,0 +1,51 @@
+package s3_test
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/s3"
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+)
+
+func TestAccS3BucketInventoryDataSource_basic(t *testing.T) {
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	dataSourceName := "data.aws_s3_bucket_inventory.test"
+	resourceName := "aws_s3_bucket_inventory.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:   func() { acctest.PreCheck(t) },
+		ErrorCheck: acctest.ErrorCheck(t, s3.EndpointsID),
+		Providers:  acctest.Providers,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccBucketInventoryDataSourceConfig(rName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(dataSourceName, "bucket", resourceName, "bucket"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "destination.#", resourceName, "destination.#"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "destination.0.bucket.0.account_id", resourceName, "destination.0.bucket.0.account_id"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "destination.0.bucket.0.bucket_arn", resourceName, "destination.0.bucket.0.bucket_arn"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "destination.0.bucket.0.format", resourceName, "destination.0.bucket.0.format"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "destination.0.bucket.0.prefix", resourceName, "destination.0.bucket.0.prefix"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "enabled", resourceName, "enabled"),
+					resource.TestCheckResourceAttr(dataSourceName, "filter.#", "1"),
+					resource.TestCheckResourceAttr(dataSourceName, "filter.0.prefix", "documents/"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "included_object_versions", resourceName, "included_object_versions"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "name", resourceName, "name"),
+					resource.TestCheckResourceAttr(dataSourceName, "optional_fields.#", "2"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "schedule.0.frequency", resourceName, "schedule.0.frequency"),
+				),
+			},
+		},
+	})
+}
+
+func testAccBucketInventoryDataSourceConfig(rName string) string {
+	return acctest.ConfigCompose(testAccBucketInventoryConfig(rName, rName), `
+data "aws_s3_bucket_inventory" "test" {
+  bucket = aws_s3_bucket_inventory.test.bucket
+  name   = aws_s3_bucket_inventory.test.name
+}
+`)
+}
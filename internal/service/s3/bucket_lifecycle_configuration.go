@@ -0,0 +1,842 @@
+package s3
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/s3"
+	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func ResourceBucketLifecycleConfiguration() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceBucketLifecycleConfigurationCreate,
+		Read:   resourceBucketLifecycleConfigurationRead,
+		Update: resourceBucketLifecycleConfigurationUpdate,
+		Delete: resourceBucketLifecycleConfigurationDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"bucket": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(1, 63),
+			},
+			"rule": {
+				Type:     schema.TypeList,
+				Required: true,
+				MinItems: 1,
+				MaxItems: 1000,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"abort_incomplete_multipart_upload": {
+							Type:     schema.TypeList,
+							Optional: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"days_after_initiation": {
+										Type:         schema.TypeInt,
+										Required:     true,
+										ValidateFunc: validation.IntAtLeast(1),
+									},
+								},
+							},
+						},
+						"expiration": {
+							Type:     schema.TypeList,
+							Optional: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"date": {
+										Type:         schema.TypeString,
+										Optional:     true,
+										ValidateFunc: validBucketLifecycleTimestamp,
+									},
+									"days": {
+										Type:         schema.TypeInt,
+										Optional:     true,
+										ValidateFunc: validation.IntAtLeast(1),
+									},
+									"expired_object_delete_marker": {
+										Type:     schema.TypeBool,
+										Optional: true,
+									},
+								},
+							},
+						},
+						"filter": {
+							Type:     schema.TypeList,
+							Optional: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"and": {
+										Type:     schema.TypeList,
+										Optional: true,
+										MaxItems: 1,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"object_size_greater_than": {
+													Type:         schema.TypeInt,
+													Optional:     true,
+													ValidateFunc: validation.IntAtLeast(0),
+												},
+												"object_size_less_than": {
+													Type:         schema.TypeInt,
+													Optional:     true,
+													ValidateFunc: validation.IntAtLeast(1),
+												},
+												"prefix": {
+													Type:         schema.TypeString,
+													Optional:     true,
+													ValidateFunc: validation.StringLenBetween(0, 1024),
+												},
+												"tags": tftags.TagsSchema(),
+											},
+										},
+									},
+									"object_size_greater_than": {
+										Type:         schema.TypeInt,
+										Optional:     true,
+										ValidateFunc: validation.IntAtLeast(0),
+									},
+									"object_size_less_than": {
+										Type:         schema.TypeInt,
+										Optional:     true,
+										ValidateFunc: validation.IntAtLeast(1),
+									},
+									"prefix": {
+										Type:         schema.TypeString,
+										Optional:     true,
+										ValidateFunc: validation.StringLenBetween(0, 1024),
+									},
+									"tag": {
+										Type:     schema.TypeList,
+										Optional: true,
+										MaxItems: 1,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"key": {
+													Type:     schema.TypeString,
+													Required: true,
+												},
+												"value": {
+													Type:     schema.TypeString,
+													Required: true,
+												},
+											},
+										},
+									},
+								},
+							},
+						},
+						"id": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringLenBetween(1, 255),
+						},
+						"noncurrent_version_expiration": {
+							Type:     schema.TypeList,
+							Optional: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"newer_noncurrent_versions": {
+										Type:         schema.TypeInt,
+										Optional:     true,
+										ValidateFunc: validation.IntAtLeast(1),
+									},
+									"noncurrent_days": {
+										Type:         schema.TypeInt,
+										Optional:     true,
+										ValidateFunc: validation.IntAtLeast(1),
+									},
+								},
+							},
+						},
+						"noncurrent_version_transition": {
+							Type:     schema.TypeSet,
+							Optional: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"newer_noncurrent_versions": {
+										Type:         schema.TypeInt,
+										Optional:     true,
+										ValidateFunc: validation.IntAtLeast(1),
+									},
+									"noncurrent_days": {
+										Type:         schema.TypeInt,
+										Optional:     true,
+										ValidateFunc: validation.IntAtLeast(0),
+									},
+									"storage_class": {
+										Type:         schema.TypeString,
+										Required:     true,
+										ValidateFunc: validation.StringInSlice(s3.TransitionStorageClass_Values(), false),
+									},
+								},
+							},
+						},
+						"prefix": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							Deprecated:   "Use filter instead",
+							ValidateFunc: validation.StringLenBetween(0, 1024),
+						},
+						"status": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringInSlice(s3.ExpirationStatus_Values(), false),
+						},
+						"transition": {
+							Type:     schema.TypeSet,
+							Optional: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"date": {
+										Type:         schema.TypeString,
+										Optional:     true,
+										ValidateFunc: validBucketLifecycleTimestamp,
+									},
+									"days": {
+										Type:         schema.TypeInt,
+										Optional:     true,
+										ValidateFunc: validation.IntAtLeast(0),
+									},
+									"storage_class": {
+										Type:         schema.TypeString,
+										Required:     true,
+										ValidateFunc: validation.StringInSlice(s3.TransitionStorageClass_Values(), false),
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+
+		CustomizeDiff: resourceBucketLifecycleConfigurationCustomizeDiff,
+	}
+}
+
+func resourceBucketLifecycleConfigurationCustomizeDiff(_ context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+	for _, tfMapRaw := range diff.Get("rule").([]interface{}) {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		if v, ok := tfMap["filter"].([]interface{}); ok {
+			if err := validLifecycleRuleFilter(v); err != nil {
+				return fmt.Errorf("rule (%s) filter: %w", tfMap["id"], err)
+			}
+		}
+	}
+
+	return nil
+}
+
+func resourceBucketLifecycleConfigurationCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).S3Conn
+
+	bucket := d.Get("bucket").(string)
+
+	if err := putBucketLifecycleConfiguration(conn, bucket, d.Get("rule").([]interface{})); err != nil {
+		return fmt.Errorf("error creating S3 Bucket Lifecycle Configuration (%s): %w", bucket, err)
+	}
+
+	d.SetId(bucket)
+
+	return resourceBucketLifecycleConfigurationRead(d, meta)
+}
+
+func resourceBucketLifecycleConfigurationRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).S3Conn
+
+	outputRaw, err := tfresource.RetryWhenNewResourceNotFound(propagationTimeout, func() (interface{}, error) {
+		return FindBucketLifecycleConfiguration(conn, d.Id())
+	}, d.IsNewResource())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] S3 Bucket Lifecycle Configuration (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error reading S3 Bucket Lifecycle Configuration (%s): %w", d.Id(), err)
+	}
+
+	rules := outputRaw.([]*s3.LifecycleRule)
+
+	d.Set("bucket", d.Id())
+
+	if err := d.Set("rule", flattenLifecycleRules(rules)); err != nil {
+		return fmt.Errorf("error setting rule: %w", err)
+	}
+
+	return nil
+}
+
+func resourceBucketLifecycleConfigurationUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).S3Conn
+
+	if err := putBucketLifecycleConfiguration(conn, d.Id(), d.Get("rule").([]interface{})); err != nil {
+		return fmt.Errorf("error updating S3 Bucket Lifecycle Configuration (%s): %w", d.Id(), err)
+	}
+
+	return resourceBucketLifecycleConfigurationRead(d, meta)
+}
+
+func resourceBucketLifecycleConfigurationDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).S3Conn
+
+	log.Printf("[DEBUG] Deleting S3 Bucket Lifecycle Configuration: %s", d.Id())
+	_, err := conn.DeleteBucketLifecycle(&s3.DeleteBucketLifecycleInput{
+		Bucket: aws.String(d.Id()),
+	})
+
+	if tfawserr.ErrCodeEquals(err, ErrCodeNoSuchLifecycleConfiguration, s3.ErrCodeNoSuchBucket) {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error deleting S3 Bucket Lifecycle Configuration (%s): %w", d.Id(), err)
+	}
+
+	return nil
+}
+
+func putBucketLifecycleConfiguration(conn *s3.S3, bucket string, rules []interface{}) error {
+	input := &s3.PutBucketLifecycleConfigurationInput{
+		Bucket: aws.String(bucket),
+		LifecycleConfiguration: &s3.BucketLifecycleConfiguration{
+			Rules: expandLifecycleRules(rules),
+		},
+	}
+
+	_, err := retryWhenBucketNotFound(func() (interface{}, error) {
+		return conn.PutBucketLifecycleConfiguration(input)
+	})
+
+	return err
+}
+
+func expandLifecycleRules(tfList []interface{}) []*s3.LifecycleRule {
+	var apiObjects []*s3.LifecycleRule
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		apiObject := expandLifecycleRule(tfMap)
+
+		if apiObject == nil {
+			continue
+		}
+
+		apiObjects = append(apiObjects, apiObject)
+	}
+
+	return apiObjects
+}
+
+func expandLifecycleRule(tfMap map[string]interface{}) *s3.LifecycleRule {
+	if len(tfMap) == 0 {
+		return nil
+	}
+
+	apiObject := &s3.LifecycleRule{}
+
+	if v, ok := tfMap["abort_incomplete_multipart_upload"].([]interface{}); ok && len(v) > 0 {
+		apiObject.AbortIncompleteMultipartUpload = expandAbortIncompleteMultipartUpload(v)
+	}
+
+	if v, ok := tfMap["expiration"].([]interface{}); ok && len(v) > 0 {
+		apiObject.Expiration = expandLifecycleExpiration(v)
+	}
+
+	if v, ok := tfMap["filter"].([]interface{}); ok && len(v) > 0 {
+		apiObject.Filter = expandLifecycleRuleFilter(v)
+	}
+
+	if v, ok := tfMap["id"].(string); ok && v != "" {
+		apiObject.ID = aws.String(v)
+	}
+
+	if v, ok := tfMap["noncurrent_version_expiration"].([]interface{}); ok && len(v) > 0 {
+		apiObject.NoncurrentVersionExpiration = expandNoncurrentVersionExpiration(v)
+	}
+
+	if v, ok := tfMap["noncurrent_version_transition"].(*schema.Set); ok && v.Len() > 0 {
+		apiObject.NoncurrentVersionTransitions = expandNoncurrentVersionTransitions(v.List())
+	}
+
+	if v, ok := tfMap["prefix"].(string); ok && apiObject.Filter == nil {
+		// Either a filter or a prefix must be specified.
+		apiObject.Prefix = aws.String(v)
+	}
+
+	if v, ok := tfMap["status"].(string); ok && v != "" {
+		apiObject.Status = aws.String(v)
+	}
+
+	if v, ok := tfMap["transition"].(*schema.Set); ok && v.Len() > 0 {
+		apiObject.Transitions = expandTransitions(v.List())
+	}
+
+	return apiObject
+}
+
+func expandAbortIncompleteMultipartUpload(tfList []interface{}) *s3.AbortIncompleteMultipartUpload {
+	if len(tfList) == 0 || tfList[0] == nil {
+		return nil
+	}
+
+	tfMap, ok := tfList[0].(map[string]interface{})
+
+	if !ok {
+		return nil
+	}
+
+	apiObject := &s3.AbortIncompleteMultipartUpload{}
+
+	if v, ok := tfMap["days_after_initiation"].(int); ok && v != 0 {
+		apiObject.DaysAfterInitiation = aws.Int64(int64(v))
+	}
+
+	return apiObject
+}
+
+func expandLifecycleExpiration(tfList []interface{}) *s3.LifecycleExpiration {
+	if len(tfList) == 0 || tfList[0] == nil {
+		return nil
+	}
+
+	tfMap, ok := tfList[0].(map[string]interface{})
+
+	if !ok {
+		return nil
+	}
+
+	apiObject := &s3.LifecycleExpiration{}
+
+	if v, ok := tfMap["date"].(string); ok && v != "" {
+		t, _ := time.Parse(time.RFC3339, fmt.Sprintf("%sT00:00:00Z", v))
+		apiObject.Date = aws.Time(t)
+	}
+
+	if v, ok := tfMap["days"].(int); ok && v != 0 {
+		apiObject.Days = aws.Int64(int64(v))
+	}
+
+	if v, ok := tfMap["expired_object_delete_marker"].(bool); ok && v {
+		apiObject.ExpiredObjectDeleteMarker = aws.Bool(v)
+	}
+
+	return apiObject
+}
+
+func expandLifecycleRuleFilter(tfList []interface{}) *s3.LifecycleRuleFilter {
+	apiObject := &s3.LifecycleRuleFilter{}
+
+	// An empty filter block applies the rule to all objects in the bucket.
+	if len(tfList) == 0 || tfList[0] == nil {
+		apiObject.Prefix = aws.String("")
+
+		return apiObject
+	}
+
+	tfMap, ok := tfList[0].(map[string]interface{})
+
+	if !ok {
+		return nil
+	}
+
+	if v, ok := tfMap["and"].([]interface{}); ok && len(v) > 0 {
+		apiObject.And = expandLifecycleRuleAndOperator(v)
+	}
+
+	if v, ok := tfMap["object_size_greater_than"].(int); ok && v != 0 {
+		apiObject.ObjectSizeGreaterThan = aws.Int64(int64(v))
+	}
+
+	if v, ok := tfMap["object_size_less_than"].(int); ok && v != 0 {
+		apiObject.ObjectSizeLessThan = aws.Int64(int64(v))
+	}
+
+	if v, ok := tfMap["tag"].([]interface{}); ok && len(v) > 0 {
+		apiObject.Tag = ExpandTag(v)
+	}
+
+	if v, ok := tfMap["prefix"].(string); ok && apiObject.And == nil && apiObject.Tag == nil && apiObject.ObjectSizeGreaterThan == nil && apiObject.ObjectSizeLessThan == nil {
+		apiObject.Prefix = aws.String(v)
+	}
+
+	return apiObject
+}
+
+func expandLifecycleRuleAndOperator(tfList []interface{}) *s3.LifecycleRuleAndOperator {
+	if len(tfList) == 0 || tfList[0] == nil {
+		return nil
+	}
+
+	tfMap, ok := tfList[0].(map[string]interface{})
+
+	if !ok {
+		return nil
+	}
+
+	apiObject := &s3.LifecycleRuleAndOperator{}
+
+	if v, ok := tfMap["object_size_greater_than"].(int); ok && v != 0 {
+		apiObject.ObjectSizeGreaterThan = aws.Int64(int64(v))
+	}
+
+	if v, ok := tfMap["object_size_less_than"].(int); ok && v != 0 {
+		apiObject.ObjectSizeLessThan = aws.Int64(int64(v))
+	}
+
+	if v, ok := tfMap["prefix"].(string); ok && v != "" {
+		apiObject.Prefix = aws.String(v)
+	}
+
+	if v, ok := tfMap["tags"].(map[string]interface{}); ok && len(v) > 0 {
+		apiObject.Tags = Tags(tftags.New(v).IgnoreAWS())
+	}
+
+	return apiObject
+}
+
+func expandNoncurrentVersionExpiration(tfList []interface{}) *s3.NoncurrentVersionExpiration {
+	if len(tfList) == 0 || tfList[0] == nil {
+		return nil
+	}
+
+	tfMap, ok := tfList[0].(map[string]interface{})
+
+	if !ok {
+		return nil
+	}
+
+	apiObject := &s3.NoncurrentVersionExpiration{}
+
+	if v, ok := tfMap["newer_noncurrent_versions"].(int); ok && v != 0 {
+		apiObject.NewerNoncurrentVersions = aws.Int64(int64(v))
+	}
+
+	if v, ok := tfMap["noncurrent_days"].(int); ok && v != 0 {
+		apiObject.NoncurrentDays = aws.Int64(int64(v))
+	}
+
+	return apiObject
+}
+
+func expandNoncurrentVersionTransitions(tfList []interface{}) []*s3.NoncurrentVersionTransition {
+	var apiObjects []*s3.NoncurrentVersionTransition
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		apiObject := &s3.NoncurrentVersionTransition{}
+
+		if v, ok := tfMap["newer_noncurrent_versions"].(int); ok && v != 0 {
+			apiObject.NewerNoncurrentVersions = aws.Int64(int64(v))
+		}
+
+		if v, ok := tfMap["noncurrent_days"].(int); ok {
+			apiObject.NoncurrentDays = aws.Int64(int64(v))
+		}
+
+		if v, ok := tfMap["storage_class"].(string); ok && v != "" {
+			apiObject.StorageClass = aws.String(v)
+		}
+
+		apiObjects = append(apiObjects, apiObject)
+	}
+
+	return apiObjects
+}
+
+func expandTransitions(tfList []interface{}) []*s3.Transition {
+	var apiObjects []*s3.Transition
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		apiObject := &s3.Transition{}
+
+		if v, ok := tfMap["date"].(string); ok && v != "" {
+			t, _ := time.Parse(time.RFC3339, fmt.Sprintf("%sT00:00:00Z", v))
+			apiObject.Date = aws.Time(t)
+		} else if v, ok := tfMap["days"].(int); ok {
+			// Days may be 0 to transition objects immediately.
+			apiObject.Days = aws.Int64(int64(v))
+		}
+
+		if v, ok := tfMap["storage_class"].(string); ok && v != "" {
+			apiObject.StorageClass = aws.String(v)
+		}
+
+		apiObjects = append(apiObjects, apiObject)
+	}
+
+	return apiObjects
+}
+
+func flattenLifecycleRules(apiObjects []*s3.LifecycleRule) []interface{} {
+	var tfList []interface{}
+
+	for _, apiObject := range apiObjects {
+		if apiObject == nil {
+			continue
+		}
+
+		tfList = append(tfList, flattenLifecycleRule(apiObject))
+	}
+
+	return tfList
+}
+
+func flattenLifecycleRule(apiObject *s3.LifecycleRule) map[string]interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{}
+
+	if v := apiObject.AbortIncompleteMultipartUpload; v != nil {
+		tfMap["abort_incomplete_multipart_upload"] = flattenAbortIncompleteMultipartUpload(v)
+	}
+
+	if v := apiObject.Expiration; v != nil {
+		tfMap["expiration"] = flattenLifecycleExpiration(v)
+	}
+
+	if v := apiObject.Filter; v != nil {
+		tfMap["filter"] = flattenLifecycleRuleFilter(v)
+	}
+
+	if v := apiObject.ID; v != nil {
+		tfMap["id"] = aws.StringValue(v)
+	}
+
+	if v := apiObject.NoncurrentVersionExpiration; v != nil {
+		tfMap["noncurrent_version_expiration"] = flattenNoncurrentVersionExpiration(v)
+	}
+
+	if v := apiObject.NoncurrentVersionTransitions; v != nil {
+		tfMap["noncurrent_version_transition"] = flattenNoncurrentVersionTransitions(v)
+	}
+
+	if v := apiObject.Prefix; v != nil {
+		tfMap["prefix"] = aws.StringValue(v)
+	}
+
+	if v := apiObject.Status; v != nil {
+		tfMap["status"] = aws.StringValue(v)
+	}
+
+	if v := apiObject.Transitions; v != nil {
+		tfMap["transition"] = flattenTransitions(v)
+	}
+
+	return tfMap
+}
+
+func flattenAbortIncompleteMultipartUpload(apiObject *s3.AbortIncompleteMultipartUpload) []interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{}
+
+	if v := apiObject.DaysAfterInitiation; v != nil {
+		tfMap["days_after_initiation"] = aws.Int64Value(v)
+	}
+
+	return []interface{}{tfMap}
+}
+
+func flattenLifecycleExpiration(apiObject *s3.LifecycleExpiration) []interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{}
+
+	if v := apiObject.Date; v != nil {
+		tfMap["date"] = aws.TimeValue(v).Format("2006-01-02")
+	}
+
+	if v := apiObject.Days; v != nil {
+		tfMap["days"] = aws.Int64Value(v)
+	}
+
+	if v := apiObject.ExpiredObjectDeleteMarker; v != nil {
+		tfMap["expired_object_delete_marker"] = aws.BoolValue(v)
+	}
+
+	return []interface{}{tfMap}
+}
+
+func flattenLifecycleRuleFilter(apiObject *s3.LifecycleRuleFilter) []interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{}
+
+	if v := apiObject.And; v != nil {
+		tfMap["and"] = flattenLifecycleRuleAndOperator(v)
+	}
+
+	if v := apiObject.ObjectSizeGreaterThan; v != nil {
+		tfMap["object_size_greater_than"] = aws.Int64Value(v)
+	}
+
+	if v := apiObject.ObjectSizeLessThan; v != nil {
+		tfMap["object_size_less_than"] = aws.Int64Value(v)
+	}
+
+	if v := apiObject.Prefix; v != nil {
+		tfMap["prefix"] = aws.StringValue(v)
+	}
+
+	if v := apiObject.Tag; v != nil {
+		tfMap["tag"] = []interface{}{
+			map[string]interface{}{
+				"key":   aws.StringValue(v.Key),
+				"value": aws.StringValue(v.Value),
+			},
+		}
+	}
+
+	return []interface{}{tfMap}
+}
+
+func flattenLifecycleRuleAndOperator(apiObject *s3.LifecycleRuleAndOperator) []interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{}
+
+	if v := apiObject.ObjectSizeGreaterThan; v != nil {
+		tfMap["object_size_greater_than"] = aws.Int64Value(v)
+	}
+
+	if v := apiObject.ObjectSizeLessThan; v != nil {
+		tfMap["object_size_less_than"] = aws.Int64Value(v)
+	}
+
+	if v := apiObject.Prefix; v != nil {
+		tfMap["prefix"] = aws.StringValue(v)
+	}
+
+	if v := apiObject.Tags; v != nil {
+		tfMap["tags"] = KeyValueTags(v).IgnoreAWS().Map()
+	}
+
+	return []interface{}{tfMap}
+}
+
+func flattenNoncurrentVersionExpiration(apiObject *s3.NoncurrentVersionExpiration) []interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{}
+
+	if v := apiObject.NewerNoncurrentVersions; v != nil {
+		tfMap["newer_noncurrent_versions"] = aws.Int64Value(v)
+	}
+
+	if v := apiObject.NoncurrentDays; v != nil {
+		tfMap["noncurrent_days"] = aws.Int64Value(v)
+	}
+
+	return []interface{}{tfMap}
+}
+
+func flattenNoncurrentVersionTransitions(apiObjects []*s3.NoncurrentVersionTransition) []interface{} {
+	var tfList []interface{}
+
+	for _, apiObject := range apiObjects {
+		if apiObject == nil {
+			continue
+		}
+
+		tfMap := map[string]interface{}{}
+
+		if v := apiObject.NewerNoncurrentVersions; v != nil {
+			tfMap["newer_noncurrent_versions"] = aws.Int64Value(v)
+		}
+
+		if v := apiObject.NoncurrentDays; v != nil {
+			tfMap["noncurrent_days"] = aws.Int64Value(v)
+		}
+
+		if v := apiObject.StorageClass; v != nil {
+			tfMap["storage_class"] = aws.StringValue(v)
+		}
+
+		tfList = append(tfList, tfMap)
+	}
+
+	return tfList
+}
+
+func flattenTransitions(apiObjects []*s3.Transition) []interface{} {
+	var tfList []interface{}
+
+	for _, apiObject := range apiObjects {
+		if apiObject == nil {
+			continue
+		}
+
+		tfMap := map[string]interface{}{}
+
+		if v := apiObject.Date; v != nil {
+			tfMap["date"] = aws.TimeValue(v).Format("2006-01-02")
+		}
+
+		if v := apiObject.Days; v != nil {
+			tfMap["days"] = aws.Int64Value(v)
+		}
+
+		if v := apiObject.StorageClass; v != nil {
+			tfMap["storage_class"] = aws.StringValue(v)
+		}
+
+		tfList = append(tfList, tfMap)
+	}
+
+	return tfList
+}
@@ -11,7 +11,6 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
-	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
 	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 )
 
@@ -31,26 +30,7 @@ func ResourceBucketMetric() *schema.Resource {
 				Required: true,
 				ForceNew: true,
 			},
-			"filter": {
-				Type:     schema.TypeList,
-				Optional: true,
-				MaxItems: 1,
-				Elem: &schema.Resource{
-					Schema: map[string]*schema.Schema{
-						"prefix": {
-							Type:         schema.TypeString,
-							Optional:     true,
-							AtLeastOneOf: filterAtLeastOneOfKeys,
-						},
-						"tags": {
-							Type:         schema.TypeMap,
-							Optional:     true,
-							Elem:         &schema.Schema{Type: schema.TypeString},
-							AtLeastOneOf: filterAtLeastOneOfKeys,
-						},
-					},
-				},
-			},
+			"filter": filterSchema(filterPredicateAccessPoint, filterPredicatePrefix, filterPredicateTags),
 			"name": {
 				Type:     schema.TypeString,
 				Required: true,
@@ -190,54 +170,11 @@ func resourceBucketMetricRead(d *schema.ResourceData, meta interface{}) error {
 }
 
 func ExpandMetricsFilter(m map[string]interface{}) *s3.MetricsFilter {
-	var prefix string
-	if v, ok := m["prefix"]; ok {
-		prefix = v.(string)
-	}
-
-	var tags []*s3.Tag
-	if v, ok := m["tags"]; ok {
-		tags = Tags(tftags.New(v).IgnoreAWS())
-	}
-
-	metricsFilter := &s3.MetricsFilter{}
-	if prefix != "" && len(tags) > 0 {
-		metricsFilter.And = &s3.MetricsAndOperator{
-			Prefix: aws.String(prefix),
-			Tags:   tags,
-		}
-	} else if len(tags) > 1 {
-		metricsFilter.And = &s3.MetricsAndOperator{
-			Tags: tags,
-		}
-	} else if len(tags) == 1 {
-		metricsFilter.Tag = tags[0]
-	} else {
-		metricsFilter.Prefix = aws.String(prefix)
-	}
-	return metricsFilter
+	return metricsFilterFromS3Filter(expandS3Filter(m))
 }
 
 func FlattenMetricsFilter(metricsFilter *s3.MetricsFilter) map[string]interface{} {
-	m := make(map[string]interface{})
-
-	if metricsFilter.And != nil {
-		and := *metricsFilter.And
-		if and.Prefix != nil {
-			m["prefix"] = *and.Prefix
-		}
-		if and.Tags != nil {
-			m["tags"] = KeyValueTags(and.Tags).IgnoreAWS().Map()
-		}
-	} else if metricsFilter.Prefix != nil {
-		m["prefix"] = *metricsFilter.Prefix
-	} else if metricsFilter.Tag != nil {
-		tags := []*s3.Tag{
-			metricsFilter.Tag,
-		}
-		m["tags"] = KeyValueTags(tags).IgnoreAWS().Map()
-	}
-	return m
+	return flattenS3Filter(s3FilterFromMetricsFilter(metricsFilter))
 }
 
 func BucketMetricParseID(id string) (string, string, error) {
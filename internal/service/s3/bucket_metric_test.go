@@ -111,6 +111,35 @@ func TestExpandS3MetricsFilter(t *testing.T) {
 				},
 			},
 		},
+		{
+			Config: map[string]interface{}{
+				"access_point": "arn:aws:s3:us-east-1:123456789012:accesspoint/test", //lintignore:AWSAT003,AWSAT005
+			},
+			ExpectedS3MetricsFilter: &s3.MetricsFilter{
+				AccessPointArn: aws.String("arn:aws:s3:us-east-1:123456789012:accesspoint/test"), //lintignore:AWSAT003,AWSAT005
+			},
+		},
+		{
+			Config: map[string]interface{}{
+				"access_point": "arn:aws:s3:us-east-1:123456789012:accesspoint/test", //lintignore:AWSAT003,AWSAT005
+				"prefix":       "prefix/",
+				"tags": map[string]interface{}{
+					"tag1key": "tag1value",
+				},
+			},
+			ExpectedS3MetricsFilter: &s3.MetricsFilter{
+				And: &s3.MetricsAndOperator{
+					AccessPointArn: aws.String("arn:aws:s3:us-east-1:123456789012:accesspoint/test"), //lintignore:AWSAT003,AWSAT005
+					Prefix:         aws.String("prefix/"),
+					Tags: []*s3.Tag{
+						{
+							Key:   aws.String("tag1key"),
+							Value: aws.String("tag1value"),
+						},
+					},
+				},
+			},
+		},
 	}
 
 	for i, tc := range testCases {
@@ -224,6 +253,26 @@ func TestFlattenS3MetricsFilter(t *testing.T) {
 				},
 			},
 		},
+		{
+			S3MetricsFilter: &s3.MetricsFilter{
+				AccessPointArn: aws.String("arn:aws:s3:us-east-1:123456789012:accesspoint/test"), //lintignore:AWSAT003,AWSAT005
+			},
+			ExpectedConfig: map[string]interface{}{
+				"access_point": "arn:aws:s3:us-east-1:123456789012:accesspoint/test", //lintignore:AWSAT003,AWSAT005
+			},
+		},
+		{
+			S3MetricsFilter: &s3.MetricsFilter{
+				And: &s3.MetricsAndOperator{
+					AccessPointArn: aws.String("arn:aws:s3:us-east-1:123456789012:accesspoint/test"), //lintignore:AWSAT003,AWSAT005
+					Prefix:         aws.String("prefix/"),
+				},
+			},
+			ExpectedConfig: map[string]interface{}{
+				"access_point": "arn:aws:s3:us-east-1:123456789012:accesspoint/test", //lintignore:AWSAT003,AWSAT005
+				"prefix":       "prefix/",
+			},
+		},
 	}
 
 	for i, tc := range testCases {
@@ -367,6 +416,48 @@ func TestAccS3BucketMetric_withFilterPrefix(t *testing.T) {
 	})
 }
 
+func TestAccS3BucketMetric_withFilterAccessPoint(t *testing.T) {
+	var conf s3.MetricsConfiguration
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_s3_bucket_metric.test"
+	accessPointResourceName := "aws_s3_access_point.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, s3.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckBucketMetricDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccBucketMetricsWithFilterAccessPointConfig(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckBucketMetricsExistsConfig(resourceName, &conf),
+					resource.TestCheckResourceAttr(resourceName, "filter.#", "1"),
+					resource.TestCheckResourceAttrPair(resourceName, "filter.0.access_point", accessPointResourceName, "arn"),
+					resource.TestCheckResourceAttr(resourceName, "filter.0.prefix", ""),
+					resource.TestCheckResourceAttr(resourceName, "filter.0.tags.%", "0"),
+				),
+			},
+			{
+				Config: testAccBucketMetricsWithFilterAccessPointAndTagsConfig(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckBucketMetricsExistsConfig(resourceName, &conf),
+					resource.TestCheckResourceAttr(resourceName, "filter.#", "1"),
+					resource.TestCheckResourceAttrPair(resourceName, "filter.0.access_point", accessPointResourceName, "arn"),
+					resource.TestCheckResourceAttr(resourceName, "filter.0.prefix", "prefix/"),
+					resource.TestCheckResourceAttr(resourceName, "filter.0.tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "filter.0.tags.tag1", "value1"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
 func TestAccS3BucketMetric_withFilterPrefixAndMultipleTags(t *testing.T) {
 	var conf s3.MetricsConfiguration
 	rInt := sdkacctest.RandInt()
@@ -669,6 +760,50 @@ resource "aws_s3_bucket_metric" "test" {
 `, testAccBucketMetricsBucketConfig(bucketName), metricName, prefix)
 }
 
+func testAccBucketMetricsWithFilterAccessPointBaseConfig(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_s3_bucket" "bucket" {
+  bucket = %[1]q
+}
+
+resource "aws_s3_access_point" "test" {
+  bucket = aws_s3_bucket.bucket.id
+  name   = %[1]q
+}
+`, rName)
+}
+
+func testAccBucketMetricsWithFilterAccessPointConfig(rName string) string {
+	return acctest.ConfigCompose(testAccBucketMetricsWithFilterAccessPointBaseConfig(rName), fmt.Sprintf(`
+resource "aws_s3_bucket_metric" "test" {
+  bucket = aws_s3_bucket.bucket.id
+  name   = %[1]q
+
+  filter {
+    access_point = aws_s3_access_point.test.arn
+  }
+}
+`, rName))
+}
+
+func testAccBucketMetricsWithFilterAccessPointAndTagsConfig(rName string) string {
+	return acctest.ConfigCompose(testAccBucketMetricsWithFilterAccessPointBaseConfig(rName), fmt.Sprintf(`
+resource "aws_s3_bucket_metric" "test" {
+  bucket = aws_s3_bucket.bucket.id
+  name   = %[1]q
+
+  filter {
+    access_point = aws_s3_access_point.test.arn
+    prefix       = "prefix/"
+
+    tags = {
+      "tag1" = "value1"
+    }
+  }
+}
+`, rName))
+}
+
 func testAccBucketMetricsWithFilterPrefixAndMultipleTagsConfig(bucketName, metricName, prefix, tag1, tag2 string) string {
 	return fmt.Sprintf(`
 %s
@@ -99,8 +99,8 @@ func ResourceBucketObject() *schema.Resource {
 			},
 			"etag": {
 				Type: schema.TypeString,
-				// This will conflict with SSE-C and SSE-KMS encryption and multi-part upload
-				// if/when it's actually implemented. The Etag then won't match raw-file MD5.
+				// This will conflict with SSE-C and SSE-KMS encryption, as the Etag then won't match raw-file MD5.
+				// Multipart uploads are handled in resourceBucketObjectCustomizeDiff.
 				// See http://docs.aws.amazon.com/AmazonS3/latest/API/RESTCommonResponseHeaders.html
 				Optional:      true,
 				Computed:      true,
@@ -136,6 +136,21 @@ func ResourceBucketObject() *schema.Resource {
 				Optional:     true,
 				Elem:         &schema.Schema{Type: schema.TypeString},
 			},
+			"multipart_concurrency": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ValidateFunc: validation.IntBetween(1, 64),
+			},
+			"multipart_part_size": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ValidateFunc: validation.IntBetween(objectMinPartSize, objectMaxSinglePartSize),
+			},
+			"multipart_threshold": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ValidateFunc: validation.IntBetween(objectMinPartSize, objectMaxSinglePartSize),
+			},
 			"object_lock_legal_hold_status": {
 				Type:         schema.TypeString,
 				Optional:     true,
@@ -420,7 +435,6 @@ func resourceBucketObjectImport(d *schema.ResourceData, meta interface{}) ([]*sc
 
 func resourceBucketObjectUpload(d *schema.ResourceData, meta interface{}) error {
 	conn := meta.(*conns.AWSClient).S3Conn
-	uploader := s3manager.NewUploaderWithClient(conn)
 	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
 	tags := defaultTagsConfig.MergeTags(tftags.New(d.Get("tags").(map[string]interface{})))
 
@@ -465,7 +479,6 @@ func resourceBucketObjectUpload(d *schema.ResourceData, meta interface{}) error
 
 	input := &s3manager.UploadInput{
 		ACL:    aws.String(d.Get("acl").(string)),
-		Body:   body,
 		Bucket: aws.String(bucket),
 		Key:    aws.String(key),
 	}
@@ -532,7 +545,7 @@ func resourceBucketObjectUpload(d *schema.ResourceData, meta interface{}) error
 		input.ObjectLockRetainUntilDate = expandS3ObjectDate(v.(string))
 	}
 
-	if _, err := uploader.Upload(input); err != nil {
+	if _, err := uploadObject(conn, input, body, expandObjectUploadOptions(d)); err != nil {
 		return fmt.Errorf("Error uploading object to S3 bucket (%s): %s", bucket, err)
 	}
 
@@ -573,6 +586,12 @@ func validateMetadataIsLowerCase(v interface{}, k string) (ws []string, errors [
 }
 
 func resourceBucketObjectCustomizeDiff(_ context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	if d.HasChange("etag") {
+		if err := resourceBucketObjectMultipartETagDiff(d); err != nil {
+			return err
+		}
+	}
+
 	if hasS3BucketObjectContentChanges(d) {
 		return d.SetNewComputed("version_id")
 	}
@@ -585,6 +604,59 @@ func resourceBucketObjectCustomizeDiff(_ context.Context, d *schema.ResourceDiff
 	return nil
 }
 
+// resourceBucketObjectMultipartETagDiff suppresses the etag difference between
+// an MD5 in configuration (e.g. filemd5(source)) and the multipart ETag S3
+// returned for the same content. The multipart ETag is recomputed locally from
+// source using the configured part size. Whether source is uploaded in multiple
+// parts is decided from its size, so smaller files are not read during planning.
+func resourceBucketObjectMultipartETagDiff(d *schema.ResourceDiff) error {
+	o, n := d.GetChange("etag")
+	oldETag, newETag := o.(string), n.(string)
+
+	if !isMultipartObjectETag(oldETag) || newETag == "" || isMultipartObjectETag(newETag) {
+		return nil
+	}
+
+	if !d.NewValueKnown("source") {
+		return nil
+	}
+
+	source, ok := d.GetOk("source")
+
+	if !ok {
+		return nil
+	}
+
+	size, err := sourceObjectSize(source.(string))
+
+	if err != nil {
+		// Leave the diff in place; any problem reading source is reported during upload.
+		log.Printf("[WARN] Unable to determine size of S3 bucket object source (%s): %s", source, err)
+		return nil
+	}
+
+	opts := expandObjectUploadOptions(d)
+
+	// Only a source uploaded in multiple parts needs to be read to compute its ETag.
+	if !opts.multipart(size) {
+		return nil
+	}
+
+	sum, etag, err := computeSourceObjectETag(source.(string), opts)
+
+	if err != nil {
+		// Leave the diff in place; any problem reading source is reported during upload.
+		log.Printf("[WARN] Unable to compute ETag of S3 bucket object source (%s): %s", source, err)
+		return nil
+	}
+
+	if sum == newETag && etag == oldETag {
+		return d.Clear("etag")
+	}
+
+	return nil
+}
+
 func hasS3BucketObjectContentChanges(d verify.ResourceDiffer) bool {
 	for _, key := range []string{
 		"bucket_key_enabled",
@@ -611,174 +683,6 @@ func hasS3BucketObjectContentChanges(d verify.ResourceDiffer) bool {
 	return false
 }
 
-// DeleteAllObjectVersions deletes all versions of a specified key from an S3 bucket.
-// If key is empty then all versions of all objects are deleted.
-// Set force to true to override any S3 object lock protections on object lock enabled buckets.
-func DeleteAllObjectVersions(conn *s3.S3, bucketName, key string, force, ignoreObjectErrors bool) error {
-	input := &s3.ListObjectVersionsInput{
-		Bucket: aws.String(bucketName),
-	}
-	if key != "" {
-		input.Prefix = aws.String(key)
-	}
-
-	var lastErr error
-	err := conn.ListObjectVersionsPages(input, func(page *s3.ListObjectVersionsOutput, lastPage bool) bool {
-		if page == nil {
-			return !lastPage
-		}
-
-		for _, objectVersion := range page.Versions {
-			objectKey := aws.StringValue(objectVersion.Key)
-			objectVersionID := aws.StringValue(objectVersion.VersionId)
-
-			if key != "" && key != objectKey {
-				continue
-			}
-
-			err := deleteS3ObjectVersion(conn, bucketName, objectKey, objectVersionID, force)
-			if tfawserr.ErrMessageContains(err, "AccessDenied", "") && force {
-				// Remove any legal hold.
-				resp, err := conn.HeadObject(&s3.HeadObjectInput{
-					Bucket:    aws.String(bucketName),
-					Key:       objectVersion.Key,
-					VersionId: objectVersion.VersionId,
-				})
-
-				if err != nil {
-					log.Printf("[ERROR] Error getting S3 Bucket (%s) Object (%s) Version (%s) metadata: %s", bucketName, objectKey, objectVersionID, err)
-					lastErr = err
-					continue
-				}
-
-				if aws.StringValue(resp.ObjectLockLegalHoldStatus) == s3.ObjectLockLegalHoldStatusOn {
-					_, err := conn.PutObjectLegalHold(&s3.PutObjectLegalHoldInput{
-						Bucket:    aws.String(bucketName),
-						Key:       objectVersion.Key,
-						VersionId: objectVersion.VersionId,
-						LegalHold: &s3.ObjectLockLegalHold{
-							Status: aws.String(s3.ObjectLockLegalHoldStatusOff),
-						},
-					})
-
-					if err != nil {
-						log.Printf("[ERROR] Error putting S3 Bucket (%s) Object (%s) Version(%s) legal hold: %s", bucketName, objectKey, objectVersionID, err)
-						lastErr = err
-						continue
-					}
-
-					// Attempt to delete again.
-					err = deleteS3ObjectVersion(conn, bucketName, objectKey, objectVersionID, force)
-
-					if err != nil {
-						lastErr = err
-					}
-
-					continue
-				}
-
-				// AccessDenied for another reason.
-				lastErr = fmt.Errorf("AccessDenied deleting S3 Bucket (%s) Object (%s) Version: %s", bucketName, objectKey, objectVersionID)
-				continue
-			}
-
-			if err != nil {
-				lastErr = err
-			}
-		}
-
-		return !lastPage
-	})
-
-	if tfawserr.ErrMessageContains(err, s3.ErrCodeNoSuchBucket, "") {
-		err = nil
-	}
-
-	if err != nil {
-		return err
-	}
-
-	if lastErr != nil {
-		if !ignoreObjectErrors {
-			return fmt.Errorf("error deleting at least one object version, last error: %s", lastErr)
-		}
-
-		lastErr = nil
-	}
-
-	err = conn.ListObjectVersionsPages(input, func(page *s3.ListObjectVersionsOutput, lastPage bool) bool {
-		if page == nil {
-			return !lastPage
-		}
-
-		for _, deleteMarker := range page.DeleteMarkers {
-			deleteMarkerKey := aws.StringValue(deleteMarker.Key)
-			deleteMarkerVersionID := aws.StringValue(deleteMarker.VersionId)
-
-			if key != "" && key != deleteMarkerKey {
-				continue
-			}
-
-			// Delete markers have no object lock protections.
-			err := deleteS3ObjectVersion(conn, bucketName, deleteMarkerKey, deleteMarkerVersionID, false)
-
-			if err != nil {
-				lastErr = err
-			}
-		}
-
-		return !lastPage
-	})
-
-	if tfawserr.ErrMessageContains(err, s3.ErrCodeNoSuchBucket, "") {
-		err = nil
-	}
-
-	if err != nil {
-		return err
-	}
-
-	if lastErr != nil {
-		if !ignoreObjectErrors {
-			return fmt.Errorf("error deleting at least one object delete marker, last error: %s", lastErr)
-		}
-
-		lastErr = nil
-	}
-
-	return nil
-}
-
-// deleteS3ObjectVersion deletes a specific bucket object version.
-// Set force to true to override any S3 object lock protections.
-func deleteS3ObjectVersion(conn *s3.S3, b, k, v string, force bool) error {
-	input := &s3.DeleteObjectInput{
-		Bucket: aws.String(b),
-		Key:    aws.String(k),
-	}
-
-	if v != "" {
-		input.VersionId = aws.String(v)
-	}
-
-	if force {
-		input.BypassGovernanceRetention = aws.Bool(true)
-	}
-
-	log.Printf("[INFO] Deleting S3 Bucket (%s) Object (%s) Version: %s", b, k, v)
-	_, err := conn.DeleteObject(input)
-
-	if err != nil {
-		log.Printf("[WARN] Error deleting S3 Bucket (%s) Object (%s) Version (%s): %s", b, k, v, err)
-	}
-
-	if tfawserr.ErrMessageContains(err, s3.ErrCodeNoSuchBucket, "") || tfawserr.ErrMessageContains(err, s3.ErrCodeNoSuchKey, "") {
-		return nil
-	}
-
-	return err
-}
-
 func expandS3ObjectDate(v string) *time.Time {
 	t, err := time.Parse(time.RFC3339, v)
 	if err != nil {
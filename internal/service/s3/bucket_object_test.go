@@ -8,6 +8,7 @@ import (
 	"reflect"
 	"regexp"
 	"sort"
+	"strings"
 	"testing"
 	"time"
 
@@ -111,6 +112,41 @@ func TestAccS3BucketObject_source(t *testing.T) {
 	})
 }
 
+func TestAccS3BucketObject_multipartSource(t *testing.T) {
+	var obj s3.GetObjectOutput
+	resourceName := "aws_s3_bucket_object.object"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	// 11 MiB is uploaded as three 5 MiB parts.
+	source := testAccBucketObjectCreateTempFile(t, strings.Repeat("x", 11*1024*1024))
+	defer os.Remove(source)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, s3.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckBucketObjectDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccBucketObjectMultipartSourceConfig(rName, source),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckBucketObjectExists(resourceName, &obj),
+					resource.TestMatchResourceAttr(resourceName, "etag", regexp.MustCompile(`^[0-9a-f]{32}-3$`)),
+					resource.TestCheckResourceAttr(resourceName, "multipart_part_size", "5242880"),
+					resource.TestCheckResourceAttr(resourceName, "multipart_threshold", "5242880"),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"acl", "source", "force_destroy", "multipart_part_size", "multipart_threshold"},
+				ImportStateId:           fmt.Sprintf("s3://%s/test-key", rName),
+			},
+		},
+	})
+}
+
 func TestAccS3BucketObject_content(t *testing.T) {
 	var obj s3.GetObjectOutput
 	resourceName := "aws_s3_bucket_object.object"
@@ -1597,6 +1633,23 @@ resource "aws_s3_bucket_object" "object" {
 `, rName, source)
 }
 
+func testAccBucketObjectMultipartSourceConfig(rName string, source string) string {
+	return fmt.Sprintf(`
+resource "aws_s3_bucket" "test" {
+  bucket = %[1]q
+}
+
+resource "aws_s3_bucket_object" "object" {
+  bucket              = aws_s3_bucket.test.bucket
+  key                 = "test-key"
+  source              = %[2]q
+  etag                = filemd5(%[2]q)
+  multipart_part_size = 5242880
+  multipart_threshold = 5242880
+}
+`, rName, source)
+}
+
 func testAccBucketObjectConfig_withContentCharacteristics(rName string, source string) string {
 	return fmt.Sprintf(`
 resource "aws_s3_bucket" "test" {
@@ -1,15 +1,27 @@
 package s3
 
 import (
+	"bytes"
 	"fmt"
+	"io"
+	"log"
+	"net/url"
+	"strings"
+	"time"
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/s3"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 )
 
-const keyRequestPageSize = 1000
+const (
+	keyRequestPageSize = 1000
+
+	// Only objects up to this size are read when include_body is set, unless max_body_size is configured.
+	objectsBodyMaxSizeDefault = 64 * 1024
+)
 
 func DataSourceBucketObjects() *schema.Resource {
 	return &schema.Resource{
@@ -45,6 +57,16 @@ func DataSourceBucketObjects() *schema.Resource {
 				Type:     schema.TypeBool,
 				Optional: true,
 			},
+			"include_body": {
+				Type:     schema.TypeBool,
+				Optional: true,
+			},
+			"max_body_size": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      objectsBodyMaxSizeDefault,
+				ValidateFunc: validation.IntAtLeast(0),
+			},
 			"keys": {
 				Type:     schema.TypeList,
 				Computed: true,
@@ -55,6 +77,46 @@ func DataSourceBucketObjects() *schema.Resource {
 				Computed: true,
 				Elem:     &schema.Schema{Type: schema.TypeString},
 			},
+			"objects": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"body": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"body_skipped": {
+							Type:     schema.TypeBool,
+							Computed: true,
+						},
+						"etag": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"key": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"last_modified": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"owner": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"size": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"storage_class": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
 			"owners": {
 				Type:     schema.TypeList,
 				Computed: true,
@@ -102,34 +164,84 @@ func dataSourceBucketObjectsRead(d *schema.ResourceData, meta interface{}) error
 		listInput.FetchOwner = aws.Bool(b.(bool))
 	}
 
+	includeBody := d.Get("include_body").(bool)
+	maxBodySize := int64(d.Get("max_body_size").(int))
+	urlEncoded := d.Get("encoding_type").(string) == s3.EncodingTypeUrl
+
 	var commonPrefixes []string
 	var keys []string
+	var objects []interface{}
 	var owners []string
 
-	err := conn.ListObjectsV2Pages(&listInput, func(page *s3.ListObjectsV2Output, lastPage bool) bool {
+	// ListObjectsV2Pages copies its input, so page through the results by hand
+	// to be able to shrink the final page and stop once maxKeys is reached.
+	for maxKeys > 0 {
+		page, err := conn.ListObjectsV2(&listInput)
+
+		if err != nil {
+			return fmt.Errorf("error listing S3 Bucket (%s) Objects: %w", bucket, err)
+		}
+
 		for _, commonPrefix := range page.CommonPrefixes {
 			commonPrefixes = append(commonPrefixes, aws.StringValue(commonPrefix.Prefix))
 		}
 
 		for _, object := range page.Contents {
-			keys = append(keys, aws.StringValue(object.Key))
+			key := aws.StringValue(object.Key)
+			keys = append(keys, key)
+
+			tfMap := map[string]interface{}{
+				"etag":          strings.Trim(aws.StringValue(object.ETag), `"`),
+				"key":           key,
+				"size":          int(aws.Int64Value(object.Size)),
+				"storage_class": aws.StringValue(object.StorageClass),
+			}
+
+			if object.LastModified != nil {
+				tfMap["last_modified"] = object.LastModified.Format(time.RFC1123)
+			}
 
 			if object.Owner != nil {
 				owners = append(owners, aws.StringValue(object.Owner.ID))
+				tfMap["owner"] = aws.StringValue(object.Owner.ID)
 			}
+
+			if includeBody {
+				if aws.Int64Value(object.Size) > maxBodySize {
+					log.Printf("[INFO] Ignoring body of S3 object %s/%s larger than %d bytes", bucket, key, maxBodySize)
+					tfMap["body_skipped"] = true
+				} else {
+					if urlEncoded {
+						if key, err = url.QueryUnescape(key); err != nil {
+							return fmt.Errorf("error decoding S3 Bucket (%s) Object key (%s): %w", bucket, aws.StringValue(object.Key), err)
+						}
+					}
+
+					body, ok, err := readObjectBody(conn, bucket, key)
+
+					if err != nil {
+						return fmt.Errorf("error reading S3 Bucket (%s) Object (%s): %w", bucket, key, err)
+					}
+
+					tfMap["body"] = body
+					tfMap["body_skipped"] = !ok
+				}
+			}
+
+			objects = append(objects, tfMap)
 		}
 
 		maxKeys = maxKeys - aws.Int64Value(page.KeyCount)
 
-		if maxKeys <= keyRequestPageSize {
-			listInput.MaxKeys = aws.Int64(maxKeys)
+		if !aws.BoolValue(page.IsTruncated) {
+			break
 		}
 
-		return !lastPage
-	})
+		listInput.ContinuationToken = page.NextContinuationToken
 
-	if err != nil {
-		return fmt.Errorf("error listing S3 Bucket (%s) Objects: %w", bucket, err)
+		if maxKeys <= keyRequestPageSize {
+			listInput.MaxKeys = aws.Int64(maxKeys)
+		}
 	}
 
 	d.SetId(bucket)
@@ -142,9 +254,43 @@ func dataSourceBucketObjectsRead(d *schema.ResourceData, meta interface{}) error
 		return fmt.Errorf("error setting keys: %w", err)
 	}
 
+	if err := d.Set("objects", objects); err != nil {
+		return fmt.Errorf("error setting objects: %w", err)
+	}
+
 	if err := d.Set("owners", owners); err != nil {
 		return fmt.Errorf("error setting owners: %w", err)
 	}
 
 	return nil
 }
+
+// readObjectBody returns the content of the specified object, following the
+// same rules as the aws_s3_bucket_object data source: only objects with a
+// human-readable Content-Type are read. The returned bool is false if the
+// content was not read for that reason.
+func readObjectBody(conn *s3.S3, bucket, key string) (string, bool, error) {
+	output, err := conn.GetObject(&s3.GetObjectInput{
+		Bucket: aws.String(bucket),
+		Key:    aws.String(key),
+	})
+
+	if err != nil {
+		return "", false, err
+	}
+
+	defer output.Body.Close()
+
+	if !isContentTypeAllowed(output.ContentType) {
+		log.Printf("[INFO] Ignoring body of S3 object %s/%s with Content-Type %q", bucket, key, aws.StringValue(output.ContentType))
+		return "", false, nil
+	}
+
+	buf := new(bytes.Buffer)
+
+	if _, err := io.Copy(buf, output.Body); err != nil {
+		return "", false, err
+	}
+
+	return buf.String(), true, nil
+}
@@ -226,6 +226,105 @@ func TestAccS3BucketObjectsDataSource_fetchOwner(t *testing.T) {
 	})
 }
 
+func TestAccS3BucketObjectsDataSource_objects(t *testing.T) {
+	rInt := sdkacctest.RandInt()
+	dataSourceName := "data.aws_s3_bucket_objects.yesh"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                  func() { acctest.PreCheck(t) },
+		ErrorCheck:                acctest.ErrorCheck(t, s3.EndpointsID),
+		Providers:                 acctest.Providers,
+		PreventPostDestroyRefresh: true,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccObjectsResourcesDataSourceConfig(rInt), // NOTE: contains no data source
+				// Does not need Check
+			},
+			{
+				Config: testAccObjectsBasicDataSourceConfig(rInt),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckObjectsExistsDataSource(dataSourceName),
+					resource.TestCheckResourceAttr(dataSourceName, "objects.#", "2"),
+					resource.TestCheckResourceAttr(dataSourceName, "objects.0.key", "arch/navajo/north_window"),
+					resource.TestCheckResourceAttr(dataSourceName, "objects.0.size", "13"),
+					resource.TestCheckResourceAttr(dataSourceName, "objects.0.storage_class", s3.StorageClassStandard),
+					resource.TestCheckResourceAttrPair(dataSourceName, "objects.0.etag", "aws_s3_bucket_object.object3", "etag"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "objects.0.last_modified"),
+					resource.TestCheckResourceAttr(dataSourceName, "objects.0.body", ""),
+					resource.TestCheckResourceAttr(dataSourceName, "objects.1.key", "arch/navajo/sand_dune"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccS3BucketObjectsDataSource_includeBody(t *testing.T) {
+	rInt := sdkacctest.RandInt()
+	dataSourceName := "data.aws_s3_bucket_objects.yesh"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                  func() { acctest.PreCheck(t) },
+		ErrorCheck:                acctest.ErrorCheck(t, s3.EndpointsID),
+		Providers:                 acctest.Providers,
+		PreventPostDestroyRefresh: true,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccObjectsExtraContentTypeResourceDataSourceConfig(rInt), // NOTE: contains no data source
+				// Does not need Check
+			},
+			{
+				Config: testAccObjectsIncludeBodyDataSourceConfig(rInt),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckObjectsExistsDataSource(dataSourceName),
+					resource.TestCheckResourceAttr(dataSourceName, "objects.#", "2"),
+					resource.TestCheckResourceAttr(dataSourceName, "objects.0.key", "config/binary.bin"),
+					resource.TestCheckResourceAttr(dataSourceName, "objects.0.body", ""),
+					resource.TestCheckResourceAttr(dataSourceName, "objects.0.body_skipped", "true"),
+					resource.TestCheckResourceAttr(dataSourceName, "objects.1.key", "config/settings.json"),
+					resource.TestCheckResourceAttr(dataSourceName, "objects.1.body", `{"landscape":"arch"}`),
+					resource.TestCheckResourceAttr(dataSourceName, "objects.1.body_skipped", "false"),
+				),
+			},
+			{
+				Config: testAccObjectsIncludeBodyMaxBodySizeDataSourceConfig(rInt),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckObjectsExistsDataSource(dataSourceName),
+					resource.TestCheckResourceAttr(dataSourceName, "objects.#", "2"),
+					resource.TestCheckResourceAttr(dataSourceName, "objects.1.key", "config/settings.json"),
+					resource.TestCheckResourceAttr(dataSourceName, "objects.1.body", ""),
+					resource.TestCheckResourceAttr(dataSourceName, "objects.1.body_skipped", "true"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccS3BucketObjectsDataSource_maxKeysAcrossPages(t *testing.T) {
+	rInt := sdkacctest.RandInt()
+	dataSourceName := "data.aws_s3_bucket_objects.yesh"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                  func() { acctest.PreCheck(t) },
+		ErrorCheck:                acctest.ErrorCheck(t, s3.EndpointsID),
+		Providers:                 acctest.Providers,
+		PreventPostDestroyRefresh: true,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccObjectsManyResourcesDataSourceConfig(rInt), // NOTE: contains no data source
+				// Does not need Check
+			},
+			{
+				Config: testAccObjectsMaxKeysAcrossPagesDataSourceConfig(rInt),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckObjectsExistsDataSource(dataSourceName),
+					resource.TestCheckResourceAttr(dataSourceName, "keys.#", "1005"),
+					resource.TestCheckResourceAttr(dataSourceName, "objects.#", "1005"),
+				),
+			},
+		},
+	})
+}
+
 func testAccCheckObjectsExistsDataSource(addr string) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		rs, ok := s.RootModule().Resources[addr]
@@ -401,3 +500,75 @@ data "aws_s3_bucket_objects" "yesh" {
 }
 `, testAccObjectsResourcesDataSourceConfig(randInt))
 }
+
+func testAccObjectsExtraContentTypeResourceDataSourceConfig(randInt int) string {
+	return fmt.Sprintf(`
+%s
+
+resource "aws_s3_bucket_object" "object8" {
+  bucket       = aws_s3_bucket.objects_bucket.id
+  key          = "config/settings.json"
+  content      = jsonencode({ landscape = "arch" })
+  content_type = "application/json"
+}
+
+resource "aws_s3_bucket_object" "object9" {
+  bucket         = aws_s3_bucket.objects_bucket.id
+  key            = "config/binary.bin"
+  content_base64 = "AAEC"
+  content_type   = "application/octet-stream"
+}
+`, testAccObjectsResourcesDataSourceConfig(randInt))
+}
+
+func testAccObjectsIncludeBodyDataSourceConfig(randInt int) string {
+	return fmt.Sprintf(`
+%s
+
+data "aws_s3_bucket_objects" "yesh" {
+  bucket       = aws_s3_bucket.objects_bucket.id
+  prefix       = "config/"
+  include_body = true
+}
+`, testAccObjectsExtraContentTypeResourceDataSourceConfig(randInt))
+}
+
+func testAccObjectsIncludeBodyMaxBodySizeDataSourceConfig(randInt int) string {
+	return fmt.Sprintf(`
+%s
+
+data "aws_s3_bucket_objects" "yesh" {
+  bucket        = aws_s3_bucket.objects_bucket.id
+  prefix        = "config/"
+  include_body  = true
+  max_body_size = 10
+}
+`, testAccObjectsExtraContentTypeResourceDataSourceConfig(randInt))
+}
+
+func testAccObjectsManyResourcesDataSourceConfig(randInt int) string {
+	return fmt.Sprintf(`
+resource "aws_s3_bucket" "objects_bucket" {
+  bucket = "tf-acc-objects-test-bucket-%d"
+}
+
+resource "aws_s3_bucket_object" "test" {
+  count = 1010
+
+  bucket  = aws_s3_bucket.objects_bucket.id
+  key     = format("key-%%04d", count.index)
+  content = count.index
+}
+`, randInt)
+}
+
+func testAccObjectsMaxKeysAcrossPagesDataSourceConfig(randInt int) string {
+	return fmt.Sprintf(`
+%s
+
+data "aws_s3_bucket_objects" "yesh" {
+  bucket   = aws_s3_bucket.objects_bucket.id
+  max_keys = 1005
+}
+`, testAccObjectsManyResourcesDataSourceConfig(randInt))
+}
@@ -58,25 +58,7 @@ func resourceBucketPolicyPut(d *schema.ResourceData, meta interface{}) error {
 
 	log.Printf("[DEBUG] S3 bucket: %s, put policy: %s", bucket, policy)
 
-	params := &s3.PutBucketPolicyInput{
-		Bucket: aws.String(bucket),
-		Policy: aws.String(policy),
-	}
-
-	err = resource.Retry(1*time.Minute, func() *resource.RetryError {
-		_, err := conn.PutBucketPolicy(params)
-		if tfawserr.ErrMessageContains(err, "MalformedPolicy", "") {
-			return resource.RetryableError(err)
-		}
-		if err != nil {
-			return resource.NonRetryableError(err)
-		}
-		return nil
-	})
-	if tfresource.TimedOut(err) {
-		_, err = conn.PutBucketPolicy(params)
-	}
-	if err != nil {
+	if err := putBucketPolicy(conn, bucket, policy); err != nil {
 		return fmt.Errorf("Error putting S3 policy: %s", err)
 	}
 
@@ -140,3 +122,27 @@ func resourceBucketPolicyDelete(d *schema.ResourceData, meta interface{}) error
 
 	return nil
 }
+
+// putBucketPolicy puts the specified bucket policy, retrying while any principals propagate.
+func putBucketPolicy(conn *s3.S3, bucket, policy string) error {
+	input := &s3.PutBucketPolicyInput{
+		Bucket: aws.String(bucket),
+		Policy: aws.String(policy),
+	}
+
+	err := resource.Retry(1*time.Minute, func() *resource.RetryError {
+		_, err := conn.PutBucketPolicy(input)
+		if tfawserr.ErrMessageContains(err, "MalformedPolicy", "") {
+			return resource.RetryableError(err)
+		}
+		if err != nil {
+			return resource.NonRetryableError(err)
+		}
+		return nil
+	})
+	if tfresource.TimedOut(err) {
+		_, err = conn.PutBucketPolicy(input)
+	}
+
+	return err
+}
@@ -0,0 +1,364 @@
+package s3
+
+import (
+	"encoding/json"
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/s3"
+	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+const (
+	bucketPolicyStatementResourceIDSeparator = "/"
+	bucketPolicyVersion                      = "2012-10-17"
+)
+
+func ResourceBucketPolicyStatement() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceBucketPolicyStatementPut,
+		Read:   resourceBucketPolicyStatementRead,
+		Update: resourceBucketPolicyStatementPut,
+		Delete: resourceBucketPolicyStatementDelete,
+
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"bucket": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"policy": {
+				Type:             schema.TypeString,
+				Required:         true,
+				ValidateFunc:     verify.ValidIAMPolicyJSON,
+				DiffSuppressFunc: verify.SuppressEquivalentPolicyDiffs,
+			},
+			"sid": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+				ValidateFunc: validation.All(
+					validation.StringIsNotEmpty,
+					validation.StringDoesNotContainAny(bucketPolicyStatementResourceIDSeparator),
+				),
+			},
+		},
+	}
+}
+
+func resourceBucketPolicyStatementPut(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).S3Conn
+
+	bucket := d.Get("bucket").(string)
+	sid := d.Get("sid").(string)
+	id := BucketPolicyStatementCreateResourceID(bucket, sid)
+
+	statement, err := expandBucketPolicyStatement(d.Get("policy").(string), sid)
+
+	if err != nil {
+		return err
+	}
+
+	conns.GlobalMutexKV.Lock(bucketPolicyMutexKey(bucket))
+	defer conns.GlobalMutexKV.Unlock(bucketPolicyMutexKey(bucket))
+
+	document, err := findBucketPolicyDocument(conn, bucket)
+
+	if tfresource.NotFound(err) {
+		document = &bucketPolicyDocument{
+			Version: bucketPolicyVersion,
+		}
+	} else if err != nil {
+		return fmt.Errorf("error reading S3 Bucket (%s) policy: %w", bucket, err)
+	}
+
+	document.setStatement(sid, statement)
+
+	policy, err := json.Marshal(document)
+
+	if err != nil {
+		return err
+	}
+
+	log.Printf("[DEBUG] Putting S3 Bucket Policy Statement (%s): %s", id, policy)
+	if err := putBucketPolicy(conn, bucket, string(policy)); err != nil {
+		return fmt.Errorf("error putting S3 Bucket Policy Statement (%s): %w", id, err)
+	}
+
+	d.SetId(id)
+
+	return resourceBucketPolicyStatementRead(d, meta)
+}
+
+func resourceBucketPolicyStatementRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).S3Conn
+
+	bucket, sid, err := BucketPolicyStatementParseResourceID(d.Id())
+
+	if err != nil {
+		return err
+	}
+
+	outputRaw, err := tfresource.RetryWhenNewResourceNotFound(propagationTimeout, func() (interface{}, error) {
+		return FindBucketPolicyStatement(conn, bucket, sid)
+	}, d.IsNewResource())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] S3 Bucket Policy Statement (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error reading S3 Bucket Policy Statement (%s): %w", d.Id(), err)
+	}
+
+	statement := outputRaw.(map[string]interface{})
+
+	// Present the statement in the same shape as the configured policy so that
+	// equivalent policies don't show a difference.
+	policyDocument := &bucketPolicyDocument{
+		Version: bucketPolicyVersion,
+	}
+
+	if v, ok := d.GetOk("policy"); ok {
+		configuredDocument, err := unmarshalBucketPolicyDocument(v.(string))
+
+		if err != nil {
+			return err
+		}
+
+		policyDocument.Version = configuredDocument.Version
+		policyDocument.Id = configuredDocument.Id
+
+		// The configured statement's Sid may be empty or absent.
+		if len(configuredDocument.Statements) == 1 {
+			if v, ok := configuredDocument.Statements[0]["Sid"]; ok {
+				statement["Sid"] = v
+			} else {
+				delete(statement, "Sid")
+			}
+		}
+	}
+
+	policyDocument.Statements = []map[string]interface{}{statement}
+
+	policy, err := json.Marshal(policyDocument)
+
+	if err != nil {
+		return err
+	}
+
+	policyToSet, err := verify.PolicyToSet(d.Get("policy").(string), string(policy))
+
+	if err != nil {
+		return err
+	}
+
+	d.Set("bucket", bucket)
+	d.Set("policy", policyToSet)
+	d.Set("sid", sid)
+
+	return nil
+}
+
+func resourceBucketPolicyStatementDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).S3Conn
+
+	bucket, sid, err := BucketPolicyStatementParseResourceID(d.Id())
+
+	if err != nil {
+		return err
+	}
+
+	conns.GlobalMutexKV.Lock(bucketPolicyMutexKey(bucket))
+	defer conns.GlobalMutexKV.Unlock(bucketPolicyMutexKey(bucket))
+
+	document, err := findBucketPolicyDocument(conn, bucket)
+
+	if tfresource.NotFound(err) {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error reading S3 Bucket (%s) policy: %w", bucket, err)
+	}
+
+	if !document.removeStatement(sid) {
+		return nil
+	}
+
+	if len(document.Statements) == 0 {
+		log.Printf("[DEBUG] Deleting S3 Bucket (%s) policy", bucket)
+		_, err = conn.DeleteBucketPolicy(&s3.DeleteBucketPolicyInput{
+			Bucket: aws.String(bucket),
+		})
+
+		if tfawserr.ErrCodeEquals(err, s3.ErrCodeNoSuchBucket) {
+			return nil
+		}
+
+		if err != nil {
+			return fmt.Errorf("error deleting S3 Bucket Policy Statement (%s): %w", d.Id(), err)
+		}
+
+		return nil
+	}
+
+	policy, err := json.Marshal(document)
+
+	if err != nil {
+		return err
+	}
+
+	log.Printf("[DEBUG] Deleting S3 Bucket Policy Statement (%s)", d.Id())
+	if err := putBucketPolicy(conn, bucket, string(policy)); err != nil {
+		return fmt.Errorf("error deleting S3 Bucket Policy Statement (%s): %w", d.Id(), err)
+	}
+
+	return nil
+}
+
+func BucketPolicyStatementCreateResourceID(bucket, sid string) string {
+	parts := []string{bucket, sid}
+	id := strings.Join(parts, bucketPolicyStatementResourceIDSeparator)
+
+	return id
+}
+
+func BucketPolicyStatementParseResourceID(id string) (string, string, error) {
+	parts := strings.Split(id, bucketPolicyStatementResourceIDSeparator)
+
+	if len(parts) == 2 && parts[0] != "" && parts[1] != "" {
+		return parts[0], parts[1], nil
+	}
+
+	return "", "", fmt.Errorf("unexpected format for ID (%[1]s), expected bucket%[2]ssid", id, bucketPolicyStatementResourceIDSeparator)
+}
+
+// bucketPolicyMutexKey returns the key used to serialize read-modify-write updates of a bucket's policy.
+func bucketPolicyMutexKey(bucket string) string {
+	return fmt.Sprintf("s3-bucket-policy-%s", bucket)
+}
+
+// expandBucketPolicyStatement returns the single statement in the specified policy document with its Sid set.
+func expandBucketPolicyStatement(policy, sid string) (map[string]interface{}, error) {
+	document, err := unmarshalBucketPolicyDocument(policy)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if n := len(document.Statements); n != 1 {
+		return nil, fmt.Errorf("policy must contain exactly one statement, got %d", n)
+	}
+
+	statement := document.Statements[0]
+
+	if v, ok := statement["Sid"].(string); ok && v != "" && v != sid {
+		return nil, fmt.Errorf("policy statement Sid (%s) does not match sid (%s)", v, sid)
+	}
+
+	statement["Sid"] = sid
+
+	return statement, nil
+}
+
+func findBucketPolicyDocument(conn *s3.S3, bucket string) (*bucketPolicyDocument, error) {
+	policy, err := FindBucketPolicy(conn, bucket)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return unmarshalBucketPolicyDocument(policy)
+}
+
+// bucketPolicyDocument is an S3 bucket policy.
+// Statements are kept as generic JSON objects so that statements managed
+// elsewhere round-trip unchanged.
+type bucketPolicyDocument struct {
+	Version    string                   `json:",omitempty"`
+	Id         string                   `json:",omitempty"`
+	Statements []map[string]interface{} `json:"Statement"`
+}
+
+func unmarshalBucketPolicyDocument(policy string) (*bucketPolicyDocument, error) {
+	var raw struct {
+		Version   string          `json:",omitempty"`
+		Id        string          `json:",omitempty"`
+		Statement json.RawMessage `json:",omitempty"`
+	}
+
+	if err := json.Unmarshal([]byte(policy), &raw); err != nil {
+		return nil, fmt.Errorf("error parsing policy (%s): %w", policy, err)
+	}
+
+	document := &bucketPolicyDocument{
+		Version: raw.Version,
+		Id:      raw.Id,
+	}
+
+	if len(raw.Statement) == 0 {
+		return document, nil
+	}
+
+	// Statement may be a single object or an array of objects.
+	if err := json.Unmarshal(raw.Statement, &document.Statements); err != nil {
+		var statement map[string]interface{}
+
+		if err := json.Unmarshal(raw.Statement, &statement); err != nil {
+			return nil, fmt.Errorf("error parsing policy (%s) statement: %w", policy, err)
+		}
+
+		document.Statements = []map[string]interface{}{statement}
+	}
+
+	return document, nil
+}
+
+// statement returns the statement with the specified Sid, or nil if there is no such statement.
+func (doc *bucketPolicyDocument) statement(sid string) map[string]interface{} {
+	for _, statement := range doc.Statements {
+		if v, ok := statement["Sid"].(string); ok && v == sid {
+			return statement
+		}
+	}
+
+	return nil
+}
+
+// setStatement replaces the statement with the specified Sid, or appends the statement if there is no such statement.
+func (doc *bucketPolicyDocument) setStatement(sid string, statement map[string]interface{}) {
+	for i, v := range doc.Statements {
+		if v, ok := v["Sid"].(string); ok && v == sid {
+			doc.Statements[i] = statement
+			return
+		}
+	}
+
+	doc.Statements = append(doc.Statements, statement)
+}
+
+// removeStatement removes the statement with the specified Sid, returning whether the statement was found.
+func (doc *bucketPolicyDocument) removeStatement(sid string) bool {
+	for i, v := range doc.Statements {
+		if v, ok := v["Sid"].(string); ok && v == sid {
+			doc.Statements = append(doc.Statements[:i], doc.Statements[i+1:]...)
+			return true
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,332 @@
+package s3_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/s3"
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfs3 "github.com/hashicorp/terraform-provider-aws/internal/service/s3"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func TestAccS3BucketPolicyStatement_basic(t *testing.T) {
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_s3_bucket_policy_statement.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, s3.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckBucketPolicyStatementDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccBucketPolicyStatementConfig(rName, "s3:GetObject"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckBucketPolicyStatementExists(resourceName),
+					resource.TestCheckResourceAttrPair(resourceName, "bucket", "aws_s3_bucket.test", "bucket"),
+					resource.TestCheckResourceAttrSet(resourceName, "policy"),
+					resource.TestCheckResourceAttr(resourceName, "sid", "AllowGet"),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"policy"},
+			},
+		},
+	})
+}
+
+func TestAccS3BucketPolicyStatement_disappears(t *testing.T) {
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_s3_bucket_policy_statement.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, s3.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckBucketPolicyStatementDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccBucketPolicyStatementConfig(rName, "s3:GetObject"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckBucketPolicyStatementExists(resourceName),
+					acctest.CheckResourceDisappears(acctest.Provider, tfs3.ResourceBucketPolicyStatement(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccS3BucketPolicyStatement_update(t *testing.T) {
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_s3_bucket_policy_statement.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, s3.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckBucketPolicyStatementDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccBucketPolicyStatementConfig(rName, "s3:GetObject"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckBucketPolicyStatementExists(resourceName),
+				),
+			},
+			{
+				Config: testAccBucketPolicyStatementConfig(rName, "s3:GetObjectVersion"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckBucketPolicyStatementExists(resourceName),
+					testAccCheckBucketPolicyStatementAction(resourceName, "s3:GetObjectVersion"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccS3BucketPolicyStatement_multipleStatements(t *testing.T) {
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName1 := "aws_s3_bucket_policy_statement.test1"
+	resourceName2 := "aws_s3_bucket_policy_statement.test2"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, s3.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckBucketPolicyStatementDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccBucketPolicyStatementMultipleConfig(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckBucketPolicyStatementExists(resourceName1),
+					testAccCheckBucketPolicyStatementExists(resourceName2),
+				),
+			},
+		},
+	})
+}
+
+func TestBucketPolicyStatementParseResourceID(t *testing.T) {
+	testCases := []struct {
+		TestName      string
+		InputID       string
+		ExpectedError bool
+		ExpectedSid   string
+	}{
+		{
+			TestName:      "empty ID",
+			InputID:       "",
+			ExpectedError: true,
+		},
+		{
+			TestName:      "missing sid",
+			InputID:       "example-bucket/",
+			ExpectedError: true,
+		},
+		{
+			TestName:      "too many parts",
+			InputID:       "example-bucket/sid/extra",
+			ExpectedError: true,
+		},
+		{
+			TestName:    "valid ID",
+			InputID:     tfs3.BucketPolicyStatementCreateResourceID("example-bucket", "AllowGet"),
+			ExpectedSid: "AllowGet",
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.TestName, func(t *testing.T) {
+			_, gotSid, err := tfs3.BucketPolicyStatementParseResourceID(testCase.InputID)
+
+			if err == nil && testCase.ExpectedError {
+				t.Fatalf("expected error, got no error")
+			}
+
+			if err != nil && !testCase.ExpectedError {
+				t.Fatalf("got unexpected error: %s", err)
+			}
+
+			if gotSid != testCase.ExpectedSid {
+				t.Errorf("got sid %s, expected %s", gotSid, testCase.ExpectedSid)
+			}
+		})
+	}
+}
+
+func testAccCheckBucketPolicyStatementDestroy(s *terraform.State) error {
+	conn := acctest.Provider.Meta().(*conns.AWSClient).S3Conn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_s3_bucket_policy_statement" {
+			continue
+		}
+
+		bucket, sid, err := tfs3.BucketPolicyStatementParseResourceID(rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		_, err = tfs3.FindBucketPolicyStatement(conn, bucket, sid)
+
+		if tfresource.NotFound(err) {
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+
+		return fmt.Errorf("S3 Bucket Policy Statement (%s) still exists", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+func testAccCheckBucketPolicyStatementExists(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No S3 Bucket Policy Statement ID is set")
+		}
+
+		bucket, sid, err := tfs3.BucketPolicyStatementParseResourceID(rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).S3Conn
+
+		_, err = tfs3.FindBucketPolicyStatement(conn, bucket, sid)
+
+		return err
+	}
+}
+
+func testAccCheckBucketPolicyStatementAction(n, action string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		bucket, sid, err := tfs3.BucketPolicyStatementParseResourceID(rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).S3Conn
+
+		statement, err := tfs3.FindBucketPolicyStatement(conn, bucket, sid)
+
+		if err != nil {
+			return err
+		}
+
+		if got := fmt.Sprintf("%v", statement["Action"]); got != action {
+			return fmt.Errorf("S3 Bucket Policy Statement (%s) Action is %s, expected %s", rs.Primary.ID, got, action)
+		}
+
+		return nil
+	}
+}
+
+func testAccBucketPolicyStatementBaseConfig(rName string) string {
+	return fmt.Sprintf(`
+data "aws_partition" "current" {}
+
+data "aws_caller_identity" "current" {}
+
+resource "aws_s3_bucket" "test" {
+  bucket = %[1]q
+}
+`, rName)
+}
+
+func testAccBucketPolicyStatementConfig(rName, action string) string {
+	return acctest.ConfigCompose(testAccBucketPolicyStatementBaseConfig(rName), fmt.Sprintf(`
+resource "aws_s3_bucket_policy_statement" "test" {
+  bucket = aws_s3_bucket.test.bucket
+  sid    = "AllowGet"
+  policy = data.aws_iam_policy_document.test.json
+}
+
+data "aws_iam_policy_document" "test" {
+  statement {
+    actions   = [%[1]q]
+    resources = ["${aws_s3_bucket.test.arn}/*"]
+
+    principals {
+      type        = "AWS"
+      identifiers = ["arn:${data.aws_partition.current.partition}:iam::${data.aws_caller_identity.current.account_id}:root"]
+    }
+  }
+}
+`, action))
+}
+
+func testAccBucketPolicyStatementMultipleConfig(rName string) string {
+	return acctest.ConfigCompose(testAccBucketPolicyStatementBaseConfig(rName), `
+resource "aws_s3_bucket_policy_statement" "test1" {
+  bucket = aws_s3_bucket.test.bucket
+  sid    = "AllowGet"
+  policy = data.aws_iam_policy_document.test1.json
+}
+
+data "aws_iam_policy_document" "test1" {
+  statement {
+    actions   = ["s3:GetObject"]
+    resources = ["${aws_s3_bucket.test.arn}/*"]
+
+    principals {
+      type        = "AWS"
+      identifiers = ["arn:${data.aws_partition.current.partition}:iam::${data.aws_caller_identity.current.account_id}:root"]
+    }
+  }
+}
+
+resource "aws_s3_bucket_policy_statement" "test2" {
+  bucket = aws_s3_bucket.test.bucket
+  sid    = "DenyInsecureTransport"
+  policy = data.aws_iam_policy_document.test2.json
+}
+
+data "aws_iam_policy_document" "test2" {
+  statement {
+    effect  = "Deny"
+    actions = ["s3:*"]
+
+    resources = [
+      aws_s3_bucket.test.arn,
+      "${aws_s3_bucket.test.arn}/*",
+    ]
+
+    principals {
+      type        = "*"
+      identifiers = ["*"]
+    }
+
+    condition {
+      test     = "Bool"
+      variable = "aws:SecureTransport"
+      values   = ["false"]
+    }
+  }
+}
+`)
+}
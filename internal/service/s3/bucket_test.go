@@ -1248,6 +1248,15 @@ func TestAccS3Bucket_Security_logging(t *testing.T) {
 				ImportStateVerify:       true,
 				ImportStateVerifyIgnore: []string{"force_destroy", "acl"},
 			},
+			{
+				// logging is computed, so removing the block leaves the bucket's configuration in place.
+				Config: testAccBucketWithLoggingRemovedConfig(bucketName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckBucketExists(resourceName),
+					testAccCheckBucketLogging(resourceName, "aws_s3_bucket.log_bucket", "log/"),
+					resource.TestCheckResourceAttr(resourceName, "logging.#", "1"),
+				),
+			},
 		},
 	})
 }
@@ -2613,6 +2622,53 @@ func TestAccS3Bucket_Basic_forceDestroyWithObjectLockEnabled(t *testing.T) {
 	})
 }
 
+func TestAccS3Bucket_Basic_forceDestroyWithGovernanceRetention(t *testing.T) {
+	resourceName := "aws_s3_bucket.bucket"
+	bucketName := sdkacctest.RandomWithPrefix("tf-test-bucket")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, s3.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckBucketDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccBucketConfig_forceDestroyWithGovernanceRetention(bucketName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckBucketExists(resourceName),
+					// More than one DeleteObjects batch.
+					testAccCheckBucketAddObjectsWithGovernanceRetention(resourceName, "data/", 1005),
+				),
+			},
+		},
+	})
+}
+
+// Object Lock is enabled outside of the aws_s3_bucket resource so that
+// only bypass_governance_retention allows force_destroy to purge the bucket.
+func TestAccS3Bucket_Basic_forceDestroyBypassGovernanceRetention(t *testing.T) {
+	resourceName := "aws_s3_bucket.bucket"
+	bucketName := sdkacctest.RandomWithPrefix("tf-test-bucket")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, s3.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckBucketDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccBucketConfig_forceDestroyBypassGovernanceRetention(bucketName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckBucketExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "bypass_governance_retention", "true"),
+					testAccCheckBucketEnableObjectLock(resourceName),
+					testAccCheckBucketAddObjectsWithGovernanceRetention(resourceName, "data/", 5),
+				),
+			},
+		},
+	})
+}
+
 func TestBucketName(t *testing.T) {
 	validDnsNames := []string{
 		"foobar",
@@ -3036,6 +3092,51 @@ func testAccCheckBucketAddObjectsWithLegalHold(n string, keys ...string) resourc
 	}
 }
 
+// testAccCheckBucketAddObjectsWithGovernanceRetention puts count objects, with keys beginning with prefix,
+// that are retained in GOVERNANCE mode and have a legal hold.
+func testAccCheckBucketAddObjectsWithGovernanceRetention(n, prefix string, count int) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs := s.RootModule().Resources[n]
+		conn := acctest.Provider.Meta().(*conns.AWSClient).S3Conn
+
+		for i := 0; i < count; i++ {
+			_, err := conn.PutObject(&s3.PutObjectInput{
+				Bucket:                    aws.String(rs.Primary.ID),
+				Key:                       aws.String(fmt.Sprintf("%s%d", prefix, i)),
+				ObjectLockLegalHoldStatus: aws.String(s3.ObjectLockLegalHoldStatusOn),
+				ObjectLockMode:            aws.String(s3.ObjectLockModeGovernance),
+				ObjectLockRetainUntilDate: aws.Time(time.Now().Add(24 * time.Hour)),
+			})
+
+			if err != nil {
+				return fmt.Errorf("PutObject error: %s", err)
+			}
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckBucketEnableObjectLock(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs := s.RootModule().Resources[n]
+		conn := acctest.Provider.Meta().(*conns.AWSClient).S3Conn
+
+		_, err := conn.PutObjectLockConfiguration(&s3.PutObjectLockConfigurationInput{
+			Bucket: aws.String(rs.Primary.ID),
+			ObjectLockConfiguration: &s3.ObjectLockConfiguration{
+				ObjectLockEnabled: aws.String(s3.ObjectLockEnabledEnabled),
+			},
+		})
+
+		if err != nil {
+			return fmt.Errorf("PutObjectLockConfiguration error: %s", err)
+		}
+
+		return nil
+	}
+}
+
 // Create an S3 bucket via a CF stack so that it has system tags.
 func testAccCheckBucketCreateViaCloudFormation(n string, stackID *string) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
@@ -3950,6 +4051,20 @@ resource "aws_s3_bucket" "bucket" {
 `, bucketName)
 }
 
+func testAccBucketWithLoggingRemovedConfig(bucketName string) string {
+	return fmt.Sprintf(`
+resource "aws_s3_bucket" "log_bucket" {
+  bucket = "%[1]s-log"
+  acl    = "log-delivery-write"
+}
+
+resource "aws_s3_bucket" "bucket" {
+  bucket = %[1]q
+  acl    = "private"
+}
+`, bucketName)
+}
+
 func testAccBucketWithLifecycleConfig(bucketName string) string {
 	return fmt.Sprintf(`
 resource "aws_s3_bucket" "bucket" {
@@ -5096,6 +5211,37 @@ resource "aws_s3_bucket" "bucket" {
 `, bucketName)
 }
 
+func testAccBucketConfig_forceDestroyWithGovernanceRetention(bucketName string) string {
+	return fmt.Sprintf(`
+resource "aws_s3_bucket" "bucket" {
+  bucket        = %[1]q
+  force_destroy = true
+
+  versioning {
+    enabled = true
+  }
+
+  object_lock_configuration {
+    object_lock_enabled = "Enabled"
+  }
+}
+`, bucketName)
+}
+
+func testAccBucketConfig_forceDestroyBypassGovernanceRetention(bucketName string) string {
+	return fmt.Sprintf(`
+resource "aws_s3_bucket" "bucket" {
+  bucket                      = %[1]q
+  bypass_governance_retention = true
+  force_destroy               = true
+
+  versioning {
+    enabled = true
+  }
+}
+`, bucketName)
+}
+
 func testAccBucketReplicationConfig_iamPolicy(rName string) string {
 	return fmt.Sprintf(`
 resource "aws_iam_role" "test" {
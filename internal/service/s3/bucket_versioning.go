@@ -0,0 +1,206 @@
+package s3
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/s3"
+	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func ResourceBucketVersioning() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceBucketVersioningCreate,
+		Read:   resourceBucketVersioningRead,
+		Update: resourceBucketVersioningUpdate,
+		Delete: resourceBucketVersioningDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"bucket": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(1, 63),
+			},
+			"mfa": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"versioning_configuration": {
+				Type:     schema.TypeList,
+				Required: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"mfa_delete": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							Computed:     true,
+							ValidateFunc: validation.StringInSlice(s3.MFADelete_Values(), false),
+						},
+						"status": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringInSlice(s3.BucketVersioningStatus_Values(), false),
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func resourceBucketVersioningCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).S3Conn
+
+	bucket := d.Get("bucket").(string)
+	versioningConfiguration := expandBucketVersioningConfiguration(d.Get("versioning_configuration").([]interface{}))
+
+	if err := putBucketVersioning(conn, bucket, d.Get("mfa").(string), versioningConfiguration); err != nil {
+		return fmt.Errorf("error creating S3 Bucket Versioning (%s): %w", bucket, err)
+	}
+
+	d.SetId(bucket)
+
+	return resourceBucketVersioningRead(d, meta)
+}
+
+func resourceBucketVersioningRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).S3Conn
+
+	outputRaw, err := tfresource.RetryWhenNewResourceNotFound(propagationTimeout, func() (interface{}, error) {
+		return FindBucketVersioning(conn, d.Id())
+	}, d.IsNewResource())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] S3 Bucket Versioning (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error reading S3 Bucket Versioning (%s): %w", d.Id(), err)
+	}
+
+	output := outputRaw.(*s3.GetBucketVersioningOutput)
+
+	d.Set("bucket", d.Id())
+
+	if err := d.Set("versioning_configuration", flattenBucketVersioningConfiguration(output)); err != nil {
+		return fmt.Errorf("error setting versioning_configuration: %w", err)
+	}
+
+	return nil
+}
+
+func resourceBucketVersioningUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).S3Conn
+
+	versioningConfiguration := expandBucketVersioningConfiguration(d.Get("versioning_configuration").([]interface{}))
+
+	if err := putBucketVersioning(conn, d.Id(), d.Get("mfa").(string), versioningConfiguration); err != nil {
+		return fmt.Errorf("error updating S3 Bucket Versioning (%s): %w", d.Id(), err)
+	}
+
+	return resourceBucketVersioningRead(d, meta)
+}
+
+func resourceBucketVersioningDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).S3Conn
+
+	// Versioning can't be disabled once it has been enabled, only suspended.
+	input := &s3.PutBucketVersioningInput{
+		Bucket: aws.String(d.Id()),
+		VersioningConfiguration: &s3.VersioningConfiguration{
+			Status: aws.String(s3.BucketVersioningStatusSuspended),
+		},
+	}
+
+	if v, ok := d.GetOk("mfa"); ok {
+		input.MFA = aws.String(v.(string))
+	}
+
+	log.Printf("[DEBUG] Deleting S3 Bucket Versioning: %s", d.Id())
+	_, err := conn.PutBucketVersioning(input)
+
+	if tfawserr.ErrCodeEquals(err, s3.ErrCodeNoSuchBucket) {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error deleting S3 Bucket Versioning (%s): %w", d.Id(), err)
+	}
+
+	return nil
+}
+
+func putBucketVersioning(conn *s3.S3, bucket, mfa string, versioningConfiguration *s3.VersioningConfiguration) error {
+	input := &s3.PutBucketVersioningInput{
+		Bucket:                  aws.String(bucket),
+		VersioningConfiguration: versioningConfiguration,
+	}
+
+	if mfa != "" {
+		input.MFA = aws.String(mfa)
+	}
+
+	_, err := retryWhenBucketNotFound(func() (interface{}, error) {
+		return conn.PutBucketVersioning(input)
+	})
+
+	if err != nil {
+		return err
+	}
+
+	if err := waitBucketVersioningUpdated(conn, bucket, aws.StringValue(versioningConfiguration.Status)); err != nil {
+		return fmt.Errorf("error waiting for versioning status to become %s: %w", aws.StringValue(versioningConfiguration.Status), err)
+	}
+
+	return nil
+}
+
+func expandBucketVersioningConfiguration(tfList []interface{}) *s3.VersioningConfiguration {
+	if len(tfList) == 0 || tfList[0] == nil {
+		return nil
+	}
+
+	tfMap := tfList[0].(map[string]interface{})
+
+	apiObject := &s3.VersioningConfiguration{}
+
+	if v, ok := tfMap["mfa_delete"].(string); ok && v != "" {
+		apiObject.MFADelete = aws.String(v)
+	}
+
+	if v, ok := tfMap["status"].(string); ok && v != "" {
+		apiObject.Status = aws.String(v)
+	}
+
+	return apiObject
+}
+
+func flattenBucketVersioningConfiguration(apiObject *s3.GetBucketVersioningOutput) []interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{
+		"status": aws.StringValue(apiObject.Status),
+	}
+
+	if v := apiObject.MFADelete; v != nil {
+		tfMap["mfa_delete"] = aws.StringValue(v)
+	} else {
+		tfMap["mfa_delete"] = s3.MFADeleteDisabled
+	}
+
+	return []interface{}{tfMap}
+}
@@ -0,0 +1,253 @@
+package s3
+
+import (
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/s3"
+	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func FindBucketACL(conn *s3.S3, bucket string) (*s3.GetBucketAclOutput, error) {
+	input := &s3.GetBucketAclInput{
+		Bucket: aws.String(bucket),
+	}
+
+	output, err := conn.GetBucketAcl(input)
+
+	if tfawserr.ErrCodeEquals(err, s3.ErrCodeNoSuchBucket) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output, nil
+}
+
+func FindBucketCorsRules(conn *s3.S3, bucket string) ([]*s3.CORSRule, error) {
+	input := &s3.GetBucketCorsInput{
+		Bucket: aws.String(bucket),
+	}
+
+	output, err := conn.GetBucketCors(input)
+
+	if tfawserr.ErrCodeEquals(err, s3.ErrCodeNoSuchBucket, ErrCodeNoSuchCORSConfiguration) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || len(output.CORSRules) == 0 {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output.CORSRules, nil
+}
+
+func FindBucketLogging(conn *s3.S3, bucket string) (*s3.LoggingEnabled, error) {
+	input := &s3.GetBucketLoggingInput{
+		Bucket: aws.String(bucket),
+	}
+
+	output, err := conn.GetBucketLogging(input)
+
+	if tfawserr.ErrCodeEquals(err, s3.ErrCodeNoSuchBucket) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.LoggingEnabled == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output.LoggingEnabled, nil
+}
+
+func FindBucketPolicy(conn *s3.S3, bucket string) (string, error) {
+	input := &s3.GetBucketPolicyInput{
+		Bucket: aws.String(bucket),
+	}
+
+	output, err := conn.GetBucketPolicy(input)
+
+	if tfawserr.ErrCodeEquals(err, s3.ErrCodeNoSuchBucket, ErrCodeNoSuchBucketPolicy) {
+		return "", &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return "", err
+	}
+
+	if output == nil || aws.StringValue(output.Policy) == "" {
+		return "", tfresource.NewEmptyResultError(input)
+	}
+
+	return aws.StringValue(output.Policy), nil
+}
+
+func FindBucketPolicyStatement(conn *s3.S3, bucket, sid string) (map[string]interface{}, error) {
+	document, err := findBucketPolicyDocument(conn, bucket)
+
+	if err != nil {
+		return nil, err
+	}
+
+	statement := document.statement(sid)
+
+	if statement == nil {
+		return nil, tfresource.NewEmptyResultError(sid)
+	}
+
+	return statement, nil
+}
+
+func FindBucketServerSideEncryptionConfiguration(conn *s3.S3, bucket string) (*s3.ServerSideEncryptionConfiguration, error) {
+	input := &s3.GetBucketEncryptionInput{
+		Bucket: aws.String(bucket),
+	}
+
+	output, err := conn.GetBucketEncryption(input)
+
+	if tfawserr.ErrCodeEquals(err, s3.ErrCodeNoSuchBucket, ErrCodeServerSideEncryptionConfigurationNotFound) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.ServerSideEncryptionConfiguration == nil || len(output.ServerSideEncryptionConfiguration.Rules) == 0 {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output.ServerSideEncryptionConfiguration, nil
+}
+
+// FindBucketVersioning returns the versioning state of the specified bucket.
+// A bucket that has never had versioning enabled or suspended is treated as not found.
+func FindBucketVersioning(conn *s3.S3, bucket string) (*s3.GetBucketVersioningOutput, error) {
+	input := &s3.GetBucketVersioningInput{
+		Bucket: aws.String(bucket),
+	}
+
+	output, err := conn.GetBucketVersioning(input)
+
+	if tfawserr.ErrCodeEquals(err, s3.ErrCodeNoSuchBucket) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || aws.StringValue(output.Status) == "" {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output, nil
+}
+
+func FindBucketWebsite(conn *s3.S3, bucket string) (*s3.GetBucketWebsiteOutput, error) {
+	input := &s3.GetBucketWebsiteInput{
+		Bucket: aws.String(bucket),
+	}
+
+	output, err := conn.GetBucketWebsite(input)
+
+	if tfawserr.ErrCodeEquals(err, s3.ErrCodeNoSuchBucket, ErrCodeNoSuchWebsiteConfiguration) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output, nil
+}
+
+func FindBucketInventoryConfiguration(conn *s3.S3, bucket, id string) (*s3.InventoryConfiguration, error) {
+	input := &s3.GetBucketInventoryConfigurationInput{
+		Bucket: aws.String(bucket),
+		Id:     aws.String(id),
+	}
+
+	output, err := conn.GetBucketInventoryConfiguration(input)
+
+	if tfawserr.ErrCodeEquals(err, s3.ErrCodeNoSuchBucket, ErrCodeNoSuchConfiguration) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.InventoryConfiguration == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output.InventoryConfiguration, nil
+}
+
+func FindBucketLifecycleConfiguration(conn *s3.S3, bucket string) ([]*s3.LifecycleRule, error) {
+	input := &s3.GetBucketLifecycleConfigurationInput{
+		Bucket: aws.String(bucket),
+	}
+
+	output, err := conn.GetBucketLifecycleConfiguration(input)
+
+	if tfawserr.ErrCodeEquals(err, s3.ErrCodeNoSuchBucket, ErrCodeNoSuchLifecycleConfiguration) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || len(output.Rules) == 0 {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output.Rules, nil
+}
@@ -1,9 +1,13 @@
 package s3
 
 import (
+	"fmt"
+
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/s3"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
 )
 
 func ExpandAccessControlTranslation(l []interface{}) *s3.AccessControlTranslation {
@@ -681,3 +685,246 @@ func FlattenSseKmsEncryptedObjects(objects *s3.SseKmsEncryptedObjects) []interfa
 
 	return []interface{}{m}
 }
+
+// Filter predicates shared by the analytics, inventory and metrics configuration filters.
+const (
+	filterPredicateAccessPoint = "access_point"
+	filterPredicatePrefix      = "prefix"
+	filterPredicateTags        = "tags"
+)
+
+// s3Filter is the common model of the analytics, inventory and metrics configuration filters.
+// Each configuration type supports a subset of the predicates:
+//   - analytics: prefix and tags
+//   - inventory: prefix
+//   - metrics: access point, prefix and tags
+//
+// Multiple predicates are combined with a logical AND.
+type s3Filter struct {
+	AccessPointARN string
+	Prefix         string
+	Tags           []*s3.Tag
+}
+
+func (f *s3Filter) isEmpty() bool {
+	return f == nil || (f.AccessPointARN == "" && f.Prefix == "" && len(f.Tags) == 0)
+}
+
+// predicateCount returns the number of predicates that must be combined with a logical AND.
+func (f *s3Filter) predicateCount() int {
+	n := len(f.Tags)
+
+	if f.AccessPointARN != "" {
+		n++
+	}
+
+	if f.Prefix != "" {
+		n++
+	}
+
+	return n
+}
+
+// filterSchema returns the schema of a filter configuration block supporting the specified predicates.
+func filterSchema(predicates ...string) *schema.Schema {
+	var atLeastOneOf []string
+	if len(predicates) > 1 {
+		for _, predicate := range predicates {
+			atLeastOneOf = append(atLeastOneOf, fmt.Sprintf("filter.0.%s", predicate))
+		}
+	}
+
+	s := map[string]*schema.Schema{}
+
+	for _, predicate := range predicates {
+		switch predicate {
+		case filterPredicateAccessPoint:
+			s[predicate] = &schema.Schema{
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: verify.ValidARN,
+				AtLeastOneOf: atLeastOneOf,
+			}
+		case filterPredicatePrefix:
+			s[predicate] = &schema.Schema{
+				Type:         schema.TypeString,
+				Optional:     true,
+				AtLeastOneOf: atLeastOneOf,
+			}
+		case filterPredicateTags:
+			s[predicate] = &schema.Schema{
+				Type:         schema.TypeMap,
+				Optional:     true,
+				Elem:         &schema.Schema{Type: schema.TypeString},
+				AtLeastOneOf: atLeastOneOf,
+			}
+		}
+	}
+
+	return &schema.Schema{
+		Type:     schema.TypeList,
+		Optional: true,
+		MaxItems: 1,
+		Elem: &schema.Resource{
+			Schema: s,
+		},
+	}
+}
+
+func expandS3Filter(tfMap map[string]interface{}) *s3Filter {
+	if tfMap == nil {
+		return nil
+	}
+
+	filter := &s3Filter{}
+
+	if v, ok := tfMap[filterPredicateAccessPoint].(string); ok {
+		filter.AccessPointARN = v
+	}
+
+	if v, ok := tfMap[filterPredicatePrefix].(string); ok {
+		filter.Prefix = v
+	}
+
+	if v, ok := tfMap[filterPredicateTags].(map[string]interface{}); ok && len(v) > 0 {
+		filter.Tags = Tags(tftags.New(v).IgnoreAWS())
+	}
+
+	return filter
+}
+
+func flattenS3Filter(filter *s3Filter) map[string]interface{} {
+	if filter == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{}
+
+	if v := filter.AccessPointARN; v != "" {
+		tfMap[filterPredicateAccessPoint] = v
+	}
+
+	if v := filter.Prefix; v != "" {
+		tfMap[filterPredicatePrefix] = v
+	}
+
+	if v := filter.Tags; len(v) > 0 {
+		tfMap[filterPredicateTags] = KeyValueTags(v).IgnoreAWS().Map()
+	}
+
+	return tfMap
+}
+
+func analyticsFilterFromS3Filter(filter *s3Filter) *s3.AnalyticsFilter {
+	if filter.isEmpty() {
+		return nil
+	}
+
+	apiObject := &s3.AnalyticsFilter{}
+
+	switch {
+	case filter.predicateCount() > 1:
+		apiObject.And = &s3.AnalyticsAndOperator{
+			Tags: filter.Tags,
+		}
+		if filter.Prefix != "" {
+			apiObject.And.Prefix = aws.String(filter.Prefix)
+		}
+	case len(filter.Tags) == 1:
+		apiObject.Tag = filter.Tags[0]
+	default:
+		apiObject.Prefix = aws.String(filter.Prefix)
+	}
+
+	return apiObject
+}
+
+func s3FilterFromAnalyticsFilter(apiObject *s3.AnalyticsFilter) *s3Filter {
+	if apiObject == nil {
+		return nil
+	}
+
+	filter := &s3Filter{}
+
+	if v := apiObject.And; v != nil {
+		filter.Prefix = aws.StringValue(v.Prefix)
+		filter.Tags = v.Tags
+	} else if v := apiObject.Tag; v != nil {
+		filter.Tags = []*s3.Tag{v}
+	} else {
+		filter.Prefix = aws.StringValue(apiObject.Prefix)
+	}
+
+	return filter
+}
+
+func inventoryFilterFromS3Filter(filter *s3Filter) *s3.InventoryFilter {
+	if filter == nil {
+		return nil
+	}
+
+	return &s3.InventoryFilter{
+		Prefix: aws.String(filter.Prefix),
+	}
+}
+
+func s3FilterFromInventoryFilter(apiObject *s3.InventoryFilter) *s3Filter {
+	if apiObject == nil {
+		return nil
+	}
+
+	return &s3Filter{
+		Prefix: aws.StringValue(apiObject.Prefix),
+	}
+}
+
+func metricsFilterFromS3Filter(filter *s3Filter) *s3.MetricsFilter {
+	if filter == nil {
+		return nil
+	}
+
+	apiObject := &s3.MetricsFilter{}
+
+	switch {
+	case filter.predicateCount() > 1:
+		apiObject.And = &s3.MetricsAndOperator{
+			Tags: filter.Tags,
+		}
+		if filter.AccessPointARN != "" {
+			apiObject.And.AccessPointArn = aws.String(filter.AccessPointARN)
+		}
+		if filter.Prefix != "" {
+			apiObject.And.Prefix = aws.String(filter.Prefix)
+		}
+	case filter.AccessPointARN != "":
+		apiObject.AccessPointArn = aws.String(filter.AccessPointARN)
+	case len(filter.Tags) == 1:
+		apiObject.Tag = filter.Tags[0]
+	default:
+		apiObject.Prefix = aws.String(filter.Prefix)
+	}
+
+	return apiObject
+}
+
+func s3FilterFromMetricsFilter(apiObject *s3.MetricsFilter) *s3Filter {
+	if apiObject == nil {
+		return nil
+	}
+
+	filter := &s3Filter{}
+
+	if v := apiObject.And; v != nil {
+		filter.AccessPointARN = aws.StringValue(v.AccessPointArn)
+		filter.Prefix = aws.StringValue(v.Prefix)
+		filter.Tags = v.Tags
+	} else if v := apiObject.AccessPointArn; v != nil {
+		filter.AccessPointARN = aws.StringValue(v)
+	} else if v := apiObject.Tag; v != nil {
+		filter.Tags = []*s3.Tag{v}
+	} else {
+		filter.Prefix = aws.StringValue(apiObject.Prefix)
+	}
+
+	return filter
+}
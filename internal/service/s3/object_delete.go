@@ -0,0 +1,316 @@
+package s3
+
+import (
+	"fmt"
+	"log"
+	"sync"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/s3"
+	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
+)
+
+const (
+	// deleteObjectsMaxKeys is the maximum number of keys in a DeleteObjects request.
+	deleteObjectsMaxKeys = 1000
+
+	// deleteObjectsConcurrency is the number of DeleteObjects requests in flight at any one time.
+	deleteObjectsConcurrency = 10
+)
+
+// DeleteAllObjectVersions deletes all versions of a specified key from an S3 bucket.
+// If key is empty then all versions of all objects are deleted.
+// Set force to true to override any S3 object lock protections on object lock enabled buckets,
+// i.e. to bypass governance-mode retention and to remove any legal holds.
+// Object versions are deleted in batches of up to 1,000 keys using concurrent DeleteObjects requests.
+func DeleteAllObjectVersions(conn *s3.S3, bucketName, key string, force, ignoreObjectErrors bool) error {
+	input := &s3.ListObjectVersionsInput{
+		Bucket: aws.String(bucketName),
+	}
+	if key != "" {
+		input.Prefix = aws.String(key)
+	}
+
+	deleter := newObjectVersionsDeleter(conn, bucketName, force)
+
+	err := conn.ListObjectVersionsPages(input, func(page *s3.ListObjectVersionsOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, objectVersion := range page.Versions {
+			if key != "" && key != aws.StringValue(objectVersion.Key) {
+				continue
+			}
+
+			deleter.add(objectVersion.Key, objectVersion.VersionId)
+		}
+
+		return !lastPage
+	})
+
+	deleted, lastErr := deleter.wait()
+
+	if tfawserr.ErrMessageContains(err, s3.ErrCodeNoSuchBucket, "") {
+		err = nil
+	}
+
+	if err != nil {
+		return err
+	}
+
+	log.Printf("[INFO] Deleted %d S3 Bucket (%s) object versions", deleted, bucketName)
+
+	if lastErr != nil {
+		if !ignoreObjectErrors {
+			return fmt.Errorf("error deleting at least one object version, last error: %s", lastErr)
+		}
+
+		lastErr = nil
+	}
+
+	// Delete markers have no object lock protections.
+	deleter = newObjectVersionsDeleter(conn, bucketName, false)
+
+	err = conn.ListObjectVersionsPages(input, func(page *s3.ListObjectVersionsOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, deleteMarker := range page.DeleteMarkers {
+			if key != "" && key != aws.StringValue(deleteMarker.Key) {
+				continue
+			}
+
+			deleter.add(deleteMarker.Key, deleteMarker.VersionId)
+		}
+
+		return !lastPage
+	})
+
+	deleted, lastErr = deleter.wait()
+
+	if tfawserr.ErrMessageContains(err, s3.ErrCodeNoSuchBucket, "") {
+		err = nil
+	}
+
+	if err != nil {
+		return err
+	}
+
+	log.Printf("[INFO] Deleted %d S3 Bucket (%s) object delete markers", deleted, bucketName)
+
+	if lastErr != nil {
+		if !ignoreObjectErrors {
+			return fmt.Errorf("error deleting at least one object delete marker, last error: %s", lastErr)
+		}
+	}
+
+	return nil
+}
+
+// objectVersionsDeleter batches object versions and deletes each batch with a DeleteObjects request.
+// Batches are deleted concurrently while further object versions are being added.
+type objectVersionsDeleter struct {
+	bucket string
+	conn   *s3.S3
+	force  bool
+
+	pending   []*s3.ObjectIdentifier
+	semaphore chan struct{}
+	wg        sync.WaitGroup
+
+	mu      sync.Mutex
+	deleted int
+	lastErr error
+}
+
+func newObjectVersionsDeleter(conn *s3.S3, bucket string, force bool) *objectVersionsDeleter {
+	return &objectVersionsDeleter{
+		bucket:    bucket,
+		conn:      conn,
+		force:     force,
+		semaphore: make(chan struct{}, deleteObjectsConcurrency),
+	}
+}
+
+// add queues the specified object version for deletion.
+func (d *objectVersionsDeleter) add(key, versionID *string) {
+	objectIdentifier := &s3.ObjectIdentifier{
+		Key: key,
+	}
+	if aws.StringValue(versionID) != "" {
+		objectIdentifier.VersionId = versionID
+	}
+
+	d.pending = append(d.pending, objectIdentifier)
+
+	if len(d.pending) == deleteObjectsMaxKeys {
+		d.flush()
+	}
+}
+
+// flush starts deletion of any pending object versions.
+func (d *objectVersionsDeleter) flush() {
+	if len(d.pending) == 0 {
+		return
+	}
+
+	objects := d.pending
+	d.pending = nil
+
+	d.wg.Add(1)
+	d.semaphore <- struct{}{}
+
+	go func() {
+		defer func() {
+			<-d.semaphore
+			d.wg.Done()
+		}()
+
+		d.deleteObjects(objects)
+	}()
+}
+
+// wait deletes any pending object versions and waits for all deletions to complete.
+// It returns the number of object versions deleted and the last error encountered.
+func (d *objectVersionsDeleter) wait() (int, error) {
+	d.flush()
+	d.wg.Wait()
+
+	return d.deleted, d.lastErr
+}
+
+func (d *objectVersionsDeleter) deleteObjects(objects []*s3.ObjectIdentifier) {
+	input := &s3.DeleteObjectsInput{
+		Bucket: aws.String(d.bucket),
+		Delete: &s3.Delete{
+			Objects: objects,
+			Quiet:   aws.Bool(true),
+		},
+	}
+
+	if d.force {
+		input.BypassGovernanceRetention = aws.Bool(true)
+	}
+
+	log.Printf("[DEBUG] Deleting %d S3 Bucket (%s) object versions", len(objects), d.bucket)
+	output, err := d.conn.DeleteObjects(input)
+
+	if tfawserr.ErrMessageContains(err, s3.ErrCodeNoSuchBucket, "") {
+		return
+	}
+
+	if err != nil {
+		d.update(0, fmt.Errorf("error deleting S3 Bucket (%s) objects: %w", d.bucket, err))
+		return
+	}
+
+	deleted := len(objects)
+	var lastErr error
+
+	for _, v := range output.Errors {
+		objectKey := aws.StringValue(v.Key)
+		objectVersionID := aws.StringValue(v.VersionId)
+		code := aws.StringValue(v.Code)
+
+		if code == s3.ErrCodeNoSuchKey {
+			continue
+		}
+
+		deleted--
+
+		if code == "AccessDenied" && d.force {
+			if err := d.removeLegalHoldAndDeleteObject(objectKey, objectVersionID); err != nil {
+				lastErr = err
+				continue
+			}
+
+			deleted++
+			continue
+		}
+
+		log.Printf("[WARN] Error deleting S3 Bucket (%s) Object (%s) Version (%s): %s: %s", d.bucket, objectKey, objectVersionID, code, aws.StringValue(v.Message))
+		lastErr = fmt.Errorf("%s deleting S3 Bucket (%s) Object (%s) Version: %s", code, d.bucket, objectKey, objectVersionID)
+	}
+
+	d.update(deleted, lastErr)
+}
+
+// removeLegalHoldAndDeleteObject removes any legal hold on the specified object version and then deletes it.
+func (d *objectVersionsDeleter) removeLegalHoldAndDeleteObject(key, versionID string) error {
+	resp, err := d.conn.HeadObject(&s3.HeadObjectInput{
+		Bucket:    aws.String(d.bucket),
+		Key:       aws.String(key),
+		VersionId: aws.String(versionID),
+	})
+
+	if err != nil {
+		log.Printf("[ERROR] Error getting S3 Bucket (%s) Object (%s) Version (%s) metadata: %s", d.bucket, key, versionID, err)
+		return err
+	}
+
+	if aws.StringValue(resp.ObjectLockLegalHoldStatus) != s3.ObjectLockLegalHoldStatusOn {
+		// AccessDenied for another reason.
+		return fmt.Errorf("AccessDenied deleting S3 Bucket (%s) Object (%s) Version: %s", d.bucket, key, versionID)
+	}
+
+	_, err = d.conn.PutObjectLegalHold(&s3.PutObjectLegalHoldInput{
+		Bucket:    aws.String(d.bucket),
+		Key:       aws.String(key),
+		VersionId: aws.String(versionID),
+		LegalHold: &s3.ObjectLockLegalHold{
+			Status: aws.String(s3.ObjectLockLegalHoldStatusOff),
+		},
+	})
+
+	if err != nil {
+		log.Printf("[ERROR] Error putting S3 Bucket (%s) Object (%s) Version(%s) legal hold: %s", d.bucket, key, versionID, err)
+		return err
+	}
+
+	// Attempt to delete again.
+	return deleteS3ObjectVersion(d.conn, d.bucket, key, versionID, d.force)
+}
+
+func (d *objectVersionsDeleter) update(deleted int, err error) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	d.deleted += deleted
+	if err != nil {
+		d.lastErr = err
+	}
+
+	log.Printf("[INFO] Deleted %d S3 Bucket (%s) object versions so far", d.deleted, d.bucket)
+}
+
+// deleteS3ObjectVersion deletes a specific bucket object version.
+// Set force to true to override any S3 object lock protections.
+func deleteS3ObjectVersion(conn *s3.S3, b, k, v string, force bool) error {
+	input := &s3.DeleteObjectInput{
+		Bucket: aws.String(b),
+		Key:    aws.String(k),
+	}
+
+	if v != "" {
+		input.VersionId = aws.String(v)
+	}
+
+	if force {
+		input.BypassGovernanceRetention = aws.Bool(true)
+	}
+
+	log.Printf("[INFO] Deleting S3 Bucket (%s) Object (%s) Version: %s", b, k, v)
+	_, err := conn.DeleteObject(input)
+
+	if err != nil {
+		log.Printf("[WARN] Error deleting S3 Bucket (%s) Object (%s) Version (%s): %s", b, k, v, err)
+	}
+
+	if tfawserr.ErrMessageContains(err, s3.ErrCodeNoSuchBucket, "") || tfawserr.ErrMessageContains(err, s3.ErrCodeNoSuchKey, "") {
+		return nil
+	}
+
+	return err
+}
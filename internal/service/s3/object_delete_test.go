@@ -0,0 +1,233 @@
+package s3
+
+import (
+	"encoding/xml"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"sort"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/credentials"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/aws-sdk-go/service/s3"
+)
+
+func TestDeleteAllObjectVersions(t *testing.T) {
+	testCases := []struct {
+		Name                 string
+		Force                bool
+		Versions             int
+		Locked               int
+		DeleteMarkers        int
+		ExpectedError        bool
+		ExpectedDeleteCalls  int
+		ExpectedRemaining    int
+		ExpectedBypassHeader bool
+	}{
+		{
+			Name:                "single batch",
+			Versions:            10,
+			DeleteMarkers:       2,
+			ExpectedDeleteCalls: 2,
+		},
+		{
+			Name:                "multiple batches",
+			Versions:            2500,
+			DeleteMarkers:       1001,
+			ExpectedDeleteCalls: 5,
+		},
+		{
+			Name:                "governance retention",
+			Versions:            1200,
+			Locked:              3,
+			ExpectedError:       true,
+			ExpectedDeleteCalls: 2,
+			ExpectedRemaining:   3,
+		},
+		{
+			Name:                 "governance retention bypassed",
+			Force:                true,
+			Versions:             1200,
+			Locked:               3,
+			ExpectedDeleteCalls:  2,
+			ExpectedBypassHeader: true,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			server := newTestObjectVersionsServer(testCase.Versions, testCase.Locked, testCase.DeleteMarkers)
+			ts := httptest.NewServer(server)
+			defer ts.Close()
+
+			sess, err := session.NewSession(&aws.Config{
+				Credentials:      credentials.NewStaticCredentials("AKID", "SECRET", ""),
+				Endpoint:         aws.String(ts.URL),
+				Region:           aws.String("us-east-1"), //lintignore:AWSAT003
+				S3ForcePathStyle: aws.Bool(true),
+			})
+
+			if err != nil {
+				t.Fatalf("error creating session: %s", err)
+			}
+
+			err = DeleteAllObjectVersions(s3.New(sess), "test-bucket", "", testCase.Force, false)
+
+			if testCase.ExpectedError && err == nil {
+				t.Fatal("expected error, got none")
+			}
+
+			if !testCase.ExpectedError && err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if got := server.deleteCalls; got != testCase.ExpectedDeleteCalls {
+				t.Errorf("got %d DeleteObjects requests, expected %d", got, testCase.ExpectedDeleteCalls)
+			}
+
+			if got := len(server.versions); got != testCase.ExpectedRemaining {
+				t.Errorf("got %d remaining object versions, expected %d", got, testCase.ExpectedRemaining)
+			}
+
+			if got := server.bypassHeader; got != testCase.ExpectedBypassHeader {
+				t.Errorf("got bypass governance retention header %t, expected %t", got, testCase.ExpectedBypassHeader)
+			}
+		})
+	}
+}
+
+type testObjectVersion struct {
+	Key          string
+	VersionID    string
+	DeleteMarker bool
+	Locked       bool
+}
+
+// testObjectVersionsServer implements the subset of the S3 API used by DeleteAllObjectVersions.
+type testObjectVersionsServer struct {
+	mu           sync.Mutex
+	bypassHeader bool
+	deleteCalls  int
+	versions     map[string]testObjectVersion
+}
+
+func newTestObjectVersionsServer(versions, locked, deleteMarkers int) *testObjectVersionsServer {
+	s := &testObjectVersionsServer{
+		versions: make(map[string]testObjectVersion),
+	}
+
+	for i := 0; i < versions+deleteMarkers; i++ {
+		v := testObjectVersion{
+			Key:          fmt.Sprintf("key-%05d", i),
+			VersionID:    fmt.Sprintf("version-%05d", i),
+			DeleteMarker: i >= versions,
+			Locked:       i < locked,
+		}
+		s.versions[v.Key] = v
+	}
+
+	return s
+}
+
+func (s *testObjectVersionsServer) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	query := r.URL.Query()
+
+	switch {
+	case r.Method == http.MethodGet && query.Has("versions"):
+		s.listObjectVersions(w, query.Get("key-marker"))
+
+	case r.Method == http.MethodPost && query.Has("delete"):
+		s.deleteObjects(w, r)
+
+	default:
+		http.Error(w, "unsupported request", http.StatusNotImplemented)
+	}
+}
+
+func (s *testObjectVersionsServer) listObjectVersions(w http.ResponseWriter, keyMarker string) {
+	var keys []string
+	for key := range s.versions {
+		if key > keyMarker {
+			keys = append(keys, key)
+		}
+	}
+	sort.Strings(keys)
+
+	// Smaller pages than the service to exercise batching across pages.
+	const maxKeys = 300
+	truncated := len(keys) > maxKeys
+	if truncated {
+		keys = keys[:maxKeys]
+	}
+
+	var sb strings.Builder
+	sb.WriteString(`<ListVersionsResult>`)
+	fmt.Fprintf(&sb, `<IsTruncated>%t</IsTruncated>`, truncated)
+	if truncated {
+		fmt.Fprintf(&sb, `<NextKeyMarker>%s</NextKeyMarker><NextVersionIdMarker>%s</NextVersionIdMarker>`, keys[len(keys)-1], s.versions[keys[len(keys)-1]].VersionID)
+	}
+	for _, key := range keys {
+		v := s.versions[key]
+		element := "Version"
+		if v.DeleteMarker {
+			element = "DeleteMarker"
+		}
+		fmt.Fprintf(&sb, `<%[1]s><Key>%[2]s</Key><VersionId>%[3]s</VersionId></%[1]s>`, element, v.Key, v.VersionID)
+	}
+	sb.WriteString(`</ListVersionsResult>`)
+
+	io.WriteString(w, sb.String())
+}
+
+func (s *testObjectVersionsServer) deleteObjects(w http.ResponseWriter, r *http.Request) {
+	var input struct {
+		Objects []struct {
+			Key       string
+			VersionId string
+		} `xml:"Object"`
+	}
+
+	if err := xml.NewDecoder(r.Body).Decode(&input); err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	if len(input.Objects) > deleteObjectsMaxKeys {
+		http.Error(w, "too many keys", http.StatusBadRequest)
+		return
+	}
+
+	s.deleteCalls++
+	bypass := r.Header.Get("x-amz-bypass-governance-retention") == "true"
+	if bypass {
+		s.bypassHeader = true
+	}
+
+	var sb strings.Builder
+	sb.WriteString(`<DeleteResult>`)
+	for _, object := range input.Objects {
+		v, ok := s.versions[object.Key]
+
+		if ok && v.VersionID != object.VersionId {
+			ok = false
+		}
+
+		if ok && v.Locked && !bypass {
+			fmt.Fprintf(&sb, `<Error><Key>%s</Key><VersionId>%s</VersionId><Code>AccessDenied</Code><Message>Access Denied</Message></Error>`, v.Key, v.VersionID)
+			continue
+		}
+
+		delete(s.versions, object.Key)
+	}
+	sb.WriteString(`</DeleteResult>`)
+
+	io.WriteString(w, sb.String())
+}
@@ -0,0 +1,203 @@
+package s3
+
+import (
+	"crypto/md5"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/s3"
+	"github.com/aws/aws-sdk-go/service/s3/s3manager"
+	"github.com/mitchellh/go-homedir"
+)
+
+const (
+	// Objects larger than this are uploaded using the multipart API unless
+	// multipart_threshold is configured.
+	objectMultipartThresholdDefault = 16 * 1024 * 1024
+
+	// Part size used for multipart uploads unless multipart_part_size is configured.
+	objectMultipartPartSizeDefault = 16 * 1024 * 1024
+
+	// Smallest part size that S3 accepts for all but the last part.
+	objectMinPartSize = 5 * 1024 * 1024
+
+	// Largest object (and part) that S3 accepts in a single request.
+	objectMaxSinglePartSize = 5 * 1024 * 1024 * 1024
+)
+
+// objectUploadOptions controls how an object body is split across requests.
+type objectUploadOptions struct {
+	Concurrency        int
+	MultipartThreshold int64
+	PartSize           int64
+}
+
+// expandObjectUploadOptions reads the multipart_* arguments from either a
+// schema.ResourceData or a schema.ResourceDiff, applying defaults for unset values.
+func expandObjectUploadOptions(d interface{ Get(string) interface{} }) objectUploadOptions {
+	opts := objectUploadOptions{
+		Concurrency:        s3manager.DefaultUploadConcurrency,
+		MultipartThreshold: objectMultipartThresholdDefault,
+		PartSize:           objectMultipartPartSizeDefault,
+	}
+
+	if v, ok := d.Get("multipart_concurrency").(int); ok && v > 0 {
+		opts.Concurrency = v
+	}
+
+	if v, ok := d.Get("multipart_threshold").(int); ok && v > 0 {
+		opts.MultipartThreshold = int64(v)
+	}
+
+	if v, ok := d.Get("multipart_part_size").(int); ok && v > 0 {
+		opts.PartSize = int64(v)
+	}
+
+	return opts
+}
+
+// multipart returns whether an object of the given size is uploaded using the multipart API.
+func (o objectUploadOptions) multipart(size int64) bool {
+	return size > o.MultipartThreshold
+}
+
+// partSize returns the part size used to upload an object of the given size.
+// Objects no larger than the multipart threshold get a part size that covers
+// the whole body so that s3manager sends a single PutObject request.
+func (o objectUploadOptions) partSize(size int64) int64 {
+	if !o.multipart(size) {
+		if size < s3manager.MinUploadPartSize {
+			return s3manager.MinUploadPartSize
+		}
+
+		return size
+	}
+
+	partSize := o.PartSize
+
+	if partSize < s3manager.MinUploadPartSize {
+		partSize = s3manager.MinUploadPartSize
+	}
+
+	// Mirror s3manager, which grows the part size when the object would
+	// otherwise need more than MaxUploadParts parts.
+	if partSize*s3manager.MaxUploadParts < size {
+		partSize = size/s3manager.MaxUploadParts + 1
+	}
+
+	return partSize
+}
+
+// uploadObject uploads body, switching to concurrent multipart uploads for
+// bodies larger than the configured threshold.
+func uploadObject(conn *s3.S3, input *s3manager.UploadInput, body io.ReadSeeker, opts objectUploadOptions) (*s3manager.UploadOutput, error) {
+	size, err := aws.SeekerLen(body)
+
+	if err != nil {
+		return nil, fmt.Errorf("error determining object size: %w", err)
+	}
+
+	input.Body = body
+
+	uploader := s3manager.NewUploaderWithClient(conn, func(u *s3manager.Uploader) {
+		u.Concurrency = opts.Concurrency
+		u.PartSize = opts.partSize(size)
+	})
+
+	return uploader.Upload(input)
+}
+
+// computeObjectETag streams r, which must contain exactly size bytes, and
+// returns its MD5 together with the ETag S3 assigns to the same unencrypted
+// content uploaded with partSize. A multipart ETag is the MD5 of the
+// concatenated part MD5s followed by "-" and the part count.
+func computeObjectETag(r io.Reader, size, partSize int64) (string, string, error) {
+	whole := md5.New()
+
+	if size <= partSize {
+		if _, err := io.Copy(whole, r); err != nil {
+			return "", "", err
+		}
+
+		sum := hex.EncodeToString(whole.Sum(nil))
+
+		return sum, sum, nil
+	}
+
+	var partSums []byte
+	var parts int
+
+	for remaining := size; remaining > 0; remaining -= partSize {
+		n := partSize
+
+		if remaining < n {
+			n = remaining
+		}
+
+		part := md5.New()
+
+		if _, err := io.CopyN(io.MultiWriter(whole, part), r, n); err != nil {
+			return "", "", err
+		}
+
+		partSums = part.Sum(partSums)
+		parts++
+	}
+
+	sum := md5.Sum(partSums)
+
+	return hex.EncodeToString(whole.Sum(nil)), fmt.Sprintf("%s-%d", hex.EncodeToString(sum[:]), parts), nil
+}
+
+// sourceObjectSize returns the size of the file at source without reading it.
+func sourceObjectSize(source string) (int64, error) {
+	path, err := homedir.Expand(source)
+
+	if err != nil {
+		return 0, err
+	}
+
+	info, err := os.Stat(path)
+
+	if err != nil {
+		return 0, err
+	}
+
+	return info.Size(), nil
+}
+
+// computeSourceObjectETag returns the MD5 and expected S3 ETag of the file at source.
+func computeSourceObjectETag(source string, opts objectUploadOptions) (string, string, error) {
+	path, err := homedir.Expand(source)
+
+	if err != nil {
+		return "", "", err
+	}
+
+	file, err := os.Open(path)
+
+	if err != nil {
+		return "", "", err
+	}
+
+	defer file.Close()
+
+	info, err := file.Stat()
+
+	if err != nil {
+		return "", "", err
+	}
+
+	size := info.Size()
+
+	return computeObjectETag(file, size, opts.partSize(size))
+}
+
+// isMultipartObjectETag returns whether etag was assigned by a multipart upload.
+func isMultipartObjectETag(etag string) bool {
+	return strings.Contains(etag, "-")
+}
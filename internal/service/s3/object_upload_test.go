@@ -0,0 +1,373 @@
+package s3
+
+import (
+	"bytes"
+	"crypto/md5"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/credentials"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/aws-sdk-go/service/s3"
+	"github.com/aws/aws-sdk-go/service/s3/s3manager"
+)
+
+const testMiB = 1024 * 1024
+
+func TestObjectUploadOptionsPartSize(t *testing.T) {
+	testCases := []struct {
+		Name     string
+		Options  objectUploadOptions
+		Size     int64
+		Expected int64
+	}{
+		{
+			Name:     "empty object",
+			Options:  objectUploadOptions{MultipartThreshold: 16 * testMiB, PartSize: 8 * testMiB},
+			Size:     0,
+			Expected: s3manager.MinUploadPartSize,
+		},
+		{
+			Name:     "below threshold",
+			Options:  objectUploadOptions{MultipartThreshold: 16 * testMiB, PartSize: 8 * testMiB},
+			Size:     12 * testMiB,
+			Expected: 12 * testMiB,
+		},
+		{
+			Name:     "at threshold",
+			Options:  objectUploadOptions{MultipartThreshold: 16 * testMiB, PartSize: 8 * testMiB},
+			Size:     16 * testMiB,
+			Expected: 16 * testMiB,
+		},
+		{
+			Name:     "above threshold",
+			Options:  objectUploadOptions{MultipartThreshold: 16 * testMiB, PartSize: 8 * testMiB},
+			Size:     17 * testMiB,
+			Expected: 8 * testMiB,
+		},
+		{
+			Name:     "too many parts",
+			Options:  objectUploadOptions{MultipartThreshold: 16 * testMiB, PartSize: 5 * testMiB},
+			Size:     100000 * testMiB,
+			Expected: 10*testMiB + 1,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			if got := testCase.Options.partSize(testCase.Size); got != testCase.Expected {
+				t.Errorf("got part size %d, expected %d", got, testCase.Expected)
+			}
+		})
+	}
+}
+
+func TestObjectUploadOptionsMultipart(t *testing.T) {
+	opts := objectUploadOptions{MultipartThreshold: 16 * testMiB, PartSize: 8 * testMiB}
+
+	for size, expected := range map[int64]bool{
+		0:                       false,
+		16 * testMiB:            false,
+		16*testMiB + 1:          true,
+		objectMaxSinglePartSize: true,
+	} {
+		if got := opts.multipart(size); got != expected {
+			t.Errorf("size %d: got multipart %t, expected %t", size, got, expected)
+		}
+	}
+}
+
+func TestSourceObjectSize(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "source")
+
+	if err := os.WriteFile(path, bytes.Repeat([]byte{'x'}, 11*testMiB), 0600); err != nil {
+		t.Fatalf("error writing source: %s", err)
+	}
+
+	size, err := sourceObjectSize(path)
+
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if expected := int64(11 * testMiB); size != expected {
+		t.Errorf("got size %d, expected %d", size, expected)
+	}
+
+	if _, err := sourceObjectSize(filepath.Join(t.TempDir(), "missing")); err == nil {
+		t.Error("expected error for missing source, got none")
+	}
+}
+
+func TestComputeObjectETag(t *testing.T) {
+	body := bytes.Repeat([]byte("0123456789abcdef"), 768*1024) // 12 MiB
+	sum := md5.Sum(body)
+	md5Hex := hex.EncodeToString(sum[:])
+
+	testCases := []struct {
+		Name         string
+		PartSize     int64
+		ExpectedETag string
+	}{
+		{
+			Name:         "single part",
+			PartSize:     int64(len(body)),
+			ExpectedETag: md5Hex,
+		},
+		{
+			Name:         "multipart",
+			PartSize:     5 * testMiB,
+			ExpectedETag: testMultipartETag(body, 5*testMiB),
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			gotMD5, gotETag, err := computeObjectETag(bytes.NewReader(body), int64(len(body)), testCase.PartSize)
+
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if gotMD5 != md5Hex {
+				t.Errorf("got MD5 %s, expected %s", gotMD5, md5Hex)
+			}
+
+			if gotETag != testCase.ExpectedETag {
+				t.Errorf("got ETag %s, expected %s", gotETag, testCase.ExpectedETag)
+			}
+		})
+	}
+
+	if !strings.HasSuffix(testCases[1].ExpectedETag, "-3") {
+		t.Errorf("expected 3 part ETag, got %s", testCases[1].ExpectedETag)
+	}
+
+	if _, _, err := computeObjectETag(bytes.NewReader(body[:testMiB]), int64(len(body)), 5*testMiB); err == nil {
+		t.Error("expected error for short read, got none")
+	}
+}
+
+func TestComputeSourceObjectETag(t *testing.T) {
+	body := bytes.Repeat([]byte{'x'}, 11*testMiB)
+	path := filepath.Join(t.TempDir(), "source")
+
+	if err := os.WriteFile(path, body, 0600); err != nil {
+		t.Fatalf("error writing source: %s", err)
+	}
+
+	_, etag, err := computeSourceObjectETag(path, objectUploadOptions{MultipartThreshold: 5 * testMiB, PartSize: 5 * testMiB})
+
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if expected := testMultipartETag(body, 5*testMiB); etag != expected {
+		t.Errorf("got ETag %s, expected %s", etag, expected)
+	}
+}
+
+func TestUploadObject(t *testing.T) {
+	testCases := []struct {
+		Name                 string
+		Options              objectUploadOptions
+		Size                 int
+		ExpectedPutObjects   int
+		ExpectedUploadParts  int
+		ExpectedMultipartTag bool
+	}{
+		{
+			Name:               "single part",
+			Options:            objectUploadOptions{Concurrency: 2, MultipartThreshold: objectMultipartThresholdDefault, PartSize: objectMultipartPartSizeDefault},
+			Size:               6 * testMiB,
+			ExpectedPutObjects: 1,
+		},
+		{
+			Name:                 "multipart",
+			Options:              objectUploadOptions{Concurrency: 3, MultipartThreshold: 5 * testMiB, PartSize: 5 * testMiB},
+			Size:                 12 * testMiB,
+			ExpectedUploadParts:  3,
+			ExpectedMultipartTag: true,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			server := newTestS3Server()
+			ts := httptest.NewServer(server)
+			defer ts.Close()
+
+			sess, err := session.NewSession(&aws.Config{
+				Credentials:      credentials.NewStaticCredentials("AKID", "SECRET", ""),
+				Endpoint:         aws.String(ts.URL),
+				Region:           aws.String("us-east-1"), //lintignore:AWSAT003
+				S3ForcePathStyle: aws.Bool(true),
+			})
+
+			if err != nil {
+				t.Fatalf("error creating session: %s", err)
+			}
+
+			body := make([]byte, testCase.Size)
+			for i := range body {
+				body[i] = byte(i % 251)
+			}
+
+			input := &s3manager.UploadInput{
+				Bucket: aws.String("test-bucket"),
+				Key:    aws.String("test-key"),
+			}
+
+			output, err := uploadObject(s3.New(sess), input, bytes.NewReader(body), testCase.Options)
+
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if got := server.putObjects; got != testCase.ExpectedPutObjects {
+				t.Errorf("got %d PutObject requests, expected %d", got, testCase.ExpectedPutObjects)
+			}
+
+			if got := server.uploadParts; got != testCase.ExpectedUploadParts {
+				t.Errorf("got %d UploadPart requests, expected %d", got, testCase.ExpectedUploadParts)
+			}
+
+			if !bytes.Equal(server.objects["test-bucket/test-key"], body) {
+				t.Error("uploaded object does not match body")
+			}
+
+			_, expectedETag, err := computeObjectETag(bytes.NewReader(body), int64(len(body)), testCase.Options.partSize(int64(len(body))))
+
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if isMultipartObjectETag(expectedETag) != testCase.ExpectedMultipartTag {
+				t.Errorf("unexpected ETag form: %s", expectedETag)
+			}
+
+			// The uploader only returns the ETag for single part uploads.
+			gotETag := server.etags["test-bucket/test-key"]
+
+			if output.ETag != nil {
+				gotETag = strings.Trim(aws.StringValue(output.ETag), `"`)
+			}
+
+			if gotETag != expectedETag {
+				t.Errorf("got ETag %s, expected %s", gotETag, expectedETag)
+			}
+		})
+	}
+}
+
+func testMultipartETag(body []byte, partSize int) string {
+	var sums []byte
+	var parts int
+
+	for i := 0; i < len(body); i += partSize {
+		end := i + partSize
+		if end > len(body) {
+			end = len(body)
+		}
+
+		sum := md5.Sum(body[i:end])
+		sums = append(sums, sum[:]...)
+		parts++
+	}
+
+	sum := md5.Sum(sums)
+
+	return fmt.Sprintf("%s-%d", hex.EncodeToString(sum[:]), parts)
+}
+
+// testS3Server implements the subset of the S3 API used by s3manager.Uploader.
+type testS3Server struct {
+	mu          sync.Mutex
+	etags       map[string]string
+	objects     map[string][]byte
+	parts       map[string]map[int][]byte
+	putObjects  int
+	uploadParts int
+}
+
+func newTestS3Server() *testS3Server {
+	return &testS3Server{
+		etags:   make(map[string]string),
+		objects: make(map[string][]byte),
+		parts:   make(map[string]map[int][]byte),
+	}
+}
+
+func (s *testS3Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	key := strings.TrimPrefix(r.URL.Path, "/")
+	query := r.URL.Query()
+
+	body, err := io.ReadAll(r.Body)
+
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	switch {
+	case r.Method == http.MethodPut && query.Get("uploadId") == "":
+		s.putObjects++
+		s.objects[key] = body
+		sum := md5.Sum(body)
+		w.Header().Set("ETag", fmt.Sprintf("%q", hex.EncodeToString(sum[:])))
+
+	case r.Method == http.MethodPost && query.Has("uploads"):
+		s.parts[key] = make(map[int][]byte)
+		fmt.Fprintf(w, `<InitiateMultipartUploadResult><Key>%s</Key><UploadId>upload-1</UploadId></InitiateMultipartUploadResult>`, key)
+
+	case r.Method == http.MethodPut:
+		partNumber, err := strconv.Atoi(query.Get("partNumber"))
+
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+
+		s.uploadParts++
+		s.parts[key][partNumber] = body
+		sum := md5.Sum(body)
+		w.Header().Set("ETag", fmt.Sprintf("%q", hex.EncodeToString(sum[:])))
+
+	case r.Method == http.MethodPost:
+		var partNumbers []int
+		for partNumber := range s.parts[key] {
+			partNumbers = append(partNumbers, partNumber)
+		}
+		sort.Ints(partNumbers)
+
+		var object, sums []byte
+		for _, partNumber := range partNumbers {
+			part := s.parts[key][partNumber]
+			object = append(object, part...)
+			sum := md5.Sum(part)
+			sums = append(sums, sum[:]...)
+		}
+
+		sum := md5.Sum(sums)
+		s.objects[key] = object
+		s.etags[key] = fmt.Sprintf("%s-%d", hex.EncodeToString(sum[:]), len(partNumbers))
+		fmt.Fprintf(w, `<CompleteMultipartUploadResult><Key>%s</Key><ETag>"%s"</ETag></CompleteMultipartUploadResult>`, key, s.etags[key])
+
+	default:
+		http.Error(w, "unsupported request", http.StatusNotImplemented)
+	}
+}
@@ -2,6 +2,7 @@ package s3
 
 import (
 	"fmt"
+	"strings"
 	"time"
 )
 
@@ -15,3 +16,45 @@ func validBucketLifecycleTimestamp(v interface{}, k string) (ws []string, errors
 
 	return
 }
+
+// validLifecycleRuleFilter returns an error if a lifecycle rule filter specifies more than
+// one condition, as S3 accepts only one. Several conditions must be combined with "and".
+func validLifecycleRuleFilter(tfList []interface{}) error {
+	if len(tfList) == 0 || tfList[0] == nil {
+		return nil
+	}
+
+	tfMap, ok := tfList[0].(map[string]interface{})
+
+	if !ok {
+		return nil
+	}
+
+	var conditions []string
+
+	if v, ok := tfMap["and"].([]interface{}); ok && len(v) > 0 {
+		conditions = append(conditions, "and")
+	}
+
+	if v, ok := tfMap["object_size_greater_than"].(int); ok && v != 0 {
+		conditions = append(conditions, "object_size_greater_than")
+	}
+
+	if v, ok := tfMap["object_size_less_than"].(int); ok && v != 0 {
+		conditions = append(conditions, "object_size_less_than")
+	}
+
+	if v, ok := tfMap["prefix"].(string); ok && v != "" {
+		conditions = append(conditions, "prefix")
+	}
+
+	if v, ok := tfMap["tag"].([]interface{}); ok && len(v) > 0 {
+		conditions = append(conditions, "tag")
+	}
+
+	if len(conditions) > 1 {
+		return fmt.Errorf("only one of and, object_size_greater_than, object_size_less_than, prefix or tag can be specified, got %s: use and to combine several conditions", strings.Join(conditions, ", "))
+	}
+
+	return nil
+}
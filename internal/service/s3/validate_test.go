@@ -29,3 +29,32 @@ func TestValidBucketLifecycleTimestamp(t *testing.T) {
 		}
 	}
 }
+
+func TestValidLifecycleRuleFilter(t *testing.T) {
+	validFilters := [][]interface{}{
+		nil,
+		{nil},
+		{map[string]interface{}{"prefix": "logs/"}},
+		{map[string]interface{}{"prefix": "", "tag": []interface{}{map[string]interface{}{"key": "Key1", "value": "Value1"}}}},
+		{map[string]interface{}{"and": []interface{}{map[string]interface{}{"prefix": "logs/", "object_size_greater_than": 1024}}}},
+	}
+
+	for _, v := range validFilters {
+		if err := validLifecycleRuleFilter(v); err != nil {
+			t.Fatalf("%v should be valid filter: %s", v, err)
+		}
+	}
+
+	invalidFilters := [][]interface{}{
+		{map[string]interface{}{"prefix": "logs/", "tag": []interface{}{map[string]interface{}{"key": "Key1", "value": "Value1"}}}},
+		{map[string]interface{}{"prefix": "logs/", "object_size_greater_than": 1024}},
+		{map[string]interface{}{"object_size_greater_than": 1024, "object_size_less_than": 2048}},
+		{map[string]interface{}{"and": []interface{}{map[string]interface{}{"prefix": "logs/"}}, "prefix": "data/"}},
+	}
+
+	for _, v := range invalidFilters {
+		if err := validLifecycleRuleFilter(v); err == nil {
+			t.Fatalf("%v should be invalid filter", v)
+		}
+	}
+}
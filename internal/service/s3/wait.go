@@ -3,6 +3,7 @@ package s3
 import (
 	"time"
 
+	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/s3"
 	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 )
@@ -15,3 +16,23 @@ const (
 func retryWhenBucketNotFound(f func() (interface{}, error)) (interface{}, error) {
 	return tfresource.RetryWhenAWSErrCodeEquals(propagationTimeout, f, s3.ErrCodeNoSuchBucket)
 }
+
+// waitBucketVersioningUpdated waits until the bucket's versioning status is consistently reported as `status`.
+func waitBucketVersioningUpdated(conn *s3.S3, bucket, status string) error {
+	return tfresource.WaitUntil(propagationTimeout, func() (bool, error) {
+		output, err := FindBucketVersioning(conn, bucket)
+
+		if tfresource.NotFound(err) {
+			return false, nil
+		}
+
+		if err != nil {
+			return false, err
+		}
+
+		return aws.StringValue(output.Status) == status, nil
+	}, tfresource.WaitOpts{
+		ContinuousTargetOccurence: 3,
+		MinTimeout:                1 * time.Second,
+	})
+}
@@ -0,0 +1,191 @@
+package s3control
+
+import (
+	"fmt"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/arn"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+func DataSourceAccessPoint() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceAccessPointRead,
+
+		Schema: map[string]*schema.Schema{
+			"account_id": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: verify.ValidAccountID,
+			},
+			"alias": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"bucket": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ExactlyOneOf: []string{"bucket", "name"},
+			},
+			"domain_name": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"endpoints": {
+				Type:     schema.TypeMap,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"has_public_access_policy": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+			"name": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ExactlyOneOf: []string{"bucket", "name"},
+			},
+			"network_origin": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"policy": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"public_access_block_configuration": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"block_public_acls": {
+							Type:     schema.TypeBool,
+							Computed: true,
+						},
+						"block_public_policy": {
+							Type:     schema.TypeBool,
+							Computed: true,
+						},
+						"ignore_public_acls": {
+							Type:     schema.TypeBool,
+							Computed: true,
+						},
+						"restrict_public_buckets": {
+							Type:     schema.TypeBool,
+							Computed: true,
+						},
+					},
+				},
+			},
+			"vpc_configuration": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"vpc_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceAccessPointRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).S3ControlConn
+
+	accountID := meta.(*conns.AWSClient).AccountID
+	if v, ok := d.GetOk("account_id"); ok {
+		accountID = v.(string)
+	}
+
+	name := d.Get("name").(string)
+
+	if v, ok := d.GetOk("bucket"); ok {
+		bucket := v.(string)
+		accessPoints, err := FindAccessPointsByAccountIDAndBucket(conn, accountID, bucket)
+
+		if err != nil {
+			return fmt.Errorf("error listing S3 Access Points for bucket (%s): %w", bucket, err)
+		}
+
+		if n := len(accessPoints); n == 0 {
+			return fmt.Errorf("no S3 Access Points found for bucket (%s)", bucket)
+		} else if n > 1 {
+			return fmt.Errorf("%d S3 Access Points found for bucket (%s); specify name to select one", n, bucket)
+		}
+
+		name = aws.StringValue(accessPoints[0].Name)
+	}
+
+	output, err := FindAccessPointByAccountIDAndName(conn, accountID, name)
+
+	if err != nil {
+		return fmt.Errorf("error reading S3 Access Point (%s): %w", name, err)
+	}
+
+	// https://docs.aws.amazon.com/service-authorization/latest/reference/list_amazons3.html#amazons3-resources-for-iam-policies.
+	accessPointARN := arn.ARN{
+		Partition: meta.(*conns.AWSClient).Partition,
+		Service:   "s3",
+		Region:    meta.(*conns.AWSClient).Region,
+		AccountID: accountID,
+		Resource:  fmt.Sprintf("accesspoint/%s", aws.StringValue(output.Name)),
+	}.String()
+
+	resourceID, err := AccessPointCreateResourceID(accessPointARN)
+
+	if err != nil {
+		return err
+	}
+
+	d.SetId(resourceID)
+	d.Set("account_id", accountID)
+	d.Set("alias", output.Alias)
+	d.Set("arn", accessPointARN)
+	d.Set("bucket", output.Bucket)
+	d.Set("domain_name", meta.(*conns.AWSClient).RegionalHostname(fmt.Sprintf("%s-%s.s3-accesspoint", aws.StringValue(output.Name), accountID)))
+	d.Set("endpoints", aws.StringValueMap(output.Endpoints))
+	d.Set("name", output.Name)
+	d.Set("network_origin", output.NetworkOrigin)
+	if output.PublicAccessBlockConfiguration != nil {
+		if err := d.Set("public_access_block_configuration", []interface{}{flattenPublicAccessBlockConfiguration(output.PublicAccessBlockConfiguration)}); err != nil {
+			return fmt.Errorf("error setting public_access_block_configuration: %w", err)
+		}
+	} else {
+		d.Set("public_access_block_configuration", nil)
+	}
+	if output.VpcConfiguration != nil {
+		if err := d.Set("vpc_configuration", []interface{}{flattenVPCConfiguration(output.VpcConfiguration)}); err != nil {
+			return fmt.Errorf("error setting vpc_configuration: %w", err)
+		}
+	} else {
+		d.Set("vpc_configuration", nil)
+	}
+
+	policy, status, err := FindAccessPointPolicyAndStatusByAccountIDAndName(conn, accountID, name)
+
+	if tfresource.NotFound(err) {
+		d.Set("has_public_access_policy", false)
+		d.Set("policy", nil)
+	} else if err != nil {
+		return fmt.Errorf("error reading S3 Access Point (%s) policy: %w", name, err)
+	} else {
+		d.Set("has_public_access_policy", status.IsPublic)
+		d.Set("policy", policy)
+	}
+
+	return nil
+}
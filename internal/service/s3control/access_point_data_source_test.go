@@ -0,0 +1,111 @@
+package s3control_test
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/s3control"
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+)
+
+func TestAccS3ControlAccessPointDataSource_basic(t *testing.T) {
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	dataSourceName := "data.aws_s3_access_point.test"
+	resourceName := "aws_s3_access_point.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:   func() { acctest.PreCheck(t) },
+		ErrorCheck: acctest.ErrorCheck(t, s3control.EndpointsID),
+		Providers:  acctest.Providers,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAccessPointDataSourceConfig_name(rName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(dataSourceName, "account_id", resourceName, "account_id"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "alias", resourceName, "alias"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "arn", resourceName, "arn"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "bucket", resourceName, "bucket"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "domain_name", resourceName, "domain_name"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "endpoints.%", resourceName, "endpoints.%"),
+					resource.TestCheckResourceAttr(dataSourceName, "has_public_access_policy", "false"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "name", resourceName, "name"),
+					resource.TestCheckResourceAttr(dataSourceName, "network_origin", "Internet"),
+					resource.TestCheckResourceAttr(dataSourceName, "policy", ""),
+					resource.TestCheckResourceAttr(dataSourceName, "public_access_block_configuration.#", "1"),
+					resource.TestCheckResourceAttr(dataSourceName, "public_access_block_configuration.0.block_public_acls", "true"),
+					resource.TestCheckResourceAttr(dataSourceName, "vpc_configuration.#", "0"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccS3ControlAccessPointDataSource_bucket(t *testing.T) {
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	dataSourceName := "data.aws_s3_access_point.test"
+	resourceName := "aws_s3_access_point.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:   func() { acctest.PreCheck(t) },
+		ErrorCheck: acctest.ErrorCheck(t, s3control.EndpointsID),
+		Providers:  acctest.Providers,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAccessPointDataSourceConfig_bucket(rName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(dataSourceName, "arn", resourceName, "arn"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "name", resourceName, "name"),
+					resource.TestCheckResourceAttr(dataSourceName, "network_origin", "VPC"),
+					resource.TestCheckResourceAttr(dataSourceName, "vpc_configuration.#", "1"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "vpc_configuration.0.vpc_id", "aws_vpc.test", "id"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccS3ControlAccessPointDataSource_policy(t *testing.T) {
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	dataSourceName := "data.aws_s3_access_point.test"
+	resourceName := "aws_s3_access_point.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:   func() { acctest.PreCheck(t) },
+		ErrorCheck: acctest.ErrorCheck(t, s3control.EndpointsID),
+		Providers:  acctest.Providers,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAccessPointDataSourceConfig_policy(rName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(dataSourceName, "has_public_access_policy", resourceName, "has_public_access_policy"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "policy"),
+				),
+			},
+		},
+	})
+}
+
+func testAccAccessPointDataSourceConfig_name(rName string) string {
+	return acctest.ConfigCompose(testAccAccessPointConfig_basic(rName, rName), `
+data "aws_s3_access_point" "test" {
+  name = aws_s3_access_point.test.name
+}
+`)
+}
+
+func testAccAccessPointDataSourceConfig_bucket(rName string) string {
+	return acctest.ConfigCompose(testAccAccessPointConfig_vpc(rName), `
+data "aws_s3_access_point" "test" {
+  bucket = aws_s3_access_point.test.bucket
+}
+`)
+}
+
+func testAccAccessPointDataSourceConfig_policy(rName string) string {
+	return acctest.ConfigCompose(testAccAccessPointConfig_policy(rName), `
+data "aws_s3_access_point" "test" {
+  name = aws_s3_access_point.test.name
+}
+`)
+}
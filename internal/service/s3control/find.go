@@ -52,6 +52,34 @@ func FindAccessPointByAccountIDAndName(conn *s3control.S3Control, accountID stri
 	return output, nil
 }
 
+func FindAccessPointsByAccountIDAndBucket(conn *s3control.S3Control, accountID string, bucket string) ([]*s3control.AccessPoint, error) {
+	input := &s3control.ListAccessPointsInput{
+		AccountId: aws.String(accountID),
+		Bucket:    aws.String(bucket),
+	}
+	var output []*s3control.AccessPoint
+
+	err := conn.ListAccessPointsPages(input, func(page *s3control.ListAccessPointsOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, v := range page.AccessPointList {
+			if v != nil {
+				output = append(output, v)
+			}
+		}
+
+		return !lastPage
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	return output, nil
+}
+
 func FindAccessPointPolicyAndStatusByAccountIDAndName(conn *s3control.S3Control, accountID string, name string) (string, *s3control.PolicyStatus, error) {
 	input1 := &s3control.GetAccessPointPolicyInput{
 		AccountId: aws.String(accountID),
@@ -184,6 +212,32 @@ func FindMultiRegionAccessPointPolicyDocumentByAccountIDAndName(conn *s3control.
 	return output.Policy, nil
 }
 
+func FindMultiRegionAccessPointPolicyStatusByAccountIDAndName(conn *s3control.S3Control, accountID string, name string) (*s3control.PolicyStatus, error) {
+	input := &s3control.GetMultiRegionAccessPointPolicyStatusInput{
+		AccountId: aws.String(accountID),
+		Name:      aws.String(name),
+	}
+
+	output, err := conn.GetMultiRegionAccessPointPolicyStatus(input)
+
+	if tfawserr.ErrCodeEquals(err, errCodeNoSuchMultiRegionAccessPoint) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.Established == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output.Established, nil
+}
+
 func FindObjectLambdaAccessPointByAccountIDAndName(conn *s3control.S3Control, accountID string, name string) (*s3control.ObjectLambdaConfiguration, error) {
 	input := &s3control.GetAccessPointConfigurationForObjectLambdaInput{
 		AccountId: aws.String(accountID),
@@ -263,3 +317,29 @@ func FindObjectLambdaAccessPointPolicyAndStatusByAccountIDAndName(conn *s3contro
 
 	return policy, output2.PolicyStatus, nil
 }
+
+func FindStorageLensConfigurationByAccountIDAndConfigID(conn *s3control.S3Control, accountID string, configID string) (*s3control.StorageLensConfiguration, error) {
+	input := &s3control.GetStorageLensConfigurationInput{
+		AccountId: aws.String(accountID),
+		ConfigId:  aws.String(configID),
+	}
+
+	output, err := conn.GetStorageLensConfiguration(input)
+
+	if tfawserr.ErrCodeEquals(err, errCodeNoSuchConfiguration) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.StorageLensConfiguration == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output.StorageLensConfiguration, nil
+}
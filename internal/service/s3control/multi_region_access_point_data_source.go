@@ -0,0 +1,206 @@
+package s3control
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/arn"
+	"github.com/aws/aws-sdk-go/service/s3control"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+func DataSourceMultiRegionAccessPoint() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceMultiRegionAccessPointRead,
+
+		Schema: map[string]*schema.Schema{
+			"account_id": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: verify.ValidAccountID,
+			},
+			"alias": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"created_at": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"domain_name": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"has_public_access_policy": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"policy": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"public_access_block": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"block_public_acls": {
+							Type:     schema.TypeBool,
+							Computed: true,
+						},
+						"block_public_policy": {
+							Type:     schema.TypeBool,
+							Computed: true,
+						},
+						"ignore_public_acls": {
+							Type:     schema.TypeBool,
+							Computed: true,
+						},
+						"restrict_public_buckets": {
+							Type:     schema.TypeBool,
+							Computed: true,
+						},
+					},
+				},
+			},
+			"regions": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"bucket": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"region": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+			"status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func dataSourceMultiRegionAccessPointRead(d *schema.ResourceData, meta interface{}) error {
+	conn, err := S3ControlConnForMRAP(meta.(*conns.AWSClient))
+
+	if err != nil {
+		return err
+	}
+
+	accountID := meta.(*conns.AWSClient).AccountID
+	if v, ok := d.GetOk("account_id"); ok {
+		accountID = v.(string)
+	}
+
+	name := d.Get("name").(string)
+
+	accessPoint, err := FindMultiRegionAccessPointByAccountIDAndName(conn, accountID, name)
+
+	if err != nil {
+		return fmt.Errorf("error reading S3 Multi-Region Access Point (%s): %w", name, err)
+	}
+
+	alias := aws.StringValue(accessPoint.Alias)
+	arn := arn.ARN{
+		Partition: meta.(*conns.AWSClient).Partition,
+		Service:   "s3",
+		AccountID: accountID,
+		Resource:  fmt.Sprintf("accesspoint/%s", alias),
+	}.String()
+
+	d.SetId(MultiRegionAccessPointCreateResourceID(accountID, name))
+	d.Set("account_id", accountID)
+	d.Set("alias", alias)
+	d.Set("arn", arn)
+	if accessPoint.CreatedAt != nil {
+		d.Set("created_at", aws.TimeValue(accessPoint.CreatedAt).Format(time.RFC3339))
+	} else {
+		d.Set("created_at", nil)
+	}
+	// https://docs.aws.amazon.com/AmazonS3/latest/userguide//MultiRegionAccessPointRequests.html#MultiRegionAccessPointHostnames.
+	d.Set("domain_name", meta.(*conns.AWSClient).PartitionHostname(fmt.Sprintf("%s.accesspoint.s3-global", alias)))
+	d.Set("name", accessPoint.Name)
+	if accessPoint.PublicAccessBlock != nil {
+		if err := d.Set("public_access_block", []interface{}{flattenPublicAccessBlockConfiguration(accessPoint.PublicAccessBlock)}); err != nil {
+			return fmt.Errorf("error setting public_access_block: %w", err)
+		}
+	} else {
+		d.Set("public_access_block", nil)
+	}
+	if err := d.Set("regions", flattenMultiRegionAccessPointRegionReports(accessPoint.Regions)); err != nil {
+		return fmt.Errorf("error setting regions: %w", err)
+	}
+	d.Set("status", accessPoint.Status)
+
+	policyDocument, err := FindMultiRegionAccessPointPolicyDocumentByAccountIDAndName(conn, accountID, name)
+
+	if tfresource.NotFound(err) {
+		d.Set("policy", nil)
+	} else if err != nil {
+		return fmt.Errorf("error reading S3 Multi-Region Access Point (%s) policy: %w", name, err)
+	} else if policyDocument.Established != nil {
+		d.Set("policy", policyDocument.Established.Policy)
+	} else {
+		d.Set("policy", nil)
+	}
+
+	policyStatus, err := FindMultiRegionAccessPointPolicyStatusByAccountIDAndName(conn, accountID, name)
+
+	if tfresource.NotFound(err) {
+		d.Set("has_public_access_policy", false)
+	} else if err != nil {
+		return fmt.Errorf("error reading S3 Multi-Region Access Point (%s) policy status: %w", name, err)
+	} else {
+		d.Set("has_public_access_policy", policyStatus.IsPublic)
+	}
+
+	return nil
+}
+
+func flattenMultiRegionAccessPointRegionReports(apiObjects []*s3control.RegionReport) []interface{} {
+	if len(apiObjects) == 0 {
+		return nil
+	}
+
+	var tfList []interface{}
+
+	for _, apiObject := range apiObjects {
+		if apiObject == nil {
+			continue
+		}
+
+		tfMap := map[string]interface{}{}
+
+		if v := apiObject.Bucket; v != nil {
+			tfMap["bucket"] = aws.StringValue(v)
+		}
+
+		if v := apiObject.Region; v != nil {
+			tfMap["region"] = aws.StringValue(v)
+		}
+
+		tfList = append(tfList, tfMap)
+	}
+
+	return tfList
+}
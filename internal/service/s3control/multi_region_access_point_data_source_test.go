@@ -0,0 +1,55 @@
+package s3control_test
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/s3control"
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+)
+
+func TestAccS3ControlMultiRegionAccessPointDataSource_basic(t *testing.T) {
+	dataSourceName := "data.aws_s3control_multi_region_access_point.test"
+	resourceName := "aws_s3control_multi_region_access_point.test"
+	bucketName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	if acctest.Partition() == "aws-us-gov" {
+		t.Skip("S3 Multi-Region Access Point is not supported in GovCloud partition")
+	}
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:   func() { acctest.PreCheck(t) },
+		ErrorCheck: acctest.ErrorCheck(t, s3control.EndpointsID),
+		Providers:  acctest.Providers,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccMultiRegionAccessPointDataSourceConfig_basic(bucketName, rName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(dataSourceName, "account_id", resourceName, "account_id"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "alias", resourceName, "alias"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "arn", resourceName, "arn"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "created_at"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "domain_name", resourceName, "domain_name"),
+					resource.TestCheckResourceAttr(dataSourceName, "has_public_access_policy", "false"),
+					resource.TestCheckResourceAttr(dataSourceName, "name", rName),
+					resource.TestCheckResourceAttr(dataSourceName, "public_access_block.#", "1"),
+					resource.TestCheckResourceAttr(dataSourceName, "public_access_block.0.block_public_acls", "true"),
+					resource.TestCheckResourceAttr(dataSourceName, "regions.#", "1"),
+					resource.TestCheckResourceAttr(dataSourceName, "regions.0.bucket", bucketName),
+					resource.TestCheckResourceAttr(dataSourceName, "regions.0.region", acctest.Region()),
+					resource.TestCheckResourceAttr(dataSourceName, "status", s3control.MultiRegionAccessPointStatusReady),
+				),
+			},
+		},
+	})
+}
+
+func testAccMultiRegionAccessPointDataSourceConfig_basic(bucketName, multiRegionAccessPointName string) string {
+	return acctest.ConfigCompose(testAccMultiRegionAccessPointConfig_basic(bucketName, multiRegionAccessPointName), `
+data "aws_s3control_multi_region_access_point" "test" {
+  name = aws_s3control_multi_region_access_point.test.details[0].name
+}
+`)
+}
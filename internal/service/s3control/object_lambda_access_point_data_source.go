@@ -0,0 +1,193 @@
+package s3control
+
+import (
+	"fmt"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/arn"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+func DataSourceObjectLambdaAccessPoint() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceObjectLambdaAccessPointRead,
+
+		Schema: map[string]*schema.Schema{
+			"account_id": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: verify.ValidAccountID,
+			},
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"configuration": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"allowed_features": {
+							Type:     schema.TypeSet,
+							Computed: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+						"cloud_watch_metrics_enabled": {
+							Type:     schema.TypeBool,
+							Computed: true,
+						},
+						"supporting_access_point": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"transformation_configuration": {
+							Type:     schema.TypeSet,
+							Computed: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"actions": {
+										Type:     schema.TypeSet,
+										Computed: true,
+										Elem:     &schema.Schema{Type: schema.TypeString},
+									},
+									"content_transformation": {
+										Type:     schema.TypeList,
+										Computed: true,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"aws_lambda": {
+													Type:     schema.TypeList,
+													Computed: true,
+													Elem: &schema.Resource{
+														Schema: map[string]*schema.Schema{
+															"function_arn": {
+																Type:     schema.TypeString,
+																Computed: true,
+															},
+															"function_payload": {
+																Type:     schema.TypeString,
+																Computed: true,
+															},
+														},
+													},
+												},
+											},
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			"has_public_access_policy": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"network_origin": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"policy": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"vpc_configuration": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"vpc_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceObjectLambdaAccessPointRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).S3ControlConn
+
+	accountID := meta.(*conns.AWSClient).AccountID
+	if v, ok := d.GetOk("account_id"); ok {
+		accountID = v.(string)
+	}
+
+	name := d.Get("name").(string)
+
+	output, err := FindObjectLambdaAccessPointByAccountIDAndName(conn, accountID, name)
+
+	if err != nil {
+		return fmt.Errorf("error reading S3 Object Lambda Access Point (%s): %w", name, err)
+	}
+
+	d.SetId(ObjectLambdaAccessPointCreateResourceID(accountID, name))
+	d.Set("account_id", accountID)
+	// https://docs.aws.amazon.com/service-authorization/latest/reference/list_amazons3objectlambda.html#amazons3objectlambda-resources-for-iam-policies.
+	arn := arn.ARN{
+		Partition: meta.(*conns.AWSClient).Partition,
+		Service:   "s3-object-lambda",
+		Region:    meta.(*conns.AWSClient).Region,
+		AccountID: accountID,
+		Resource:  fmt.Sprintf("accesspoint/%s", name),
+	}.String()
+	d.Set("arn", arn)
+	if err := d.Set("configuration", []interface{}{flattenObjectLambdaConfiguration(output)}); err != nil {
+		return fmt.Errorf("error setting configuration: %w", err)
+	}
+	d.Set("name", name)
+
+	// The network origin and VPC configuration are those of the supporting access point.
+	supportingAccessPointARN := aws.StringValue(output.SupportingAccessPoint)
+	supportingAccessPointID, err := AccessPointCreateResourceID(supportingAccessPointARN)
+
+	if err != nil {
+		return err
+	}
+
+	supportingAccountID, supportingName, err := AccessPointParseResourceID(supportingAccessPointID)
+
+	if err != nil {
+		return err
+	}
+
+	accessPoint, err := FindAccessPointByAccountIDAndName(conn, supportingAccountID, supportingName)
+
+	if err != nil {
+		return fmt.Errorf("error reading S3 Access Point (%s): %w", supportingAccessPointARN, err)
+	}
+
+	d.Set("network_origin", accessPoint.NetworkOrigin)
+	if accessPoint.VpcConfiguration != nil {
+		if err := d.Set("vpc_configuration", []interface{}{flattenVPCConfiguration(accessPoint.VpcConfiguration)}); err != nil {
+			return fmt.Errorf("error setting vpc_configuration: %w", err)
+		}
+	} else {
+		d.Set("vpc_configuration", nil)
+	}
+
+	policy, status, err := FindObjectLambdaAccessPointPolicyAndStatusByAccountIDAndName(conn, accountID, name)
+
+	if tfresource.NotFound(err) {
+		d.Set("has_public_access_policy", false)
+		d.Set("policy", nil)
+	} else if err != nil {
+		return fmt.Errorf("error reading S3 Object Lambda Access Point (%s) policy: %w", name, err)
+	} else {
+		d.Set("has_public_access_policy", status.IsPublic)
+		d.Set("policy", policy)
+	}
+
+	return nil
+}
@@ -0,0 +1,49 @@
+package s3control_test
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/s3control"
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+)
+
+func TestAccS3ControlObjectLambdaAccessPointDataSource_basic(t *testing.T) {
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	dataSourceName := "data.aws_s3control_object_lambda_access_point.test"
+	resourceName := "aws_s3control_object_lambda_access_point.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:   func() { acctest.PreCheck(t) },
+		ErrorCheck: acctest.ErrorCheck(t, s3control.EndpointsID),
+		Providers:  acctest.Providers,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccObjectLambdaAccessPointDataSourceConfig(rName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(dataSourceName, "account_id", resourceName, "account_id"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "arn", resourceName, "arn"),
+					resource.TestCheckResourceAttr(dataSourceName, "configuration.#", "1"),
+					resource.TestCheckResourceAttr(dataSourceName, "configuration.0.allowed_features.#", "2"),
+					resource.TestCheckResourceAttr(dataSourceName, "configuration.0.cloud_watch_metrics_enabled", "true"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "configuration.0.supporting_access_point", "aws_s3_access_point.test", "arn"),
+					resource.TestCheckResourceAttr(dataSourceName, "configuration.0.transformation_configuration.#", "1"),
+					resource.TestCheckResourceAttr(dataSourceName, "has_public_access_policy", "false"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "name", resourceName, "name"),
+					resource.TestCheckResourceAttr(dataSourceName, "network_origin", "Internet"),
+					resource.TestCheckResourceAttr(dataSourceName, "policy", ""),
+					resource.TestCheckResourceAttr(dataSourceName, "vpc_configuration.#", "0"),
+				),
+			},
+		},
+	})
+}
+
+func testAccObjectLambdaAccessPointDataSourceConfig(rName string) string {
+	return acctest.ConfigCompose(testAccObjectLambdaAccessPointOptionalsConfig(rName), `
+data "aws_s3control_object_lambda_access_point" "test" {
+  name = aws_s3control_object_lambda_access_point.test.name
+}
+`)
+}
@@ -17,7 +17,7 @@ import (
 )
 
 func init() {
-	resource.AddTestSweepers("aws_s3_access_point", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_s3_access_point", &resource.Sweeper{
 		Name: "aws_s3_access_point",
 		F:    sweepAccessPoints,
 		Dependencies: []string{
@@ -25,15 +25,20 @@ func init() {
 		},
 	})
 
-	resource.AddTestSweepers("aws_s3control_multi_region_access_point", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_s3control_multi_region_access_point", &resource.Sweeper{
 		Name: "aws_s3control_multi_region_access_point",
 		F:    sweepMultiRegionAccessPoints,
 	})
 
-	resource.AddTestSweepers("aws_s3control_object_lambda_access_point", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_s3control_object_lambda_access_point", &resource.Sweeper{
 		Name: "aws_s3control_object_lambda_access_point",
 		F:    sweepObjectLambdaAccessPoints,
 	})
+
+	sweep.AddTestSweepers("aws_s3control_storage_lens_configuration", &resource.Sweeper{
+		Name: "aws_s3control_storage_lens_configuration",
+		F:    sweepStorageLensConfigurations,
+	})
 }
 
 func sweepAccessPoints(region string) error {
@@ -84,7 +89,7 @@ func sweepAccessPoints(region string) error {
 		return sweeperErr
 	}
 
-	err = sweep.SweepOrchestrator(sweepResources)
+	err = sweep.SweepOrchestrator("aws_s3_access_point", sweepResources)
 
 	if err != nil {
 		sweeperErr := fmt.Errorf("error sweeping S3 Access Points (%s): %w", region, err)
@@ -138,7 +143,7 @@ func sweepMultiRegionAccessPoints(region string) error {
 		return fmt.Errorf("error listing S3 Multi-Region Access Points (%s): %w", region, err)
 	}
 
-	err = sweep.SweepOrchestrator(sweepResources)
+	err = sweep.SweepOrchestrator("aws_s3control_multi_region_access_point", sweepResources)
 
 	if err != nil {
 		return fmt.Errorf("error sweeping S3 Multi-Region Access Points (%s): %w", region, err)
@@ -184,7 +189,7 @@ func sweepObjectLambdaAccessPoints(region string) error {
 		return fmt.Errorf("error listing S3 Object Lambda Access Points (%s): %w", region, err)
 	}
 
-	err = sweep.SweepOrchestrator(sweepResources)
+	err = sweep.SweepOrchestrator("aws_s3control_object_lambda_access_point", sweepResources)
 
 	if err != nil {
 		return fmt.Errorf("error sweeping S3 Object Lambda Access Points (%s): %w", region, err)
@@ -192,3 +197,61 @@ func sweepObjectLambdaAccessPoints(region string) error {
 
 	return nil
 }
+
+func sweepStorageLensConfigurations(region string) error {
+	client, err := sweep.SharedRegionalSweepClient(region)
+	if err != nil {
+		return fmt.Errorf("error getting client: %s", err)
+	}
+	conn := client.(*conns.AWSClient).S3ControlConn
+	accountID := client.(*conns.AWSClient).AccountID
+	input := &s3control.ListStorageLensConfigurationsInput{
+		AccountId: aws.String(accountID),
+	}
+	sweepResources := make([]*sweep.SweepResource, 0)
+
+	err = conn.ListStorageLensConfigurationsPages(input, func(page *s3control.ListStorageLensConfigurationsOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, configuration := range page.StorageLensConfigurationList {
+			configID := aws.StringValue(configuration.Id)
+
+			// The default dashboard cannot be deleted.
+			if configID == "default-account-dashboard" {
+				continue
+			}
+
+			// Configurations are listed for all Regions, only sweep those homed in this one.
+			if aws.StringValue(configuration.HomeRegion) != region {
+				continue
+			}
+
+			r := ResourceStorageLensConfiguration()
+			d := r.Data(nil)
+			d.SetId(StorageLensConfigurationCreateResourceID(accountID, configID))
+
+			sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client))
+		}
+
+		return !lastPage
+	})
+
+	if sweep.SkipSweepError(err) {
+		log.Printf("[WARN] Skipping S3 Storage Lens Configuration sweep for %s: %s", region, err)
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error listing S3 Storage Lens Configurations (%s): %w", region, err)
+	}
+
+	err = sweep.SweepOrchestrator("aws_s3control_storage_lens_configuration", sweepResources)
+
+	if err != nil {
+		return fmt.Errorf("error sweeping S3 Storage Lens Configurations (%s): %w", region, err)
+	}
+
+	return nil
+}
@@ -17,12 +17,12 @@ import (
 )
 
 func init() {
-	resource.AddTestSweepers("aws_schemas_discoverer", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_schemas_discoverer", &resource.Sweeper{
 		Name: "aws_schemas_discoverer",
 		F:    sweepDiscoverers,
 	})
 
-	resource.AddTestSweepers("aws_schemas_registry", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_schemas_registry", &resource.Sweeper{
 		Name: "aws_schemas_registry",
 		F:    sweepRegistries,
 	})
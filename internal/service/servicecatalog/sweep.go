@@ -18,31 +18,31 @@ import (
 )
 
 func init() {
-	resource.AddTestSweepers("aws_servicecatalog_budget_resource_association", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_servicecatalog_budget_resource_association", &resource.Sweeper{
 		Name:         "aws_servicecatalog_budget_resource_association",
 		Dependencies: []string{},
 		F:            sweepBudgetResourceAssociations,
 	})
 
-	resource.AddTestSweepers("aws_servicecatalog_constraint", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_servicecatalog_constraint", &resource.Sweeper{
 		Name:         "aws_servicecatalog_constraint",
 		Dependencies: []string{},
 		F:            sweepConstraints,
 	})
 
-	resource.AddTestSweepers("aws_servicecatalog_principal_portfolio_association", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_servicecatalog_principal_portfolio_association", &resource.Sweeper{
 		Name:         "aws_servicecatalog_principal_portfolio_association",
 		Dependencies: []string{},
 		F:            sweepPrincipalPortfolioAssociations,
 	})
 
-	resource.AddTestSweepers("aws_servicecatalog_product_portfolio_association", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_servicecatalog_product_portfolio_association", &resource.Sweeper{
 		Name:         "aws_servicecatalog_product_portfolio_association",
 		Dependencies: []string{},
 		F:            sweepProductPortfolioAssociations,
 	})
 
-	resource.AddTestSweepers("aws_servicecatalog_product", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_servicecatalog_product", &resource.Sweeper{
 		Name: "aws_servicecatalog_product",
 		Dependencies: []string{
 			"aws_servicecatalog_provisioning_artifact",
@@ -50,31 +50,31 @@ func init() {
 		F: sweepProducts,
 	})
 
-	resource.AddTestSweepers("aws_servicecatalog_provisioned_product", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_servicecatalog_provisioned_product", &resource.Sweeper{
 		Name:         "aws_servicecatalog_provisioned_product",
 		Dependencies: []string{},
 		F:            sweepProvisionedProducts,
 	})
 
-	resource.AddTestSweepers("aws_servicecatalog_provisioning_artifact", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_servicecatalog_provisioning_artifact", &resource.Sweeper{
 		Name:         "aws_servicecatalog_provisioning_artifact",
 		Dependencies: []string{},
 		F:            sweepProvisioningArtifacts,
 	})
 
-	resource.AddTestSweepers("aws_servicecatalog_service_action", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_servicecatalog_service_action", &resource.Sweeper{
 		Name:         "aws_servicecatalog_service_action",
 		Dependencies: []string{},
 		F:            sweepServiceActions,
 	})
 
-	resource.AddTestSweepers("aws_servicecatalog_tag_option_resource_association", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_servicecatalog_tag_option_resource_association", &resource.Sweeper{
 		Name:         "aws_servicecatalog_tag_option_resource_association",
 		Dependencies: []string{},
 		F:            sweepTagOptionResourceAssociations,
 	})
 
-	resource.AddTestSweepers("aws_servicecatalog_tag_option", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_servicecatalog_tag_option", &resource.Sweeper{
 		Name:         "aws_servicecatalog_tag_option",
 		Dependencies: []string{},
 		F:            sweepTagOptions,
@@ -180,7 +180,7 @@ func sweepBudgetResourceAssociations(region string) error {
 		errs = multierror.Append(errs, fmt.Errorf("error describing Service Catalog Budget Resource (Product) Associations for %s: %w", region, err))
 	}
 
-	if err = sweep.SweepOrchestrator(sweepResources); err != nil {
+	if err = sweep.SweepOrchestrator("aws_servicecatalog_budget_resource_association", sweepResources); err != nil {
 		errs = multierror.Append(errs, fmt.Errorf("error sweeping Service Catalog Budget Resource Associations for %s: %w", region, err))
 	}
 
@@ -249,7 +249,7 @@ func sweepConstraints(region string) error {
 		errs = multierror.Append(errs, fmt.Errorf("error describing Service Catalog Constraints for %s: %w", region, err))
 	}
 
-	if err = sweep.SweepOrchestrator(sweepResources); err != nil {
+	if err = sweep.SweepOrchestrator("aws_servicecatalog_constraint", sweepResources); err != nil {
 		errs = multierror.Append(errs, fmt.Errorf("error sweeping Service Catalog Constraints for %s: %w", region, err))
 	}
 
@@ -321,7 +321,7 @@ func sweepPrincipalPortfolioAssociations(region string) error {
 		errs = multierror.Append(errs, fmt.Errorf("error describing Service Catalog Principal Portfolio Associations for %s: %w", region, err))
 	}
 
-	if err = sweep.SweepOrchestrator(sweepResources); err != nil {
+	if err = sweep.SweepOrchestrator("aws_servicecatalog_principal_portfolio_association", sweepResources); err != nil {
 		errs = multierror.Append(errs, fmt.Errorf("error sweeping Service Catalog Principal Portfolio Associations for %s: %w", region, err))
 	}
 
@@ -413,7 +413,7 @@ func sweepProductPortfolioAssociations(region string) error {
 		errs = multierror.Append(errs, fmt.Errorf("error describing Service Catalog Product Portfolio Associations for %s: %w", region, err))
 	}
 
-	if err = sweep.SweepOrchestrator(sweepResources); err != nil {
+	if err = sweep.SweepOrchestrator("aws_servicecatalog_product_portfolio_association", sweepResources); err != nil {
 		errs = multierror.Append(errs, fmt.Errorf("error sweeping Service Catalog Product Portfolio Associations for %s: %w", region, err))
 	}
 
@@ -464,7 +464,7 @@ func sweepProducts(region string) error {
 		errs = multierror.Append(errs, fmt.Errorf("error describing Service Catalog Products for %s: %w", region, err))
 	}
 
-	if err = sweep.SweepOrchestrator(sweepResources); err != nil {
+	if err = sweep.SweepOrchestrator("aws_servicecatalog_product", sweepResources); err != nil {
 		errs = multierror.Append(errs, fmt.Errorf("error sweeping Service Catalog Products for %s: %w", region, err))
 	}
 
@@ -518,7 +518,7 @@ func sweepProvisionedProducts(region string) error {
 		errs = multierror.Append(errs, fmt.Errorf("error describing Service Catalog Provisioned Products for %s: %w", region, err))
 	}
 
-	if err = sweep.SweepOrchestrator(sweepResources); err != nil {
+	if err = sweep.SweepOrchestrator("aws_servicecatalog_provisioned_product", sweepResources); err != nil {
 		errs = multierror.Append(errs, fmt.Errorf("error sweeping Service Catalog Provisioned Products for %s: %w", region, err))
 	}
 
@@ -599,7 +599,7 @@ func sweepProvisioningArtifacts(region string) error {
 		errs = multierror.Append(errs, fmt.Errorf("error describing Service Catalog Provisioning Artifacts for %s: %w", region, err))
 	}
 
-	if err = sweep.SweepOrchestrator(sweepResources); err != nil {
+	if err = sweep.SweepOrchestrator("aws_servicecatalog_provisioning_artifact", sweepResources); err != nil {
 		errs = multierror.Append(errs, fmt.Errorf("error sweeping Service Catalog Provisioning Artifacts for %s: %w", region, err))
 	}
 
@@ -650,7 +650,7 @@ func sweepServiceActions(region string) error {
 		errs = multierror.Append(errs, fmt.Errorf("error describing Service Catalog Service Actions for %s: %w", region, err))
 	}
 
-	if err = sweep.SweepOrchestrator(sweepResources); err != nil {
+	if err = sweep.SweepOrchestrator("aws_servicecatalog_service_action", sweepResources); err != nil {
 		errs = multierror.Append(errs, fmt.Errorf("error sweeping Service Catalog Service Actions for %s: %w", region, err))
 	}
 
@@ -717,7 +717,7 @@ func sweepTagOptionResourceAssociations(region string) error {
 		errs = multierror.Append(errs, fmt.Errorf("error describing Service Catalog Tag Option Resource Associations for %s: %w", region, err))
 	}
 
-	if err = sweep.SweepOrchestrator(sweepResources); err != nil {
+	if err = sweep.SweepOrchestrator("aws_servicecatalog_tag_option_resource_association", sweepResources); err != nil {
 		errs = multierror.Append(errs, fmt.Errorf("error sweeping Service Catalog Tag Option Resource Associations for %s: %w", region, err))
 	}
 
@@ -768,7 +768,7 @@ func sweepTagOptions(region string) error {
 		errs = multierror.Append(errs, fmt.Errorf("error describing Service Catalog Tag Options for %s: %w", region, err))
 	}
 
-	if err = sweep.SweepOrchestrator(sweepResources); err != nil {
+	if err = sweep.SweepOrchestrator("aws_servicecatalog_tag_option", sweepResources); err != nil {
 		errs = multierror.Append(errs, fmt.Errorf("error sweeping Service Catalog Tag Options for %s: %w", region, err))
 	}
 
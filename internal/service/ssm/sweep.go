@@ -17,12 +17,12 @@ import (
 )
 
 func init() {
-	resource.AddTestSweepers("aws_ssm_maintenance_window", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_ssm_maintenance_window", &resource.Sweeper{
 		Name: "aws_ssm_maintenance_window",
 		F:    sweepMaintenanceWindows,
 	})
 
-	resource.AddTestSweepers("aws_ssm_resource_data_sync", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_ssm_resource_data_sync", &resource.Sweeper{
 		Name: "aws_ssm_resource_data_sync",
 		F:    sweepResourceDataSyncs,
 	})
@@ -118,7 +118,7 @@ func sweepResourceDataSyncs(region string) error {
 		errs = multierror.Append(errs, fmt.Errorf("error listing SSM Resource Data Sync for %s: %w", region, err))
 	}
 
-	if err := sweep.SweepOrchestrator(sweepResources); err != nil {
+	if err := sweep.SweepOrchestrator("aws_ssm_resource_data_sync", sweepResources); err != nil {
 		errs = multierror.Append(errs, fmt.Errorf("error sweeping SSM Resource Data Sync for %s: %w", region, err))
 	}
 
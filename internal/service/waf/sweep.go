@@ -17,7 +17,7 @@ import (
 )
 
 func init() {
-	resource.AddTestSweepers("aws_waf_byte_match_set", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_waf_byte_match_set", &resource.Sweeper{
 		Name: "aws_waf_byte_match_set",
 		F:    sweepByteMatchSet,
 		Dependencies: []string{
@@ -27,7 +27,7 @@ func init() {
 		},
 	})
 
-	resource.AddTestSweepers("aws_waf_geo_match_set", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_waf_geo_match_set", &resource.Sweeper{
 		Name: "aws_waf_geo_match_set",
 		F:    sweepGeoMatchSet,
 		Dependencies: []string{
@@ -37,7 +37,7 @@ func init() {
 		},
 	})
 
-	resource.AddTestSweepers("aws_waf_ipset", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_waf_ipset", &resource.Sweeper{
 		Name: "aws_waf_ipset",
 		F:    sweepIPSet,
 		Dependencies: []string{
@@ -47,7 +47,7 @@ func init() {
 		},
 	})
 
-	resource.AddTestSweepers("aws_waf_rate_based_rule", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_waf_rate_based_rule", &resource.Sweeper{
 		Name: "aws_waf_rate_based_rule",
 		F:    sweepRateBasedRules,
 		Dependencies: []string{
@@ -56,7 +56,7 @@ func init() {
 		},
 	})
 
-	resource.AddTestSweepers("aws_waf_regex_match_set", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_waf_regex_match_set", &resource.Sweeper{
 		Name: "aws_waf_regex_match_set",
 		F:    sweepRegexMatchSet,
 		Dependencies: []string{
@@ -66,7 +66,7 @@ func init() {
 		},
 	})
 
-	resource.AddTestSweepers("aws_waf_regex_pattern_set", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_waf_regex_pattern_set", &resource.Sweeper{
 		Name: "aws_waf_regex_pattern_set",
 		F:    sweepRegexPatternSet,
 		Dependencies: []string{
@@ -76,7 +76,7 @@ func init() {
 		},
 	})
 
-	resource.AddTestSweepers("aws_waf_rule_group", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_waf_rule_group", &resource.Sweeper{
 		Name: "aws_waf_rule_group",
 		F:    sweepRuleGroups,
 		Dependencies: []string{
@@ -84,7 +84,7 @@ func init() {
 		},
 	})
 
-	resource.AddTestSweepers("aws_waf_rule", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_waf_rule", &resource.Sweeper{
 		Name: "aws_waf_rule",
 		F:    sweepRules,
 		Dependencies: []string{
@@ -93,7 +93,7 @@ func init() {
 		},
 	})
 
-	resource.AddTestSweepers("aws_waf_size_constraint_set", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_waf_size_constraint_set", &resource.Sweeper{
 		Name: "aws_waf_size_constraint_set",
 		F:    sweepSizeConstraintSet,
 		Dependencies: []string{
@@ -103,7 +103,7 @@ func init() {
 		},
 	})
 
-	resource.AddTestSweepers("aws_waf_sql_injection_match_set", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_waf_sql_injection_match_set", &resource.Sweeper{
 		Name: "aws_waf_sql_injection_match_set",
 		F:    sweepSQLInjectionMatchSet,
 		Dependencies: []string{
@@ -113,12 +113,12 @@ func init() {
 		},
 	})
 
-	resource.AddTestSweepers("aws_waf_web_acl", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_waf_web_acl", &resource.Sweeper{
 		Name: "aws_waf_web_acl",
 		F:    sweepWebACLs,
 	})
 
-	resource.AddTestSweepers("aws_waf_xss_match_set", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_waf_xss_match_set", &resource.Sweeper{
 		Name: "aws_waf_xss_match_set",
 		F:    sweepXSSMatchSet,
 		Dependencies: []string{
@@ -191,7 +191,7 @@ func sweepByteMatchSet(region string) error {
 		errs = multierror.Append(errs, fmt.Errorf("error concurrently reading WAF Byte Match Sets: %w", err))
 	}
 
-	if err = sweep.SweepOrchestrator(sweepResources); err != nil {
+	if err = sweep.SweepOrchestrator("aws_waf_byte_match_set", sweepResources); err != nil {
 		errs = multierror.Append(errs, fmt.Errorf("error sweeping WAF Byte Match Set for %s: %w", region, err))
 	}
 
@@ -265,7 +265,7 @@ func sweepGeoMatchSet(region string) error {
 		errs = multierror.Append(errs, fmt.Errorf("error concurrently reading WAF Geo Match Sets: %w", err))
 	}
 
-	if err = sweep.SweepOrchestrator(sweepResources); err != nil {
+	if err = sweep.SweepOrchestrator("aws_waf_geo_match_set", sweepResources); err != nil {
 		errs = multierror.Append(errs, fmt.Errorf("error sweeping WAF Geo Match Set for %s: %w", region, err))
 	}
 
@@ -339,7 +339,7 @@ func sweepIPSet(region string) error {
 		errs = multierror.Append(errs, fmt.Errorf("error concurrently reading WAF IP Sets: %w", err))
 	}
 
-	if err = sweep.SweepOrchestrator(sweepResources); err != nil {
+	if err = sweep.SweepOrchestrator("aws_waf_ipset", sweepResources); err != nil {
 		errs = multierror.Append(errs, fmt.Errorf("error sweeping WAF IP Set for %s: %w", region, err))
 	}
 
@@ -413,7 +413,7 @@ func sweepRateBasedRules(region string) error {
 		errs = multierror.Append(errs, fmt.Errorf("error concurrently reading WAF Rate Based Rules: %w", err))
 	}
 
-	if err = sweep.SweepOrchestrator(sweepResources); err != nil {
+	if err = sweep.SweepOrchestrator("aws_waf_rate_based_rule", sweepResources); err != nil {
 		errs = multierror.Append(errs, fmt.Errorf("error sweeping WAF Rate Based Rule for %s: %w", region, err))
 	}
 
@@ -487,7 +487,7 @@ func sweepRegexMatchSet(region string) error {
 		errs = multierror.Append(errs, fmt.Errorf("error concurrently reading WAF Regex Match Sets: %w", err))
 	}
 
-	if err = sweep.SweepOrchestrator(sweepResources); err != nil {
+	if err = sweep.SweepOrchestrator("aws_waf_regex_match_set", sweepResources); err != nil {
 		errs = multierror.Append(errs, fmt.Errorf("error sweeping WAF Regex Match Set for %s: %w", region, err))
 	}
 
@@ -561,7 +561,7 @@ func sweepRegexPatternSet(region string) error {
 		errs = multierror.Append(errs, fmt.Errorf("error concurrently reading WAF Regex Pattern Sets: %w", err))
 	}
 
-	if err = sweep.SweepOrchestrator(sweepResources); err != nil {
+	if err = sweep.SweepOrchestrator("aws_waf_regex_pattern_set", sweepResources); err != nil {
 		errs = multierror.Append(errs, fmt.Errorf("error sweeping WAF Regex Pattern Set for %s: %w", region, err))
 	}
 
@@ -635,7 +635,7 @@ func sweepRuleGroups(region string) error {
 		errs = multierror.Append(errs, fmt.Errorf("error concurrently reading WAF Rule Groups: %w", err))
 	}
 
-	if err = sweep.SweepOrchestrator(sweepResources); err != nil {
+	if err = sweep.SweepOrchestrator("aws_waf_rule_group", sweepResources); err != nil {
 		errs = multierror.Append(errs, fmt.Errorf("error sweeping WAF Rule Group for %s: %w", region, err))
 	}
 
@@ -713,7 +713,7 @@ func sweepRules(region string) error {
 		errs = multierror.Append(errs, fmt.Errorf("error concurrently reading WAF Rules: %w", err))
 	}
 
-	if err = sweep.SweepOrchestrator(sweepResources); err != nil {
+	if err = sweep.SweepOrchestrator("aws_waf_rule", sweepResources); err != nil {
 		errs = multierror.Append(errs, fmt.Errorf("error sweeping WAF Rules for %s: %w", region, err))
 	}
 
@@ -787,7 +787,7 @@ func sweepSizeConstraintSet(region string) error {
 		errs = multierror.Append(errs, fmt.Errorf("error concurrently reading WAF Size Constraint Sets: %w", err))
 	}
 
-	if err = sweep.SweepOrchestrator(sweepResources); err != nil {
+	if err = sweep.SweepOrchestrator("aws_waf_size_constraint_set", sweepResources); err != nil {
 		errs = multierror.Append(errs, fmt.Errorf("error sweeping WAF Size Constraint Sets for %s: %w", region, err))
 	}
 
@@ -861,7 +861,7 @@ func sweepSQLInjectionMatchSet(region string) error {
 		errs = multierror.Append(errs, fmt.Errorf("error concurrently reading WAF SQL Injection Matches: %w", err))
 	}
 
-	if err = sweep.SweepOrchestrator(sweepResources); err != nil {
+	if err = sweep.SweepOrchestrator("aws_waf_sql_injection_match_set", sweepResources); err != nil {
 		errs = multierror.Append(errs, fmt.Errorf("error sweeping WAF SQL Injection Matches for %s: %w", region, err))
 	}
 
@@ -939,7 +939,7 @@ func sweepWebACLs(region string) error {
 		errs = multierror.Append(errs, fmt.Errorf("error concurrently reading WAF Web ACLs: %w", err))
 	}
 
-	if err = sweep.SweepOrchestrator(sweepResources); err != nil {
+	if err = sweep.SweepOrchestrator("aws_waf_web_acl", sweepResources); err != nil {
 		errs = multierror.Append(errs, fmt.Errorf("error sweeping WAF Web ACLs for %s: %w", region, err))
 	}
 
@@ -1013,7 +1013,7 @@ func sweepXSSMatchSet(region string) error {
 		errs = multierror.Append(errs, fmt.Errorf("error concurrently reading WAF XSS Match Sets: %w", err))
 	}
 
-	if err = sweep.SweepOrchestrator(sweepResources); err != nil {
+	if err = sweep.SweepOrchestrator("aws_waf_xss_match_set", sweepResources); err != nil {
 		errs = multierror.Append(errs, fmt.Errorf("error sweeping WAF XSS Match Sets for %s: %w", region, err))
 	}
 
@@ -19,7 +19,7 @@ import (
 )
 
 func init() {
-	resource.AddTestSweepers("aws_wafregional_rate_based_rule", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_wafregional_rate_based_rule", &resource.Sweeper{
 		Name: "aws_wafregional_rate_based_rule",
 		F:    sweepRateBasedRules,
 		Dependencies: []string{
@@ -27,17 +27,17 @@ func init() {
 		},
 	})
 
-	resource.AddTestSweepers("aws_wafregional_regex_match_set", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_wafregional_regex_match_set", &resource.Sweeper{
 		Name: "aws_wafregional_regex_match_set",
 		F:    sweepRegexMatchSet,
 	})
 
-	resource.AddTestSweepers("aws_wafregional_rule_group", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_wafregional_rule_group", &resource.Sweeper{
 		Name: "aws_wafregional_rule_group",
 		F:    sweepRuleGroups,
 	})
 
-	resource.AddTestSweepers("aws_wafregional_rule", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_wafregional_rule", &resource.Sweeper{
 		Name: "aws_wafregional_rule",
 		F:    sweepRules,
 		Dependencies: []string{
@@ -45,7 +45,7 @@ func init() {
 		},
 	})
 
-	resource.AddTestSweepers("aws_wafregional_web_acl", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_wafregional_web_acl", &resource.Sweeper{
 		Name: "aws_wafregional_web_acl",
 		F:    sweepWebACLs,
 	})
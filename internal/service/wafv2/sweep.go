@@ -17,7 +17,7 @@ import (
 )
 
 func init() {
-	resource.AddTestSweepers("aws_wafv2_ip_set", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_wafv2_ip_set", &resource.Sweeper{
 		Name: "aws_wafv2_ip_set",
 		F:    sweepIPSets,
 		Dependencies: []string{
@@ -26,7 +26,7 @@ func init() {
 		},
 	})
 
-	resource.AddTestSweepers("aws_wafv2_regex_pattern_set", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_wafv2_regex_pattern_set", &resource.Sweeper{
 		Name: "aws_wafv2_regex_pattern_set",
 		F:    sweepRegexPatternSets,
 		Dependencies: []string{
@@ -35,7 +35,7 @@ func init() {
 		},
 	})
 
-	resource.AddTestSweepers("aws_wafv2_rule_group", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_wafv2_rule_group", &resource.Sweeper{
 		Name: "aws_wafv2_rule_group",
 		F:    sweepRuleGroups,
 		Dependencies: []string{
@@ -43,7 +43,7 @@ func init() {
 		},
 	})
 
-	resource.AddTestSweepers("aws_wafv2_web_acl", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_wafv2_web_acl", &resource.Sweeper{
 		Name: "aws_wafv2_web_acl",
 		F:    sweepWebACLs,
 	})
@@ -259,7 +259,7 @@ func sweepWebACLs(region string) error {
 		errs = multierror.Append(errs, fmt.Errorf("error describing WAFv2 Web ACLs for %s: %w", region, err))
 	}
 
-	if err := sweep.SweepOrchestrator(sweepResources); err != nil {
+	if err := sweep.SweepOrchestrator("aws_wafv2_web_acl", sweepResources); err != nil {
 		errs = multierror.Append(errs, fmt.Errorf("error sweeping WAFv2 Web ACLs for %s: %w", region, err))
 	}
 
@@ -16,18 +16,18 @@ import (
 )
 
 func init() {
-	resource.AddTestSweepers("aws_workspaces_directory", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_workspaces_directory", &resource.Sweeper{
 		Name:         "aws_workspaces_directory",
 		F:            sweepDirectories,
 		Dependencies: []string{"aws_workspaces_workspace", "aws_workspaces_ip_group"},
 	})
 
-	resource.AddTestSweepers("aws_workspaces_ip_group", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_workspaces_ip_group", &resource.Sweeper{
 		Name: "aws_workspaces_ip_group",
 		F:    sweepIPGroups,
 	})
 
-	resource.AddTestSweepers("aws_workspaces_workspace", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_workspaces_workspace", &resource.Sweeper{
 		Name: "aws_workspaces_workspace",
 		F:    sweepWorkspace,
 	})
@@ -67,7 +67,7 @@ func sweepDirectories(region string) error {
 		return fmt.Errorf("error listing WorkSpaces Directories (%s): %w", region, err)
 	}
 
-	err = sweep.SweepOrchestrator(sweepResources)
+	err = sweep.SweepOrchestrator("aws_workspaces_directory", sweepResources)
 
 	if err != nil {
 		return fmt.Errorf("error sweeping WorkSpaces Directories (%s): %w", region, err)
@@ -110,7 +110,7 @@ func sweepIPGroups(region string) error {
 		return fmt.Errorf("error listing WorkSpaces Ip Groups (%s): %w", region, err)
 	}
 
-	err = sweep.SweepOrchestrator(sweepResources)
+	err = sweep.SweepOrchestrator("aws_workspaces_ip_group", sweepResources)
 
 	if err != nil {
 		return fmt.Errorf("error sweeping WorkSpaces Ip Groups (%s): %w", region, err)
@@ -0,0 +1,454 @@
+//go:build sweep
+// +build sweep
+
+package sweep
+
+import (
+	"encoding/json"
+	"flag"
+	"fmt"
+	"log"
+	"os"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+)
+
+const (
+	StatusFailed     = "failed"
+	StatusIncomplete = "incomplete"
+	StatusSkipped    = "skipped"
+	StatusSwept      = "swept"
+	StatusWouldSweep = "would_sweep"
+)
+
+// The -sweep, -sweep-run and -sweep-allow-failures flags are registered by the Plugin SDK's resource package.
+var (
+	flagSweepDryRun      = flag.Bool("sweep-dry-run", false, "List what Sweepers would delete without deleting anything")
+	flagSweepParallelism = flag.Int("sweep-parallelism", 10, "Maximum number of Sweepers to run concurrently")
+	flagSweepReport      = flag.String("sweep-report", "", "Path to write a JSON report of swept, skipped and failed resources to")
+)
+
+var (
+	// sweepers is the registry of all Sweepers, keyed by name.
+	sweepers = make(map[string]*resource.Sweeper)
+
+	// dryRun is set when Sweepers must not delete anything.
+	dryRun bool
+
+	// recorder collects per-resource results for the report.
+	recorder = newResourceRecorder()
+)
+
+// AddTestSweepers registers a Sweeper to be run by TestMain.
+// It replaces resource.AddTestSweepers so that Sweepers can be scheduled concurrently.
+func AddTestSweepers(name string, s *resource.Sweeper) {
+	if _, ok := sweepers[name]; ok {
+		log.Fatalf("[ERR] Error adding (%s) to sweepers: function already exists in map", name)
+	}
+
+	sweepers[name] = s
+}
+
+// TestMain runs the registered Sweepers if the -sweep flag is set, otherwise the tests.
+func TestMain(m interface {
+	Run() int
+}) {
+	flag.Parse()
+
+	regions := flagValue("sweep")
+
+	if regions == "" {
+		os.Exit(m.Run())
+	}
+
+	dryRun = *flagSweepDryRun
+
+	r := &runner{
+		allowFailures: flagValue("sweep-allow-failures") == "true",
+		dryRun:        dryRun,
+		parallelism:   *flagSweepParallelism,
+		sweepers:      filterSweepers(flagValue("sweep-run"), sweepers),
+	}
+
+	report, err := r.run(strings.Split(regions, ","))
+
+	if path := *flagSweepReport; path != "" {
+		if err := writeReport(path, report); err != nil {
+			log.Printf("[ERROR] Error writing sweeper report (%s): %s", path, err)
+			os.Exit(1)
+		}
+	}
+
+	if err != nil {
+		log.Printf("[ERROR] %s", err)
+		os.Exit(1)
+	}
+}
+
+// Report is the machine-readable result of a sweeper run.
+type Report struct {
+	DryRun  bool                     `json:"dry_run"`
+	Regions map[string]*RegionReport `json:"regions"`
+}
+
+// RegionReport is the result of running Sweepers in a single region.
+type RegionReport struct {
+	Duration string                    `json:"duration"`
+	Sweepers map[string]*SweeperReport `json:"sweepers"`
+}
+
+// SweeperReport is the result of running a single Sweeper in a single region.
+type SweeperReport struct {
+	Status    string            `json:"status"`
+	Error     string            `json:"error,omitempty"`
+	Duration  string            `json:"duration,omitempty"`
+	Resources []*ResourceReport `json:"resources,omitempty"`
+}
+
+// ResourceReport is the result of sweeping a single resource.
+type ResourceReport struct {
+	ID     string `json:"id"`
+	Status string `json:"status"`
+	Error  string `json:"error,omitempty"`
+}
+
+type runner struct {
+	allowFailures bool
+	dryRun        bool
+	parallelism   int
+	sweepers      map[string]*resource.Sweeper
+}
+
+// run runs the Sweepers in all regions concurrently.
+// Each Sweeper is started as soon as all its dependencies have completed.
+func (r *runner) run(regions []string) (*Report, error) {
+	if err := validateSweepers(r.sweepers); err != nil {
+		return nil, err
+	}
+
+	parallelism := r.parallelism
+	if parallelism < 1 {
+		parallelism = 1
+	}
+
+	sem := make(chan struct{}, parallelism)
+	report := &Report{
+		DryRun:  r.dryRun,
+		Regions: make(map[string]*RegionReport),
+	}
+
+	var wg sync.WaitGroup
+	var mu sync.Mutex
+
+	for _, region := range regions {
+		region := strings.TrimSpace(region)
+
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+
+			regionReport := r.runRegion(region, sem)
+
+			mu.Lock()
+			report.Regions[region] = regionReport
+			mu.Unlock()
+		}()
+	}
+
+	wg.Wait()
+
+	var failed []string
+
+	for _, region := range regions {
+		region := strings.TrimSpace(region)
+
+		for name, sweeperReport := range report.Regions[region].Sweepers {
+			if sweeperReport.Status == StatusFailed {
+				failed = append(failed, fmt.Sprintf("%s (%s)", name, region))
+			}
+		}
+	}
+
+	if len(failed) > 0 {
+		sort.Strings(failed)
+
+		return report, fmt.Errorf("%d sweeper(s) failed: %s", len(failed), strings.Join(failed, ", "))
+	}
+
+	return report, nil
+}
+
+// runRegion runs the Sweepers in a single region, respecting dependencies.
+// Unless failures are allowed, Sweepers not yet started when a Sweeper fails are skipped.
+func (r *runner) runRegion(region string, sem chan struct{}) *RegionReport {
+	start := time.Now()
+	log.Printf("[DEBUG] Running Sweepers for region (%s)", region)
+
+	done := make(map[string]chan struct{}, len(r.sweepers))
+	for name := range r.sweepers {
+		done[name] = make(chan struct{})
+	}
+
+	regionReport := &RegionReport{
+		Sweepers: make(map[string]*SweeperReport, len(r.sweepers)),
+	}
+
+	var wg sync.WaitGroup
+	var mu sync.Mutex
+	var aborted bool
+
+	for name, sweeper := range r.sweepers {
+		name, sweeper := name, sweeper
+
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			defer close(done[name])
+
+			for _, dep := range sweeper.Dependencies {
+				<-done[dep]
+			}
+
+			mu.Lock()
+			skipReason := ""
+			if aborted {
+				skipReason = "a previous sweeper failed"
+			} else if !r.allowFailures {
+				for _, dep := range sweeper.Dependencies {
+					if status := regionReport.Sweepers[dep].Status; status == StatusFailed || status == StatusSkipped {
+						skipReason = fmt.Sprintf("dependency (%s) %s", dep, status)
+						break
+					}
+				}
+			}
+			mu.Unlock()
+
+			var sweeperReport *SweeperReport
+
+			if skipReason != "" {
+				log.Printf("[WARN] Skipping Sweeper (%s) in region (%s): %s", name, region, skipReason)
+
+				sweeperReport = &SweeperReport{
+					Status: StatusSkipped,
+					Error:  skipReason,
+				}
+			} else {
+				sem <- struct{}{}
+				sweeperReport = r.runSweeper(region, name, sweeper)
+				<-sem
+			}
+
+			mu.Lock()
+			regionReport.Sweepers[name] = sweeperReport
+			if sweeperReport.Status == StatusFailed && !r.allowFailures {
+				aborted = true
+			}
+			mu.Unlock()
+		}()
+	}
+
+	wg.Wait()
+
+	elapsed := time.Since(start)
+	regionReport.Duration = elapsed.String()
+
+	for name, sweeperReport := range regionReport.Sweepers {
+		sweeperReport.Resources = recorder.resources(region, name)
+	}
+
+	log.Printf("[INFO] Completed Sweepers for region (%s) in %s", region, elapsed)
+
+	return regionReport
+}
+
+func (r *runner) runSweeper(region, name string, sweeper *resource.Sweeper) *SweeperReport {
+	log.Printf("[DEBUG] Running Sweeper (%s) in region (%s)", name, region)
+
+	start := time.Now()
+	err := sweeper.F(region)
+	elapsed := time.Since(start)
+
+	log.Printf("[DEBUG] Completed Sweeper (%s) in region (%s) in %s", name, region, elapsed)
+
+	sweeperReport := &SweeperReport{
+		Status:   StatusSwept,
+		Duration: elapsed.String(),
+	}
+
+	if r.dryRun {
+		sweeperReport.Status = StatusWouldSweep
+	}
+
+	if err != nil {
+		sweeperReport.Error = err.Error()
+
+		// Sweepers that delete resources directly, rather than via SweepOrchestrator, have their deletions blocked by the read-only client in a dry run.
+		// Such a Sweeper may stop listing at the first blocked deletion, so the resources it would delete are not known.
+		if r.dryRun && isReadOnlyOperationBlocked(err) {
+			log.Printf("[WARN] Dry run: Sweeper (%s) in region (%s) stopped at a blocked operation, resources may not all be listed: %s", name, region, err)
+
+			sweeperReport.Status = StatusIncomplete
+
+			return sweeperReport
+		}
+
+		log.Printf("[ERROR] Error running Sweeper (%s) in region (%s): %s", name, region, err)
+
+		sweeperReport.Status = StatusFailed
+	}
+
+	return sweeperReport
+}
+
+// isReadOnlyOperationBlocked returns whether the error is due to an API operation blocked by a read-only client.
+// Sweepers commonly format errors with %s, so the error chain cannot be relied upon.
+func isReadOnlyOperationBlocked(err error) bool {
+	return tfawserr.ErrCodeEquals(err, conns.ErrCodeReadOnlyOperationBlocked) || strings.Contains(err.Error(), conns.ErrCodeReadOnlyOperationBlocked)
+}
+
+// validateSweepers returns an error if any Sweeper has a missing dependency or the dependencies contain a cycle.
+func validateSweepers(sweepers map[string]*resource.Sweeper) error {
+	const (
+		unvisited = iota
+		visiting
+		visited
+	)
+
+	state := make(map[string]int, len(sweepers))
+
+	var visit func(name string) error
+	visit = func(name string) error {
+		switch state[name] {
+		case visiting:
+			return fmt.Errorf("sweeper (%s) has a circular dependency", name)
+		case visited:
+			return nil
+		}
+
+		state[name] = visiting
+
+		for _, dep := range sweepers[name].Dependencies {
+			if _, ok := sweepers[dep]; !ok {
+				return fmt.Errorf("sweeper (%s) has dependency (%s), but that sweeper was not found", name, dep)
+			}
+
+			if err := visit(dep); err != nil {
+				return err
+			}
+		}
+
+		state[name] = visited
+
+		return nil
+	}
+
+	for name := range sweepers {
+		if err := visit(name); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// filterSweepers returns the Sweepers whose names contain any of the comma-separated filter values, and their dependencies.
+func filterSweepers(f string, source map[string]*resource.Sweeper) map[string]*resource.Sweeper {
+	filters := strings.Split(strings.ToLower(f), ",")
+	if len(filters) == 1 && filters[0] == "" {
+		return source
+	}
+
+	result := make(map[string]*resource.Sweeper)
+
+	var add func(name string)
+	add = func(name string) {
+		if _, ok := result[name]; ok {
+			return
+		}
+
+		sweeper, ok := source[name]
+		if !ok {
+			log.Printf("[WARN] Sweeper has dependency (%s), but that sweeper was not found", name)
+			return
+		}
+
+		result[name] = sweeper
+
+		for _, dep := range sweeper.Dependencies {
+			add(dep)
+		}
+	}
+
+	for name := range source {
+		for _, filter := range filters {
+			if strings.Contains(strings.ToLower(name), filter) {
+				add(name)
+			}
+		}
+	}
+
+	return result
+}
+
+func writeReport(path string, report *Report) error {
+	b, err := json.MarshalIndent(report, "", "  ")
+
+	if err != nil {
+		return err
+	}
+
+	return os.WriteFile(path, b, 0644) //nolint:gosec
+}
+
+func flagValue(name string) string {
+	if f := flag.Lookup(name); f != nil {
+		return f.Value.String()
+	}
+
+	return ""
+}
+
+// resourceRecorder collects per-resource sweep results, keyed by region and Sweeper name.
+type resourceRecorder struct {
+	mu      sync.Mutex
+	results map[string]map[string][]*ResourceReport
+}
+
+func newResourceRecorder() *resourceRecorder {
+	return &resourceRecorder{
+		results: make(map[string]map[string][]*ResourceReport),
+	}
+}
+
+func (r *resourceRecorder) record(region, sweeper, id, status string, err error) {
+	result := &ResourceReport{
+		ID:     id,
+		Status: status,
+	}
+
+	if err != nil {
+		result.Error = err.Error()
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if _, ok := r.results[region]; !ok {
+		r.results[region] = make(map[string][]*ResourceReport)
+	}
+
+	r.results[region][sweeper] = append(r.results[region][sweeper], result)
+}
+
+func (r *resourceRecorder) resources(region, sweeper string) []*ResourceReport {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	return r.results[region][sweeper]
+}
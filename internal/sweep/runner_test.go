@@ -0,0 +1,249 @@
+//go:build sweep
+// +build sweep
+
+package sweep
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	multierror "github.com/hashicorp/go-multierror"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+)
+
+func TestRunnerDependencyOrder(t *testing.T) {
+	var mu sync.Mutex
+	var order []string
+
+	sweeper := func(name string, err error, dependencies ...string) *resource.Sweeper {
+		return &resource.Sweeper{
+			Name:         name,
+			Dependencies: dependencies,
+			F: func(region string) error {
+				mu.Lock()
+				order = append(order, name)
+				mu.Unlock()
+
+				return err
+			},
+		}
+	}
+
+	testCases := []struct {
+		Name           string
+		Sweepers       map[string]*resource.Sweeper
+		AllowFailures  bool
+		ExpectError    bool
+		ExpectedStatus map[string]string
+	}{
+		{
+			Name: "dependencies",
+			Sweepers: map[string]*resource.Sweeper{
+				"aws_a": sweeper("aws_a", nil, "aws_b", "aws_c"),
+				"aws_b": sweeper("aws_b", nil, "aws_c"),
+				"aws_c": sweeper("aws_c", nil),
+				"aws_d": sweeper("aws_d", nil),
+			},
+			ExpectedStatus: map[string]string{
+				"aws_a": StatusSwept,
+				"aws_b": StatusSwept,
+				"aws_c": StatusSwept,
+				"aws_d": StatusSwept,
+			},
+		},
+		{
+			Name: "failed dependency",
+			Sweepers: map[string]*resource.Sweeper{
+				"aws_a": sweeper("aws_a", nil, "aws_b"),
+				"aws_b": sweeper("aws_b", errors.New("test")),
+			},
+			ExpectError: true,
+			ExpectedStatus: map[string]string{
+				"aws_a": StatusSkipped,
+				"aws_b": StatusFailed,
+			},
+		},
+		{
+			Name: "failed dependency allow failures",
+			Sweepers: map[string]*resource.Sweeper{
+				"aws_a": sweeper("aws_a", nil, "aws_b"),
+				"aws_b": sweeper("aws_b", errors.New("test")),
+			},
+			AllowFailures: true,
+			ExpectError:   true,
+			ExpectedStatus: map[string]string{
+				"aws_a": StatusSwept,
+				"aws_b": StatusFailed,
+			},
+		},
+		{
+			Name: "missing dependency",
+			Sweepers: map[string]*resource.Sweeper{
+				"aws_a": sweeper("aws_a", nil, "aws_b"),
+			},
+			ExpectError: true,
+		},
+		{
+			Name: "circular dependency",
+			Sweepers: map[string]*resource.Sweeper{
+				"aws_a": sweeper("aws_a", nil, "aws_b"),
+				"aws_b": sweeper("aws_b", nil, "aws_a"),
+			},
+			ExpectError: true,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			order = nil
+
+			r := &runner{
+				allowFailures: testCase.AllowFailures,
+				parallelism:   4,
+				sweepers:      testCase.Sweepers,
+			}
+
+			report, err := r.run([]string{"us-west-2"})
+
+			if testCase.ExpectError && err == nil {
+				t.Fatal("expected error")
+			} else if !testCase.ExpectError && err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if testCase.ExpectedStatus == nil {
+				return
+			}
+
+			for name, expected := range testCase.ExpectedStatus {
+				if got := report.Regions["us-west-2"].Sweepers[name].Status; got != expected {
+					t.Errorf("sweeper (%s): got status %s, expected %s", name, got, expected)
+				}
+			}
+
+			position := make(map[string]int, len(order))
+			for i, name := range order {
+				position[name] = i
+			}
+
+			for name, s := range testCase.Sweepers {
+				for _, dep := range s.Dependencies {
+					i, ran := position[name]
+					if j, depRan := position[dep]; ran && depRan && j > i {
+						t.Errorf("sweeper (%s) ran before its dependency (%s)", name, dep)
+					}
+				}
+			}
+		})
+	}
+}
+
+func TestRunnerDryRunDirectDelete(t *testing.T) {
+	// A Sweeper that deletes resources directly gets an error from the read-only client for each blocked deletion.
+	directDelete := func(region string) error {
+		var errs *multierror.Error
+
+		for _, id := range []string{"thing-1", "thing-2"} {
+			err := awserr.New(conns.ErrCodeReadOnlyOperationBlocked, "example.DeleteThing blocked by read-only client", nil)
+			errs = multierror.Append(errs, fmt.Errorf("error deleting Example Thing (%s): %s", id, err))
+		}
+
+		return errs.ErrorOrNil()
+	}
+
+	var dependentRan bool
+
+	r := &runner{
+		dryRun:      true,
+		parallelism: 4,
+		sweepers: map[string]*resource.Sweeper{
+			"aws_a": {
+				Name:         "aws_a",
+				Dependencies: []string{"aws_b"},
+				F: func(region string) error {
+					dependentRan = true
+					return nil
+				},
+			},
+			"aws_b": {
+				Name: "aws_b",
+				F:    directDelete,
+			},
+		},
+	}
+
+	report, err := r.run([]string{"us-west-2"})
+
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if !dependentRan {
+		t.Error("expected dependent sweeper to run")
+	}
+
+	if got := report.Regions["us-west-2"].Sweepers["aws_a"].Status; got != StatusWouldSweep {
+		t.Errorf("sweeper (aws_a): got status %s, expected %s", got, StatusWouldSweep)
+	}
+
+	if got := report.Regions["us-west-2"].Sweepers["aws_b"].Status; got != StatusIncomplete {
+		t.Errorf("sweeper (aws_b): got status %s, expected %s", got, StatusIncomplete)
+	}
+
+	if got := report.Regions["us-west-2"].Sweepers["aws_b"].Error; !strings.Contains(got, "thing-2") {
+		t.Errorf("expected blocked deletions in error, got %q", got)
+	}
+}
+
+func TestRunnerDryRunFailure(t *testing.T) {
+	r := &runner{
+		dryRun:      true,
+		parallelism: 4,
+		sweepers: map[string]*resource.Sweeper{
+			"aws_a": {
+				Name: "aws_a",
+				F: func(region string) error {
+					return errors.New("error listing Example Things: AccessDenied")
+				},
+			},
+		},
+	}
+
+	report, err := r.run([]string{"us-west-2"})
+
+	if err == nil {
+		t.Fatal("expected error")
+	}
+
+	if got := report.Regions["us-west-2"].Sweepers["aws_a"].Status; got != StatusFailed {
+		t.Errorf("got status %s, expected %s", got, StatusFailed)
+	}
+}
+
+func TestFilterSweepers(t *testing.T) {
+	source := map[string]*resource.Sweeper{
+		"aws_a": {Name: "aws_a", Dependencies: []string{"aws_b"}},
+		"aws_b": {Name: "aws_b", Dependencies: []string{"aws_c"}},
+		"aws_c": {Name: "aws_c"},
+		"aws_d": {Name: "aws_d"},
+	}
+
+	got := filterSweepers("AWS_A", source)
+
+	if len(got) != 3 {
+		t.Fatalf("got %d sweepers, expected 3", len(got))
+	}
+
+	if _, ok := got["aws_d"]; ok {
+		t.Error("unexpected sweeper aws_d")
+	}
+
+	if got := filterSweepers("", source); len(got) != len(source) {
+		t.Errorf("got %d sweepers, expected %d", len(got), len(source))
+	}
+}
@@ -10,6 +10,7 @@ import (
 	"os"
 	"strconv"
 	"strings"
+	"sync"
 	"time"
 
 	"github.com/aws/aws-sdk-go/aws/endpoints"
@@ -34,9 +35,15 @@ const defaultSweeperAssumeRoleDurationSeconds = 3600
 // This prevents client re-initialization for every resource with no benefit.
 var SweeperClients map[string]interface{}
 
+// sweeperClientsMu guards SweeperClients, as Sweepers for different regions run concurrently.
+var sweeperClientsMu sync.Mutex
+
 // SharedRegionalSweepClient returns a common conns.AWSClient setup needed for the sweeper
 // functions for a given region
 func SharedRegionalSweepClient(region string) (interface{}, error) {
+	sweeperClientsMu.Lock()
+	defer sweeperClientsMu.Unlock()
+
 	if client, ok := SweeperClients[region]; ok {
 		return client, nil
 	}
@@ -55,6 +62,7 @@ func SharedRegionalSweepClient(region string) (interface{}, error) {
 
 	conf := &conns.Config{
 		MaxRetries: 5,
+		ReadOnly:   dryRun,
 		Region:     region,
 	}
 
@@ -104,11 +112,30 @@ func NewSweepResource(resource *schema.Resource, d *schema.ResourceData, meta in
 	}
 }
 
-func SweepOrchestrator(sweepResources []*SweepResource) error {
-	return SweepOrchestratorContext(context.Background(), sweepResources, 0*time.Millisecond, 0*time.Millisecond, 0*time.Millisecond, 0*time.Millisecond, SweepThrottlingRetryTimeout)
+// region returns the region of the client the resource is swept with.
+func (sr *SweepResource) region() string {
+	if client, ok := sr.meta.(*conns.AWSClient); ok {
+		return client.Region
+	}
+
+	return ""
 }
 
-func SweepOrchestratorContext(ctx context.Context, sweepResources []*SweepResource, delay time.Duration, delayRand time.Duration, minTimeout time.Duration, pollInterval time.Duration, timeout time.Duration) error {
+// SweepOrchestrator deletes the specified resources concurrently, recording the result for each resource against the named Sweeper.
+func SweepOrchestrator(sweeper string, sweepResources []*SweepResource) error {
+	return SweepOrchestratorContext(context.Background(), sweeper, sweepResources, 0*time.Millisecond, 0*time.Millisecond, 0*time.Millisecond, 0*time.Millisecond, SweepThrottlingRetryTimeout)
+}
+
+func SweepOrchestratorContext(ctx context.Context, sweeper string, sweepResources []*SweepResource, delay time.Duration, delayRand time.Duration, minTimeout time.Duration, pollInterval time.Duration, timeout time.Duration) error {
+	if dryRun {
+		for _, sweepResource := range sweepResources {
+			log.Printf("[INFO] Dry run: would sweep resource (%s) with Sweeper (%s)", sweepResource.d.Id(), sweeper)
+			recorder.record(sweepResource.region(), sweeper, sweepResource.d.Id(), StatusWouldSweep, nil)
+		}
+
+		return nil
+	}
+
 	var g multierror.Group
 
 	for _, sweepResource := range sweepResources {
@@ -134,6 +161,12 @@ func SweepOrchestratorContext(ctx context.Context, sweepResources []*SweepResour
 				err = DeleteResource(sweepResource.resource, sweepResource.d, sweepResource.meta)
 			}
 
+			if err != nil {
+				recorder.record(sweepResource.region(), sweeper, sweepResource.d.Id(), StatusFailed, err)
+			} else {
+				recorder.record(sweepResource.region(), sweeper, sweepResource.d.Id(), StatusSwept, nil)
+			}
+
 			return err
 		})
 	}
@@ -6,7 +6,6 @@ package sweep_test
 import (
 	"testing"
 
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	_ "github.com/hashicorp/terraform-provider-aws/internal/service/accessanalyzer"
 	_ "github.com/hashicorp/terraform-provider-aws/internal/service/acm"
 	_ "github.com/hashicorp/terraform-provider-aws/internal/service/acmpca"
@@ -112,5 +111,5 @@ import (
 
 func TestMain(m *testing.M) {
 	sweep.SweeperClients = make(map[string]interface{})
-	resource.TestMain(m)
+	sweep.TestMain(m)
 }
@@ -19,15 +19,39 @@ const (
 	ServerlessApplicationRepositoryTagKeyPrefix = `serverlessrepo:`
 )
 
+const (
+	// Resource tags override default tags with matching keys.
+	TagsAllPrecedenceResource = "resource"
+	// Resource tags with matching keys must not change default tag values.
+	TagsAllPrecedenceProvider = "provider"
+)
+
+func TagsAllPrecedence_Values() []string {
+	return []string{
+		TagsAllPrecedenceProvider,
+		TagsAllPrecedenceResource,
+	}
+}
+
 // DefaultConfig contains tags to default across all resources.
 type DefaultConfig struct {
-	Tags KeyValueTags
+	Tags              KeyValueTags
+	TagsAllPrecedence string
 }
 
 // IgnoreConfig contains various options for removing resource tags.
 type IgnoreConfig struct {
 	Keys        KeyValueTags
 	KeyPrefixes KeyValueTags
+	KeyRegexes  []*regexp.Regexp
+	KeySuffixes KeyValueTags
+}
+
+// PolicyConfig contains a tag policy that resource tags must comply with.
+type PolicyConfig struct {
+	AllowedValues map[string][]string
+	KeyRegex      *regexp.Regexp
+	RequiredKeys  []string
 }
 
 // KeyValueTags is a standard implementation for AWS key-value resource tags.
@@ -69,6 +93,25 @@ func (dc *DefaultConfig) MergeTags(tags KeyValueTags) KeyValueTags {
 	return dc.Tags.Merge(tags)
 }
 
+// OverriddenTags returns the given KeyValueTags whose keys are also present
+// in the DefaultConfig.Tags but with a different value, i.e. the tags that
+// are rejected when the provider takes precedence.
+func (dc *DefaultConfig) OverriddenTags(tags KeyValueTags) KeyValueTags {
+	result := make(KeyValueTags)
+
+	if dc == nil || dc.Tags == nil {
+		return result
+	}
+
+	for k, v := range tags {
+		if defaultVal, ok := dc.Tags[k]; ok && !v.Equal(defaultVal) {
+			result[k] = v
+		}
+	}
+
+	return result
+}
+
 // TagsEqual returns true if the given configuration's Tags
 // are equal to those passed in as an argument;
 // otherwise returns false
@@ -88,6 +131,64 @@ func (dc *DefaultConfig) TagsEqual(tags KeyValueTags) bool {
 	return dc.Tags.ContainsAll(tags)
 }
 
+// Violations returns a description of each way the given tags do not comply
+// with the policy, or nothing if they comply.
+func (pc *PolicyConfig) Violations(tags KeyValueTags) []string {
+	if pc == nil {
+		return nil
+	}
+
+	var violations []string
+	var missingKeys []string
+
+	for _, k := range pc.RequiredKeys {
+		if !tags.KeyExists(k) {
+			missingKeys = append(missingKeys, k)
+		}
+	}
+
+	if len(missingKeys) > 0 {
+		sort.Strings(missingKeys)
+		violations = append(violations, fmt.Sprintf("missing required tag keys: %s", strings.Join(missingKeys, ", ")))
+	}
+
+	keys := tags.Keys()
+	sort.Strings(keys)
+
+	for _, k := range keys {
+		if pc.KeyRegex != nil && !pc.KeyRegex.MatchString(k) {
+			violations = append(violations, fmt.Sprintf("tag key %q does not match %q", k, pc.KeyRegex.String()))
+		}
+
+		allowedValues, ok := pc.AllowedValues[k]
+
+		if !ok {
+			continue
+		}
+
+		var value string
+
+		if v := tags.KeyValue(k); v != nil {
+			value = *v
+		}
+
+		allowed := false
+
+		for _, allowedValue := range allowedValues {
+			if value == allowedValue {
+				allowed = true
+				break
+			}
+		}
+
+		if !allowed {
+			violations = append(violations, fmt.Sprintf("tag %q value %q is not one of the allowed values: %s", k, value, strings.Join(allowedValues, ", ")))
+		}
+	}
+
+	return violations
+}
+
 // IgnoreConfig returns any tags not removed by a given configuration.
 func (tags KeyValueTags) IgnoreConfig(config *IgnoreConfig) KeyValueTags {
 	if config == nil {
@@ -95,6 +196,8 @@ func (tags KeyValueTags) IgnoreConfig(config *IgnoreConfig) KeyValueTags {
 	}
 
 	result := tags.IgnorePrefixes(config.KeyPrefixes)
+	result = result.IgnoreSuffixes(config.KeySuffixes)
+	result = result.IgnoreRegexes(config.KeyRegexes)
 	result = result.Ignore(config.Keys)
 
 	return result
@@ -166,6 +269,30 @@ func (tags KeyValueTags) IgnoreRds() KeyValueTags {
 	return result
 }
 
+// IgnoreRegexes returns tag keys not matching any of the regular expressions.
+func (tags KeyValueTags) IgnoreRegexes(ignoreTagRegexes []*regexp.Regexp) KeyValueTags {
+	result := make(KeyValueTags)
+
+	for k, v := range tags {
+		var ignore bool
+
+		for _, ignoreTagRegex := range ignoreTagRegexes {
+			if ignoreTagRegex.MatchString(k) {
+				ignore = true
+				break
+			}
+		}
+
+		if ignore {
+			continue
+		}
+
+		result[k] = v
+	}
+
+	return result
+}
+
 // IgnoreServerlessApplicationRepository returns non-AWS and non-ServerlessApplicationRepository tag keys.
 func (tags KeyValueTags) IgnoreServerlessApplicationRepository() KeyValueTags {
 	result := make(KeyValueTags)
@@ -185,6 +312,30 @@ func (tags KeyValueTags) IgnoreServerlessApplicationRepository() KeyValueTags {
 	return result
 }
 
+// IgnoreSuffixes returns non-matching tag key suffixes.
+func (tags KeyValueTags) IgnoreSuffixes(ignoreTagSuffixes KeyValueTags) KeyValueTags {
+	result := make(KeyValueTags)
+
+	for k, v := range tags {
+		var ignore bool
+
+		for ignoreTagSuffix := range ignoreTagSuffixes {
+			if strings.HasSuffix(k, ignoreTagSuffix) {
+				ignore = true
+				break
+			}
+		}
+
+		if ignore {
+			continue
+		}
+
+		result[k] = v
+	}
+
+	return result
+}
+
 // Ignore returns non-matching tag keys.
 func (tags KeyValueTags) Ignore(ignoreTags KeyValueTags) KeyValueTags {
 	result := make(KeyValueTags)
@@ -391,6 +542,66 @@ func (tags KeyValueTags) Updated(newTags KeyValueTags) KeyValueTags {
 	return result
 }
 
+// RemovedFold returns tags removed, matching keys case-insensitively.
+func (tags KeyValueTags) RemovedFold(newTags KeyValueTags) KeyValueTags {
+	result := make(KeyValueTags)
+
+	for k, v := range tags {
+		if _, ok := newTags.keyFold(k); !ok {
+			result[k] = v
+		}
+	}
+
+	return result
+}
+
+// UpdatedFold returns tags added and updated, matching keys case-insensitively.
+// Tags whose key differs only in case and whose value is unchanged are not returned.
+func (tags KeyValueTags) UpdatedFold(newTags KeyValueTags) KeyValueTags {
+	result := make(KeyValueTags)
+
+	for k, newV := range newTags {
+		if oldK, ok := tags.keyFold(k); !ok || !tags[oldK].Equal(newV) {
+			result[k] = newV
+		}
+	}
+
+	return result
+}
+
+// FoldKeys returns tags whose keys match a key in casingTags case-insensitively
+// using the casing of that key, e.g. to keep the configured casing of tag keys
+// that an AWS service normalizes.
+func (tags KeyValueTags) FoldKeys(casingTags KeyValueTags) KeyValueTags {
+	result := make(KeyValueTags)
+
+	for k, v := range tags {
+		if casingK, ok := casingTags.keyFold(k); ok {
+			result[casingK] = v
+		} else {
+			result[k] = v
+		}
+	}
+
+	return result
+}
+
+// keyFold returns the tag key matching the given key case-insensitively,
+// preferring an exact match.
+func (tags KeyValueTags) keyFold(key string) (string, bool) {
+	if _, ok := tags[key]; ok {
+		return key, true
+	}
+
+	for k := range tags {
+		if strings.EqualFold(k, key) {
+			return k, true
+		}
+	}
+
+	return "", false
+}
+
 // Chunks returns a slice of KeyValueTags, each of the specified size.
 func (tags KeyValueTags) Chunks(size int) []KeyValueTags {
 	result := []KeyValueTags{}
@@ -450,6 +661,35 @@ func (tags KeyValueTags) Equal(other KeyValueTags) bool {
 	return true
 }
 
+// EqualFold returns whether or two sets of key-value tags are equal,
+// matching keys case-insensitively. Values are compared case-sensitively.
+func (tags KeyValueTags) EqualFold(other KeyValueTags) bool {
+	if tags == nil && other == nil {
+		return true
+	}
+
+	if tags == nil || other == nil {
+		return false
+	}
+
+	if len(tags) != len(other) {
+		return false
+	}
+
+	for k, v := range tags {
+		o, ok := other.keyFold(k)
+		if !ok {
+			return false
+		}
+
+		if !v.Equal(other[o]) {
+			return false
+		}
+	}
+
+	return true
+}
+
 // Hash returns a stable hash value.
 // The returned value may be negative (i.e. not suitable for a 'Set' function).
 func (tags KeyValueTags) Hash() int {
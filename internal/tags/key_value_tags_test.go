@@ -1,6 +1,8 @@
 package tags
 
 import (
+	"reflect"
+	"regexp"
 	"testing"
 )
 
@@ -173,6 +175,48 @@ func TestKeyValueTagsDefaultConfigMergeTags(t *testing.T) {
 				"key6": "value6",
 			},
 		},
+		{
+			name: "keys some matching provider precedence",
+			tags: New(map[string]string{
+				"key1": "value1",
+				"key2": "value2",
+				"key3": "value3",
+			}),
+			defaultConfig: &DefaultConfig{
+				Tags: New(map[string]string{
+					"key1": "value1",
+					"key4": "value4",
+				}),
+				TagsAllPrecedence: TagsAllPrecedenceProvider,
+			},
+			want: map[string]string{
+				"key1": "value1",
+				"key2": "value2",
+				"key3": "value3",
+				"key4": "value4",
+			},
+		},
+		{
+			name: "keys some matching resource precedence",
+			tags: New(map[string]string{
+				"key1": "value1",
+				"key2": "value2",
+				"key3": "value3",
+			}),
+			defaultConfig: &DefaultConfig{
+				Tags: New(map[string]string{
+					"key1": "defaultvalue1",
+					"key4": "value4",
+				}),
+				TagsAllPrecedence: TagsAllPrecedenceResource,
+			},
+			want: map[string]string{
+				"key1": "value1",
+				"key2": "value2",
+				"key3": "value3",
+				"key4": "value4",
+			},
+		},
 	}
 
 	for _, testCase := range testCases {
@@ -183,6 +227,106 @@ func TestKeyValueTagsDefaultConfigMergeTags(t *testing.T) {
 	}
 }
 
+func TestKeyValueTagsDefaultConfigOverriddenTags(t *testing.T) {
+	testCases := []struct {
+		name          string
+		tags          KeyValueTags
+		defaultConfig *DefaultConfig
+		want          map[string]string
+	}{
+		{
+			name: "no config",
+			tags: New(map[string]string{
+				"key1": "value1",
+			}),
+			defaultConfig: nil,
+			want:          map[string]string{},
+		},
+		{
+			name: "keys some overridden",
+			tags: New(map[string]string{
+				"key1": "value1",
+				"key2": "value2",
+				"key3": "value3",
+			}),
+			defaultConfig: &DefaultConfig{
+				Tags: New(map[string]string{
+					"key1": "defaultvalue1",
+					"key2": "value2",
+					"key4": "value4",
+				}),
+			},
+			want: map[string]string{
+				"key1": "value1",
+			},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			got := testCase.defaultConfig.OverriddenTags(testCase.tags)
+			testKeyValueTagsVerifyMap(t, got.Map(), testCase.want)
+		})
+	}
+}
+
+func TestKeyValueTagsDefaultConfigRoundTrip(t *testing.T) {
+	defaultTags := New(map[string]string{
+		"key1": "defaultvalue1",
+		"key2": "defaultvalue2",
+	})
+
+	testCases := []struct {
+		name       string
+		tags       KeyValueTags
+		precedence string
+		overridden bool
+	}{
+		{
+			name:       "resource precedence no overlap",
+			tags:       New(map[string]string{"key3": "value3"}),
+			precedence: TagsAllPrecedenceResource,
+		},
+		{
+			name:       "resource precedence different value",
+			tags:       New(map[string]string{"key1": "value1", "key3": "value3"}),
+			precedence: TagsAllPrecedenceResource,
+		},
+		{
+			name:       "provider precedence no overlap",
+			tags:       New(map[string]string{"key3": "value3"}),
+			precedence: TagsAllPrecedenceProvider,
+		},
+		{
+			name:       "provider precedence different value",
+			tags:       New(map[string]string{"key1": "value1", "key3": "value3"}),
+			precedence: TagsAllPrecedenceProvider,
+			overridden: true,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			dc := &DefaultConfig{
+				Tags:              defaultTags,
+				TagsAllPrecedence: testCase.precedence,
+			}
+
+			// Tags read back from the AWS API are the merged tags.
+			got := dc.MergeTags(testCase.tags).RemoveDefaultConfig(dc)
+
+			// The overridden resource tags are reported so that they can be rejected during planning.
+			if overridden := dc.OverriddenTags(testCase.tags); testCase.precedence == TagsAllPrecedenceProvider && (len(overridden) > 0) != testCase.overridden {
+				t.Errorf("got overridden tags %v, expected overridden: %t", overridden, testCase.overridden)
+			}
+
+			if !got.Equal(testCase.tags) {
+				t.Errorf("got read tags %v, expected %v", got, testCase.tags)
+			}
+		})
+	}
+}
+
 func TestKeyValueTagsDefaultConfigTagsEqual(t *testing.T) {
 	testCases := []struct {
 		name          string
@@ -291,6 +435,89 @@ func TestKeyValueTagsDefaultConfigTagsEqual(t *testing.T) {
 	}
 }
 
+func TestKeyValueTagsPolicyConfigViolations(t *testing.T) {
+	testCases := []struct {
+		name         string
+		tags         KeyValueTags
+		policyConfig *PolicyConfig
+		want         []string
+	}{
+		{
+			name: "no config",
+			tags: New(map[string]string{
+				"key1": "value1",
+			}),
+			policyConfig: nil,
+			want:         nil,
+		},
+		{
+			name: "compliant",
+			tags: New(map[string]string{
+				"cost-center": "1234",
+				"env":         "prod",
+				"owner":       "team",
+			}),
+			policyConfig: &PolicyConfig{
+				AllowedValues: map[string][]string{
+					"env": {"dev", "prod"},
+				},
+				KeyRegex:     regexp.MustCompile(`^[a-z-]+$`),
+				RequiredKeys: []string{"cost-center", "owner"},
+			},
+			want: nil,
+		},
+		{
+			name: "missing required keys",
+			tags: New(map[string]string{
+				"env": "prod",
+			}),
+			policyConfig: &PolicyConfig{
+				RequiredKeys: []string{"owner", "cost-center"},
+			},
+			want: []string{
+				"missing required tag keys: cost-center, owner",
+			},
+		},
+		{
+			name: "value not allowed",
+			tags: New(map[string]string{
+				"env": "test",
+			}),
+			policyConfig: &PolicyConfig{
+				AllowedValues: map[string][]string{
+					"env": {"dev", "prod"},
+				},
+			},
+			want: []string{
+				`tag "env" value "test" is not one of the allowed values: dev, prod`,
+			},
+		},
+		{
+			name: "key not matching",
+			tags: New(map[string]string{
+				"Env":   "prod",
+				"owner": "team",
+			}),
+			policyConfig: &PolicyConfig{
+				KeyRegex: regexp.MustCompile(`^[a-z-]+$`),
+			},
+			want: []string{
+				`tag key "Env" does not match "^[a-z-]+$"`,
+			},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			got := testCase.policyConfig.Violations(testCase.tags)
+
+			if !reflect.DeepEqual(got, testCase.want) {
+				t.Errorf("got %v, expected %v", got, testCase.want)
+			}
+		})
+	}
+}
+
 func TestKeyValueTagsIgnoreAWS(t *testing.T) {
 	testCases := []struct {
 		name string
@@ -533,6 +760,66 @@ func TestKeyValueTagsIgnoreConfig(t *testing.T) {
 				"key3": "value3",
 			},
 		},
+		{
+			name: "key regexes some matching",
+			tags: New(map[string]string{
+				"cost-center:team":  "value1",
+				"cost-center:owner": "value2",
+				"key3":              "value3",
+			}),
+			ignoreConfig: &IgnoreConfig{
+				KeyRegexes: []*regexp.Regexp{
+					regexp.MustCompile(`^cost-center:.+$`),
+				},
+			},
+			want: map[string]string{
+				"key3": "value3",
+			},
+		},
+		{
+			name: "key suffixes some suffixed",
+			tags: New(map[string]string{
+				"key1-managed": "value1",
+				"key2":         "value2",
+				"key3":         "value3",
+			}),
+			ignoreConfig: &IgnoreConfig{
+				KeySuffixes: New([]string{
+					"-managed",
+				}),
+			},
+			want: map[string]string{
+				"key2": "value2",
+				"key3": "value3",
+			},
+		},
+		{
+			name: "all options",
+			tags: New(map[string]string{
+				"aws-nuke-exclude": "value1",
+				"cost-center:team": "value2",
+				"key3-managed":     "value3",
+				"key4":             "value4",
+				"key5":             "value5",
+			}),
+			ignoreConfig: &IgnoreConfig{
+				Keys: New([]string{
+					"key4",
+				}),
+				KeyPrefixes: New([]string{
+					"aws-nuke-",
+				}),
+				KeyRegexes: []*regexp.Regexp{
+					regexp.MustCompile(`^cost-center:`),
+				},
+				KeySuffixes: New([]string{
+					"-managed",
+				}),
+			},
+			want: map[string]string{
+				"key5": "value5",
+			},
+		},
 	}
 
 	for _, testCase := range testCases {
@@ -689,6 +976,74 @@ func TestKeyValueTagsIgnorePrefixes(t *testing.T) {
 	}
 }
 
+func TestKeyValueTagsIgnoreRegexes(t *testing.T) {
+	testCases := []struct {
+		name             string
+		tags             KeyValueTags
+		ignoreTagRegexes []*regexp.Regexp
+		want             map[string]string
+	}{
+		{
+			name: "empty",
+			tags: New(map[string]string{}),
+			ignoreTagRegexes: []*regexp.Regexp{
+				regexp.MustCompile(`^key`),
+			},
+			want: map[string]string{},
+		},
+		{
+			name: "all",
+			tags: New(map[string]string{
+				"key1": "value1",
+				"key2": "value2",
+				"key3": "value3",
+			}),
+			ignoreTagRegexes: []*regexp.Regexp{
+				regexp.MustCompile(`^key\d$`),
+			},
+			want: map[string]string{},
+		},
+		{
+			name: "mixed",
+			tags: New(map[string]string{
+				"key1": "value1",
+				"key2": "value2",
+				"key3": "value3",
+			}),
+			ignoreTagRegexes: []*regexp.Regexp{
+				regexp.MustCompile(`1`),
+				regexp.MustCompile(`^KEY2$`),
+			},
+			want: map[string]string{
+				"key2": "value2",
+				"key3": "value3",
+			},
+		},
+		{
+			name: "none",
+			tags: New(map[string]string{
+				"key1": "value1",
+				"key2": "value2",
+				"key3": "value3",
+			}),
+			ignoreTagRegexes: nil,
+			want: map[string]string{
+				"key1": "value1",
+				"key2": "value2",
+				"key3": "value3",
+			},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			got := testCase.tags.IgnoreRegexes(testCase.ignoreTagRegexes)
+
+			testKeyValueTagsVerifyMap(t, got.Map(), testCase.want)
+		})
+	}
+}
+
 func TestKeyValueTagsIgnoreRds(t *testing.T) {
 	testCases := []struct {
 		name string
@@ -745,6 +1100,73 @@ func TestKeyValueTagsIgnoreRds(t *testing.T) {
 	}
 }
 
+func TestKeyValueTagsIgnoreSuffixes(t *testing.T) {
+	testCases := []struct {
+		name              string
+		tags              KeyValueTags
+		ignoreTagSuffixes KeyValueTags
+		want              map[string]string
+	}{
+		{
+			name: "empty",
+			tags: New(map[string]string{}),
+			ignoreTagSuffixes: New([]string{
+				"1",
+			}),
+			want: map[string]string{},
+		},
+		{
+			name: "all_exact",
+			tags: New(map[string]string{
+				"key1": "value1",
+				"key2": "value2",
+			}),
+			ignoreTagSuffixes: New([]string{
+				"key1",
+				"key2",
+			}),
+			want: map[string]string{},
+		},
+		{
+			name: "mixed",
+			tags: New(map[string]string{
+				"key1":         "value1",
+				"key2-managed": "value2",
+				"key3":         "value3",
+			}),
+			ignoreTagSuffixes: New([]string{
+				"-managed",
+			}),
+			want: map[string]string{
+				"key1": "value1",
+				"key3": "value3",
+			},
+		},
+		{
+			name: "none",
+			tags: New(map[string]string{
+				"key1": "value1",
+				"key2": "value2",
+			}),
+			ignoreTagSuffixes: New([]string{
+				"key",
+			}),
+			want: map[string]string{
+				"key1": "value1",
+				"key2": "value2",
+			},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			got := testCase.tags.IgnoreSuffixes(testCase.ignoreTagSuffixes)
+
+			testKeyValueTagsVerifyMap(t, got.Map(), testCase.want)
+		})
+	}
+}
+
 func TestKeyValueTagsIgnore(t *testing.T) {
 	testCases := []struct {
 		name       string
@@ -1647,6 +2069,205 @@ func TestKeyValueTagsUpdated(t *testing.T) {
 	}
 }
 
+func TestKeyValueTagsRemovedFold(t *testing.T) {
+	testCases := []struct {
+		name    string
+		oldTags KeyValueTags
+		newTags KeyValueTags
+		want    map[string]string
+	}{
+		{
+			name:    "empty",
+			oldTags: New(map[string]string{}),
+			newTags: New(map[string]string{}),
+			want:    map[string]string{},
+		},
+		{
+			name: "case_differs",
+			oldTags: New(map[string]string{
+				"key1": "value1",
+				"Key2": "value2",
+			}),
+			newTags: New(map[string]string{
+				"KEY1": "value1",
+				"key2": "value2updated",
+			}),
+			want: map[string]string{},
+		},
+		{
+			name: "mixed",
+			oldTags: New(map[string]string{
+				"key1": "value1",
+				"key2": "value2",
+				"key3": "value3",
+			}),
+			newTags: New(map[string]string{
+				"Key1": "value1",
+			}),
+			want: map[string]string{
+				"key2": "value2",
+				"key3": "value3",
+			},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			got := testCase.oldTags.RemovedFold(testCase.newTags)
+
+			testKeyValueTagsVerifyMap(t, got.Map(), testCase.want)
+		})
+	}
+}
+
+func TestKeyValueTagsUpdatedFold(t *testing.T) {
+	testCases := []struct {
+		name    string
+		oldTags KeyValueTags
+		newTags KeyValueTags
+		want    map[string]string
+	}{
+		{
+			name:    "empty",
+			oldTags: New(map[string]string{}),
+			newTags: New(map[string]string{}),
+			want:    map[string]string{},
+		},
+		{
+			name: "case_differs_no_changes",
+			oldTags: New(map[string]string{
+				"key1": "value1",
+				"Key2": "value2",
+			}),
+			newTags: New(map[string]string{
+				"KEY1": "value1",
+				"key2": "value2",
+			}),
+			want: map[string]string{},
+		},
+		{
+			name: "mixed",
+			oldTags: New(map[string]string{
+				"key1": "value1",
+				"key2": "value2",
+				"key3": "value3",
+			}),
+			newTags: New(map[string]string{
+				"Key1": "value1updated",
+				"KEY2": "value2",
+				"key4": "value4",
+			}),
+			want: map[string]string{
+				"Key1": "value1updated",
+				"key4": "value4",
+			},
+		},
+		{
+			name: "value_case_differs",
+			oldTags: New(map[string]string{
+				"key1": "value1",
+			}),
+			newTags: New(map[string]string{
+				"key1": "VALUE1",
+			}),
+			want: map[string]string{
+				"key1": "VALUE1",
+			},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			got := testCase.oldTags.UpdatedFold(testCase.newTags)
+
+			testKeyValueTagsVerifyMap(t, got.Map(), testCase.want)
+		})
+	}
+}
+
+func TestKeyValueTagsFoldKeys(t *testing.T) {
+	testCases := []struct {
+		name       string
+		tags       KeyValueTags
+		casingTags KeyValueTags
+		want       map[string]string
+	}{
+		{
+			name:       "empty",
+			tags:       New(map[string]string{}),
+			casingTags: New(map[string]string{}),
+			want:       map[string]string{},
+		},
+		{
+			name: "no_casing_tags",
+			tags: New(map[string]string{
+				"KEY1": "value1",
+			}),
+			casingTags: nil,
+			want: map[string]string{
+				"KEY1": "value1",
+			},
+		},
+		{
+			name: "mixed",
+			tags: New(map[string]string{
+				"KEY1": "value1",
+				"key2": "VALUE2",
+				"key3": "value3",
+			}),
+			casingTags: New(map[string]string{
+				"Key1": "value1",
+				"Key2": "value2",
+				"key4": "value4",
+			}),
+			want: map[string]string{
+				"Key1": "value1",
+				"Key2": "VALUE2",
+				"key3": "value3",
+			},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			got := testCase.tags.FoldKeys(testCase.casingTags)
+
+			testKeyValueTagsVerifyMap(t, got.Map(), testCase.want)
+		})
+	}
+}
+
+func TestKeyValueTagsFoldKeysReadBack(t *testing.T) {
+	dc := &DefaultConfig{
+		Tags: New(map[string]string{
+			"Environment": "test",
+		}),
+	}
+	configTags := New(map[string]string{
+		"Name": "example",
+	})
+
+	// Tags read back from an AWS API that normalizes tag key case.
+	apiTags := New(map[string]string{
+		"environment": "test",
+		"name":        "example",
+	})
+
+	if got := apiTags.RemoveDefaultConfig(dc); got.Equal(configTags) {
+		t.Fatalf("expected read tags %v to differ from %v without folding keys", got, configTags)
+	}
+
+	allTags := apiTags.FoldKeys(dc.MergeTags(configTags))
+
+	if got := allTags.RemoveDefaultConfig(dc); !got.Equal(configTags) {
+		t.Errorf("got read tags %v, expected %v", got, configTags)
+	}
+
+	if want := dc.MergeTags(configTags); !allTags.Equal(want) {
+		t.Errorf("got read tags_all %v, expected %v", allTags, want)
+	}
+}
+
 func TestKeyValueTagsChunks(t *testing.T) {
 	testCases := []struct {
 		name string
@@ -1950,6 +2571,73 @@ func TestKeyValueTagsEqual(t *testing.T) {
 	}
 }
 
+func TestKeyValueTagsEqualFold(t *testing.T) {
+	testCases := []struct {
+		name   string
+		source KeyValueTags
+		target KeyValueTags
+		want   bool
+	}{
+		{
+			name:   "nil",
+			source: nil,
+			target: nil,
+			want:   true,
+		},
+		{
+			name:   "source_nil",
+			source: nil,
+			target: New(map[string]string{
+				"key1": "value1",
+			}),
+			want: false,
+		},
+		{
+			name: "case_differs",
+			source: New(map[string]string{
+				"key1": "value1",
+				"Key2": "value2",
+			}),
+			target: New(map[string]string{
+				"KEY1": "value1",
+				"key2": "value2",
+			}),
+			want: true,
+		},
+		{
+			name: "value_case_differs",
+			source: New(map[string]string{
+				"key1": "value1",
+			}),
+			target: New(map[string]string{
+				"Key1": "VALUE1",
+			}),
+			want: false,
+		},
+		{
+			name: "length_differs",
+			source: New(map[string]string{
+				"key1": "value1",
+			}),
+			target: New(map[string]string{
+				"Key1": "value1",
+				"key2": "value2",
+			}),
+			want: false,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			got := testCase.source.EqualFold(testCase.target)
+
+			if got != testCase.want {
+				t.Errorf("unexpected EqualFold: %t", got)
+			}
+		})
+	}
+}
+
 func TestKeyValueTagsHash(t *testing.T) {
 	testCases := []struct {
 		name string
@@ -2,6 +2,9 @@ package tfresource
 
 import (
 	"context"
+	"log"
+	"math/rand"
+	"strings"
 	"time"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
@@ -39,7 +42,7 @@ func WaitUntilContext(ctx context.Context, timeout time.Duration, f func() (bool
 		return "", targetStateFalse, nil
 	}
 
-	stateConf := &resource.StateChangeConf{
+	stateConf := &StateChangeConf{
 		Pending:                   []string{targetStateFalse},
 		Target:                    []string{targetStateTrue},
 		Refresh:                   refresh,
@@ -62,3 +65,258 @@ func WaitUntilContext(ctx context.Context, timeout time.Duration, f func() (bool
 func WaitUntil(timeout time.Duration, f func() (bool, error), opts WaitOpts) error {
 	return WaitUntilContext(context.Background(), timeout, f, opts)
 }
+
+const (
+	defaultNotFoundChecks   = 20
+	defaultMaxTimeout       = 10 * time.Second
+	defaultProgressInterval = 1 * time.Minute
+	defaultJitter           = 0.1
+	maxPollInterval         = 180 * time.Second
+	initialWait             = 100 * time.Millisecond
+)
+
+// StateChangeConf is a drop-in replacement for resource.StateChangeConf.
+// Unlike the SDK implementation, waiting honours context cancellation between refreshes,
+// the backoff between refreshes can be jittered and periodic progress lines are logged.
+type StateChangeConf struct {
+	Delay          time.Duration             // Wait this time before starting checks.
+	Pending        []string                  // States that are "allowed" and will continue trying.
+	Refresh        resource.StateRefreshFunc // Refreshes the current state.
+	Target         []string                  // Target state.
+	Timeout        time.Duration             // The amount of time to wait before timeout.
+	MinTimeout     time.Duration             // Smallest time to wait before refreshes.
+	MaxTimeout     time.Duration             // Largest time to wait before refreshes. Defaults to 10 seconds.
+	PollInterval   time.Duration             // Override MinTimeout/backoff and only poll this often.
+	NotFoundChecks int                       // Number of times to allow not found (nil result from Refresh).
+
+	// This is to work around inconsistent APIs.
+	ContinuousTargetOccurence int // Number of times the Target state has to occur continuously.
+
+	Jitter           float64       // Randomize each wait by up to this fraction of its duration, e.g. 0.2 for ±20%. Defaults to 0.1; a negative value disables jitter.
+	ProgressInterval time.Duration // Log a progress line at most this often. Defaults to 1 minute.
+	Description      string        // Optional description of what is being waited for, used in progress lines.
+}
+
+// WaitForStateContext watches an object and waits for it to achieve the state
+// specified in the configuration using the specified Refresh() func,
+// waiting at most Timeout for the Target state to be reached.
+//
+// If the Refresh function returns an error, return immediately with that error.
+//
+// If the Refresh function returns a state other than the Target state or one
+// listed in Pending, return immediately with an error.
+//
+// If the Timeout is exceeded before reaching the Target state, return a *resource.TimeoutError.
+//
+// If the context is cancelled, return immediately with the context's error.
+func (conf *StateChangeConf) WaitForStateContext(ctx context.Context) (interface{}, error) {
+	log.Printf("[DEBUG] Waiting for state to become: %s", conf.Target)
+
+	notFoundChecks := conf.NotFoundChecks
+	if notFoundChecks == 0 {
+		notFoundChecks = defaultNotFoundChecks
+	}
+
+	continuousTargetOccurence := conf.ContinuousTargetOccurence
+	if continuousTargetOccurence == 0 {
+		continuousTargetOccurence = 1
+	}
+
+	maxTimeout := conf.MaxTimeout
+	if maxTimeout == 0 {
+		maxTimeout = defaultMaxTimeout
+	}
+
+	progressInterval := conf.ProgressInterval
+	if progressInterval == 0 {
+		progressInterval = defaultProgressInterval
+	}
+
+	jitterFraction := conf.Jitter
+	if jitterFraction == 0 {
+		jitterFraction = defaultJitter
+	}
+
+	start := time.Now()
+	deadline := start.Add(conf.Timeout)
+	lastProgress := start
+
+	if err := sleepContext(ctx, conf.Delay); err != nil {
+		return nil, err
+	}
+
+	var lastResult interface{}
+	var lastState string
+	var lastErr error
+	var wait time.Duration
+	notFoundTick := 0
+	targetOccurence := 0
+
+	for {
+		res, currentState, err := conf.Refresh()
+
+		if err != nil {
+			return res, err
+		}
+
+		lastResult, lastState, lastErr = res, currentState, err
+
+		// If we're waiting for the absence of a thing, then return.
+		if res == nil && len(conf.Target) == 0 {
+			targetOccurence++
+			if targetOccurence == continuousTargetOccurence {
+				return nil, nil
+			}
+		} else if res == nil {
+			// If we didn't find the resource, check if we have been
+			// not finding it for awhile, and if so, report an error.
+			notFoundTick++
+			if notFoundTick > notFoundChecks {
+				return nil, &resource.NotFoundError{
+					LastError: err,
+					Retries:   notFoundTick,
+				}
+			}
+		} else {
+			// Reset the counter for when a resource isn't found.
+			notFoundTick = 0
+			found := false
+
+			for _, allowed := range conf.Target {
+				if currentState == allowed {
+					found = true
+					targetOccurence++
+					if targetOccurence == continuousTargetOccurence {
+						return res, nil
+					}
+				}
+			}
+
+			for _, allowed := range conf.Pending {
+				if currentState == allowed {
+					found = true
+					targetOccurence = 0
+					break
+				}
+			}
+
+			if !found && len(conf.Pending) > 0 {
+				return res, &resource.UnexpectedStateError{
+					LastError:     err,
+					State:         currentState,
+					ExpectedState: conf.Target,
+				}
+			}
+		}
+
+		now := time.Now()
+
+		if !now.Before(deadline) {
+			log.Printf("[WARN] WaitForState timeout after %s", conf.Timeout)
+
+			return nil, &resource.TimeoutError{
+				LastError:     lastErr,
+				LastState:     lastState,
+				Timeout:       conf.Timeout,
+				ExpectedState: conf.Target,
+			}
+		}
+
+		if now.Sub(lastProgress) >= progressInterval {
+			conf.logProgress(lastResult, lastState, now.Sub(start))
+			lastProgress = now
+		}
+
+		// Wait between refreshes using exponential backoff, except when
+		// waiting for the target state to reoccur.
+		if wait == 0 {
+			wait = initialWait
+		} else if targetOccurence == 0 {
+			wait *= 2
+		}
+
+		// If a poll interval has been specified, choose that interval.
+		// Otherwise bound the default value.
+		if conf.PollInterval > 0 && conf.PollInterval < maxPollInterval {
+			wait = conf.PollInterval
+		} else if wait < conf.MinTimeout {
+			wait = conf.MinTimeout
+		} else if wait > maxTimeout {
+			wait = maxTimeout
+		}
+
+		sleep := jitter(wait, jitterFraction)
+
+		// Always refresh once more at the deadline.
+		if remaining := deadline.Sub(now); sleep > remaining {
+			sleep = remaining
+		}
+
+		log.Printf("[TRACE] Waiting %s before next try", sleep)
+
+		if err := sleepContext(ctx, sleep); err != nil {
+			return nil, err
+		}
+	}
+}
+
+// WaitForState watches an object and waits for it to achieve the state
+// specified in the configuration using the specified Refresh() func.
+//
+// Deprecated: Please use WaitForStateContext to ensure proper plugin shutdown.
+func (conf *StateChangeConf) WaitForState() (interface{}, error) {
+	return conf.WaitForStateContext(context.Background())
+}
+
+func (conf *StateChangeConf) logProgress(result interface{}, state string, elapsed time.Duration) {
+	var sb strings.Builder
+
+	if conf.Description != "" {
+		sb.WriteString(conf.Description)
+		sb.WriteString(": ")
+	}
+
+	target := strings.Join(conf.Target, ", ")
+
+	if len(conf.Target) == 0 {
+		target = "(deleted)"
+	}
+
+	if result == nil {
+		state = "(not found)"
+	}
+
+	log.Printf("[INFO] %sstill waiting for status %s, currently %s, elapsed %s", sb.String(), target, state, elapsed.Round(time.Second))
+}
+
+// jitter returns d randomized by up to ±fraction of d.
+func jitter(d time.Duration, fraction float64) time.Duration {
+	if fraction <= 0 || d <= 0 {
+		return d
+	}
+
+	if fraction > 1 {
+		fraction = 1
+	}
+
+	delta := fraction * float64(d)
+
+	return time.Duration(float64(d) - delta + rand.Float64()*2*delta)
+}
+
+// sleepContext sleeps for the specified duration or until the context is cancelled.
+func sleepContext(ctx context.Context, d time.Duration) error {
+	if d <= 0 {
+		return ctx.Err()
+	}
+
+	timer := time.NewTimer(d)
+	defer timer.Stop()
+
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-timer.C:
+		return nil
+	}
+}
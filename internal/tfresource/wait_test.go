@@ -1,6 +1,7 @@
 package tfresource_test
 
 import (
+	"context"
 	"errors"
 	"sync/atomic"
 	"testing"
@@ -63,3 +64,129 @@ func TestWaitUntil(t *testing.T) {
 		})
 	}
 }
+
+func TestStateChangeConfWaitForStateContext(t *testing.T) {
+	var refreshCount int32
+
+	testCases := []struct {
+		Name          string
+		Conf          tfresource.StateChangeConf
+		ExpectError   bool
+		ExpectTimeout bool
+	}{
+		{
+			Name: "target reached",
+			Conf: tfresource.StateChangeConf{
+				Pending: []string{"pending"},
+				Target:  []string{"target"},
+				Refresh: func() (interface{}, string, error) {
+					if atomic.AddInt32(&refreshCount, 1) < 3 {
+						return "", "pending", nil
+					}
+
+					return "", "target", nil
+				},
+				Timeout: 5 * time.Second,
+				Jitter:  0.5,
+			},
+		},
+		{
+			Name: "deleted",
+			Conf: tfresource.StateChangeConf{
+				Pending: []string{"deleting"},
+				Target:  []string{},
+				Refresh: func() (interface{}, string, error) {
+					if atomic.AddInt32(&refreshCount, 1) < 2 {
+						return "", "deleting", nil
+					}
+
+					return nil, "", nil
+				},
+				Timeout: 5 * time.Second,
+			},
+		},
+		{
+			Name: "refresh error",
+			Conf: tfresource.StateChangeConf{
+				Pending: []string{"pending"},
+				Target:  []string{"target"},
+				Refresh: func() (interface{}, string, error) {
+					return nil, "", errors.New("TestCode")
+				},
+				Timeout: 5 * time.Second,
+			},
+			ExpectError: true,
+		},
+		{
+			Name: "unexpected state",
+			Conf: tfresource.StateChangeConf{
+				Pending: []string{"pending"},
+				Target:  []string{"target"},
+				Refresh: func() (interface{}, string, error) {
+					return "", "failed", nil
+				},
+				Timeout: 5 * time.Second,
+			},
+			ExpectError: true,
+		},
+		{
+			Name: "never reaches state",
+			Conf: tfresource.StateChangeConf{
+				Pending: []string{"pending"},
+				Target:  []string{"target"},
+				Refresh: func() (interface{}, string, error) {
+					return "", "pending", nil
+				},
+				Timeout:          2 * time.Second,
+				ProgressInterval: 500 * time.Millisecond,
+				Description:      "test",
+			},
+			ExpectError:   true,
+			ExpectTimeout: true,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			refreshCount = 0
+
+			_, err := testCase.Conf.WaitForStateContext(context.Background())
+
+			if testCase.ExpectError && err == nil {
+				t.Fatal("expected error")
+			} else if !testCase.ExpectError && err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if testCase.ExpectTimeout && !tfresource.TimedOut(err) {
+				t.Fatalf("expected timeout error, got: %s", err)
+			}
+		})
+	}
+}
+
+func TestStateChangeConfWaitForStateContextCancelled(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+
+	conf := tfresource.StateChangeConf{
+		Pending: []string{"pending"},
+		Target:  []string{"target"},
+		Refresh: func() (interface{}, string, error) {
+			cancel()
+
+			return "", "pending", nil
+		},
+		Timeout: 1 * time.Minute,
+	}
+
+	start := time.Now()
+	_, err := conf.WaitForStateContext(ctx)
+
+	if !errors.Is(err, context.Canceled) {
+		t.Fatalf("expected context.Canceled, got: %v", err)
+	}
+
+	if elapsed := time.Since(start); elapsed > 5*time.Second {
+		t.Fatalf("expected cancellation to be honoured promptly, took %s", elapsed)
+	}
+}
@@ -3,6 +3,8 @@ package verify
 import (
 	"context"
 	"fmt"
+	"sort"
+	"strings"
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -17,10 +19,14 @@ import (
 // returns an error if unsuccessful or if the resource tags are identical
 // to those configured at the provider-level to avoid non-empty plans
 // after resource READ operations as resource and provider-level tags
-// will be indistinguishable when returned from an AWS API.
-func SetTagsDiff(_ context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+// will be indistinguishable when returned from an AWS API. It also returns
+// an error if provider-level tags take precedence over resource tags with
+// different values, or if the merged tags do not comply with the provider-level
+// tag policy.
+func SetTagsDiff(ctx context.Context, diff *schema.ResourceDiff, meta interface{}) error {
 	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
 	ignoreTagsConfig := meta.(*conns.AWSClient).IgnoreTagsConfig
+	tagPolicyConfig := meta.(*conns.AWSClient).TagPolicyConfig
 
 	resourceTags := tftags.New(diff.Get("tags").(map[string]interface{}))
 
@@ -28,6 +34,25 @@ func SetTagsDiff(_ context.Context, diff *schema.ResourceDiff, meta interface{})
 		return fmt.Errorf(`"tags" are identical to those in the "default_tags" configuration block of the provider: please de-duplicate and try again`)
 	}
 
+	// When provider-level tags take precedence, resource tags may not change their values.
+	// Tags that are not yet known are checked once they are.
+	if defaultTagsConfig != nil && defaultTagsConfig.TagsAllPrecedence == tftags.TagsAllPrecedenceProvider && diff.NewValueKnown("tags") {
+		if overriddenTags := defaultTagsConfig.OverriddenTags(resourceTags); len(overriddenTags) > 0 {
+			keys := overriddenTags.Keys()
+			sort.Strings(keys)
+
+			return fmt.Errorf(`"tags" values for keys %v differ from those in the "default_tags" configuration block of the provider, which may not be overridden as "tags_all_precedence" is "provider": please remove or align them and try again`, keys)
+		}
+	}
+
+	// The tag policy is checked before removing ignored tags as they are managed outside of Terraform.
+	// Tags that are not yet known are checked once they are.
+	if diff.NewValueKnown("tags") {
+		if violations := tagPolicyConfig.Violations(defaultTagsConfig.MergeTags(resourceTags)); len(violations) > 0 {
+			return fmt.Errorf("tags of %s do not comply with the provider tag_policy:\n\n%s", describeResource(ctx, diff), strings.Join(violations, "\n"))
+		}
+	}
+
 	allTags := defaultTagsConfig.MergeTags(resourceTags).IgnoreConfig(ignoreTagsConfig)
 
 	// To ensure "tags_all" is correctly computed, we explicitly set the attribute diff
@@ -53,6 +78,52 @@ func SetTagsDiff(_ context.Context, diff *schema.ResourceDiff, meta interface{})
 	return nil
 }
 
+type resourceTypeContextKey struct{}
+
+// ContextWithResourceType returns a copy of the context that carries the Terraform resource type,
+// so that plan-time errors can name the resource.
+func ContextWithResourceType(ctx context.Context, typeName string) context.Context {
+	return context.WithValue(ctx, resourceTypeContextKey{}, typeName)
+}
+
+// describeResource returns the resource's type and ID, or the type and name of a resource that is yet to be created,
+// for use in error messages.
+func describeResource(ctx context.Context, diff *schema.ResourceDiff) string {
+	var name string
+
+	// The ID of a resource that is yet to be created is unknown, so use one of the common name arguments.
+	if name = diff.Id(); name == "" {
+		for _, k := range []string{"name", "bucket"} {
+			if v, ok := diff.Get(k).(string); ok && v != "" && diff.NewValueKnown(k) {
+				name = v
+				break
+			}
+		}
+	}
+
+	typeName, _ := ctx.Value(resourceTypeContextKey{}).(string)
+
+	return resourceDescription(typeName, name, diff.Id() == "")
+}
+
+func resourceDescription(typeName, name string, isNew bool) string {
+	description := "resource"
+
+	if typeName != "" {
+		description = fmt.Sprintf("%s resource", typeName)
+	}
+
+	if isNew {
+		description = "new " + description
+	}
+
+	if name != "" {
+		description = fmt.Sprintf("%s (%s)", description, name)
+	}
+
+	return description
+}
+
 // SuppressEquivalentTypeStringBoolean provides custom difference suppression for TypeString booleans
 // Some arguments require three values: true, false, and "" (unspecified), but
 // confusing behavior exists when converting bare true/false values with state.
@@ -48,6 +48,27 @@ func TestSuppressEquivalentTypeStringBoolean(t *testing.T) {
 	}
 }
 
+func TestResourceDescription(t *testing.T) {
+	testCases := []struct {
+		typeName string
+		name     string
+		isNew    bool
+		expected string
+	}{
+		{typeName: "aws_s3_bucket", name: "example", expected: "aws_s3_bucket resource (example)"},
+		{typeName: "aws_s3_bucket", name: "example", isNew: true, expected: "new aws_s3_bucket resource (example)"},
+		{typeName: "aws_instance", isNew: true, expected: "new aws_instance resource"},
+		{name: "example", expected: "resource (example)"},
+		{isNew: true, expected: "new resource"},
+	}
+
+	for _, tc := range testCases {
+		if got := resourceDescription(tc.typeName, tc.name, tc.isNew); got != tc.expected {
+			t.Errorf("resourceDescription(%q, %q, %t) = %q, expected %q", tc.typeName, tc.name, tc.isNew, got, tc.expected)
+		}
+	}
+}
+
 func TestDiffStringMaps(t *testing.T) {
 	cases := []struct {
 		Old, New                  map[string]interface{}
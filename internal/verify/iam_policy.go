@@ -0,0 +1,389 @@
+package verify
+
+import (
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws/arn"
+)
+
+// Condition operators without the ForAllValues:/ForAnyValue: set operator prefixes and the IfExists suffix.
+// See https://docs.aws.amazon.com/IAM/latest/UserGuide/reference_policies_elements_condition_operators.html.
+var policyConditionOperators = []string{
+	"ArnEquals",
+	"ArnLike",
+	"ArnNotEquals",
+	"ArnNotLike",
+	"BinaryEquals",
+	"Bool",
+	"DateEquals",
+	"DateGreaterThan",
+	"DateGreaterThanEquals",
+	"DateLessThan",
+	"DateLessThanEquals",
+	"DateNotEquals",
+	"IpAddress",
+	"NotIpAddress",
+	"Null",
+	"NumericEquals",
+	"NumericGreaterThan",
+	"NumericGreaterThanEquals",
+	"NumericLessThan",
+	"NumericLessThanEquals",
+	"NumericNotEquals",
+	"StringEquals",
+	"StringEqualsIgnoreCase",
+	"StringLike",
+	"StringNotEquals",
+	"StringNotEqualsIgnoreCase",
+	"StringNotLike",
+}
+
+var policyConditionSetOperatorPrefixes = []string{
+	"ForAllValues:",
+	"ForAnyValue:",
+}
+
+// IAMPolicyConditionIfExistsSuffix is the suffix that makes a condition operator match when the condition key is absent.
+const IAMPolicyConditionIfExistsSuffix = "IfExists"
+
+// AWS global condition context keys.
+// See https://docs.aws.amazon.com/IAM/latest/UserGuide/reference_policies_condition-keys.html.
+var policyGlobalConditionKeys = []string{
+	"aws:CalledVia",
+	"aws:CalledViaFirst",
+	"aws:CalledViaLast",
+	"aws:CurrentTime",
+	"aws:Ec2InstanceSourcePrivateIPv4",
+	"aws:Ec2InstanceSourceVpc",
+	"aws:EpochTime",
+	"aws:FederatedProvider",
+	"aws:MultiFactorAuthAge",
+	"aws:MultiFactorAuthPresent",
+	"aws:PrincipalAccount",
+	"aws:PrincipalArn",
+	"aws:PrincipalIsAWSService",
+	"aws:PrincipalOrgID",
+	"aws:PrincipalOrgPaths",
+	"aws:PrincipalServiceName",
+	"aws:PrincipalServiceNamesList",
+	"aws:PrincipalType",
+	"aws:Referer",
+	"aws:RequestedRegion",
+	"aws:ResourceAccount",
+	"aws:ResourceOrgID",
+	"aws:ResourceOrgPaths",
+	"aws:SecureTransport",
+	"aws:SourceAccount",
+	"aws:SourceArn",
+	"aws:SourceIdentity",
+	"aws:SourceIp",
+	"aws:SourceOrgID",
+	"aws:SourceOrgPaths",
+	"aws:SourceVpc",
+	"aws:SourceVpce",
+	"aws:TagKeys",
+	"aws:TokenIssueTime",
+	"aws:UserAgent",
+	"aws:userid",
+	"aws:username",
+	"aws:ViaAWSService",
+	"aws:VpcSourceIp",
+}
+
+// Global condition context keys that are followed by a tag key.
+var policyGlobalConditionKeyPrefixes = []string{
+	"aws:PrincipalTag/",
+	"aws:RequestTag/",
+	"aws:ResourceTag/",
+}
+
+var policyPrincipalTypes = []string{
+	"*",
+	"AWS",
+	"CanonicalUser",
+	"Federated",
+	"Service",
+}
+
+// ValidateIAMPolicyDocument checks a JSON IAM policy document for mistakes that IAM would otherwise only report when the policy is applied.
+// Unknown global condition keys are returned as warnings as AWS adds new keys over time,
+// as are Resource values that are not ARNs as some resource-based policies use other forms.
+// Document size is not checked as the limit depends on where the policy is used.
+func ValidateIAMPolicyDocument(policy string) (ws []string, errs []error) {
+	return validatePolicyDocument(policy, true)
+}
+
+// ValidatePolicyDocument is ValidateIAMPolicyDocument for policy documents of any service,
+// e.g. resource-based policies, with Principal mistakes returned as warnings
+// as other services accept principals that IAM does not.
+func ValidatePolicyDocument(policy string) (ws []string, errs []error) {
+	return validatePolicyDocument(policy, false)
+}
+
+func validatePolicyDocument(policy string, iamPrincipals bool) (ws []string, errs []error) {
+	var document map[string]interface{}
+
+	if err := json.Unmarshal([]byte(policy), &document); err != nil {
+		return nil, []error{fmt.Errorf("invalid JSON: %w", err)}
+	}
+
+	var statements []interface{}
+
+	switch v := document["Statement"].(type) {
+	case nil:
+	case []interface{}:
+		statements = v
+	case map[string]interface{}:
+		statements = []interface{}{v}
+	default:
+		return nil, []error{fmt.Errorf("Statement must be an object or an array of objects")}
+	}
+
+	sids := make(map[string]struct{})
+
+	for i, v := range statements {
+		statement, ok := v.(map[string]interface{})
+
+		if !ok {
+			errs = append(errs, fmt.Errorf("statement %d must be an object", i))
+			continue
+		}
+
+		name := fmt.Sprintf("statement %d", i)
+
+		if sid, ok := statement["Sid"].(string); ok && sid != "" {
+			name = fmt.Sprintf("statement (%s)", sid)
+
+			if _, ok := sids[sid]; ok {
+				errs = append(errs, fmt.Errorf("duplicate Sid (%s)", sid))
+			}
+			sids[sid] = struct{}{}
+		}
+
+		statementWarnings, statementErrors := validatePolicyStatement(statement, iamPrincipals)
+
+		for _, w := range statementWarnings {
+			ws = append(ws, fmt.Sprintf("%s: %s", name, w))
+		}
+		for _, err := range statementErrors {
+			errs = append(errs, fmt.Errorf("%s: %w", name, err))
+		}
+	}
+
+	return ws, errs
+}
+
+func validatePolicyStatement(statement map[string]interface{}, iamPrincipals bool) (ws []string, errs []error) {
+	if v, ok := statement["Effect"]; ok {
+		if v, ok := v.(string); !ok || (v != "Allow" && v != "Deny") {
+			errs = append(errs, fmt.Errorf("Effect must be Allow or Deny, got %v", v))
+		}
+	}
+
+	for _, pair := range [][2]string{
+		{"Action", "NotAction"},
+		{"Principal", "NotPrincipal"},
+		{"Resource", "NotResource"},
+	} {
+		_, ok1 := statement[pair[0]]
+		_, ok2 := statement[pair[1]]
+
+		if ok1 && ok2 {
+			errs = append(errs, fmt.Errorf("cannot contain both %s and %s", pair[0], pair[1]))
+		}
+	}
+
+	for _, element := range []string{"Resource", "NotResource"} {
+		if v, ok := statement[element]; ok {
+			resources, err := PolicyStringOrStringList(v)
+
+			if err != nil {
+				errs = append(errs, fmt.Errorf("%s: %w", element, err))
+				continue
+			}
+
+			for _, resource := range resources {
+				if !validPolicyResource(resource) {
+					ws = append(ws, fmt.Sprintf("%s: (%s) is not \"*\" or a valid ARN", element, resource))
+				}
+			}
+		}
+	}
+
+	for _, element := range []string{"Principal", "NotPrincipal"} {
+		if v, ok := statement[element]; ok {
+			for _, err := range validatePolicyPrincipal(v) {
+				if !iamPrincipals {
+					ws = append(ws, fmt.Sprintf("%s: %s", element, err))
+					continue
+				}
+
+				errs = append(errs, fmt.Errorf("%s: %w", element, err))
+			}
+		}
+	}
+
+	if v, ok := statement["Condition"]; ok {
+		conditionWarnings, conditionErrors := validatePolicyCondition(v)
+
+		ws = append(ws, conditionWarnings...)
+		errs = append(errs, conditionErrors...)
+	}
+
+	return ws, errs
+}
+
+func validatePolicyPrincipal(v interface{}) (errs []error) {
+	switch v := v.(type) {
+	case string:
+		if v != "*" {
+			errs = append(errs, fmt.Errorf("must be \"*\" or an object, got %q", v))
+		}
+	case map[string]interface{}:
+		for _, principalType := range PolicySortedKeys(v) {
+			if !policyStringInSlice(principalType, policyPrincipalTypes, false) {
+				errs = append(errs, fmt.Errorf("unsupported principal type (%s), expected one of %s", principalType, strings.Join(policyPrincipalTypes, ", ")))
+				continue
+			}
+
+			if _, err := PolicyStringOrStringList(v[principalType]); err != nil {
+				errs = append(errs, fmt.Errorf("%s: %w", principalType, err))
+			}
+		}
+	default:
+		errs = append(errs, fmt.Errorf("must be \"*\" or an object"))
+	}
+
+	return errs
+}
+
+func validatePolicyCondition(v interface{}) (ws []string, errs []error) {
+	conditions, ok := v.(map[string]interface{})
+
+	if !ok {
+		return nil, []error{fmt.Errorf("Condition must be an object")}
+	}
+
+	for _, operator := range PolicySortedKeys(conditions) {
+		if !ValidIAMPolicyConditionOperator(operator) {
+			errs = append(errs, fmt.Errorf("unsupported condition operator (%s)", operator))
+		}
+
+		keys, ok := conditions[operator].(map[string]interface{})
+
+		if !ok {
+			errs = append(errs, fmt.Errorf("condition operator (%s) must map condition keys to values", operator))
+			continue
+		}
+
+		for _, key := range PolicySortedKeys(keys) {
+			if !validPolicyGlobalConditionKey(key) {
+				ws = append(ws, fmt.Sprintf("unknown global condition key (%s)", key))
+			}
+		}
+	}
+
+	return ws, errs
+}
+
+// ValidIAMPolicyConditionOperator returns whether the specified condition operator is valid.
+// Operators are matched case-insensitively.
+func ValidIAMPolicyConditionOperator(operator string) bool {
+	for _, prefix := range policyConditionSetOperatorPrefixes {
+		if len(operator) > len(prefix) && strings.EqualFold(operator[:len(prefix)], prefix) {
+			operator = operator[len(prefix):]
+			break
+		}
+	}
+
+	ifExists := false
+	if n := len(operator) - len(IAMPolicyConditionIfExistsSuffix); n > 0 && strings.EqualFold(operator[n:], IAMPolicyConditionIfExistsSuffix) {
+		operator = operator[:n]
+		ifExists = true
+	}
+
+	// The Null operator has no ...IfExists form.
+	if ifExists && strings.EqualFold(operator, "Null") {
+		return false
+	}
+
+	return policyStringInSlice(operator, policyConditionOperators, true)
+}
+
+// validPolicyGlobalConditionKey returns whether the specified condition key is a known global condition key.
+// Service-specific condition keys are always considered valid.
+func validPolicyGlobalConditionKey(key string) bool {
+	if !strings.HasPrefix(strings.ToLower(key), "aws:") {
+		return true
+	}
+
+	for _, prefix := range policyGlobalConditionKeyPrefixes {
+		if len(key) > len(prefix) && strings.EqualFold(key[:len(prefix)], prefix) {
+			return true
+		}
+	}
+
+	return policyStringInSlice(key, policyGlobalConditionKeys, true)
+}
+
+// validPolicyResource returns whether the specified resource is "*" or an ARN.
+// Some resource-based policies use other forms, e.g. "execute-api:/*" in API Gateway resource policies.
+func validPolicyResource(resource string) bool {
+	if resource == "*" {
+		return true
+	}
+
+	_, err := arn.Parse(resource)
+
+	return err == nil
+}
+
+// PolicyStringOrStringList returns the value of a policy element that is either a string or an array of strings.
+func PolicyStringOrStringList(v interface{}) ([]string, error) {
+	switch v := v.(type) {
+	case string:
+		return []string{v}, nil
+	case []interface{}:
+		out := make([]string, 0, len(v))
+
+		for _, v := range v {
+			s, ok := v.(string)
+
+			if !ok {
+				return nil, fmt.Errorf("must be a string or an array of strings")
+			}
+
+			out = append(out, s)
+		}
+
+		return out, nil
+	default:
+		return nil, fmt.Errorf("must be a string or an array of strings")
+	}
+}
+
+// PolicySortedKeys returns the keys of a policy element object in sorted order.
+func PolicySortedKeys(m map[string]interface{}) []string {
+	keys := make([]string, 0, len(m))
+
+	for k := range m {
+		keys = append(keys, k)
+	}
+
+	sort.Strings(keys)
+
+	return keys
+}
+
+func policyStringInSlice(s string, slice []string, ignoreCase bool) bool {
+	for _, v := range slice {
+		if v == s || (ignoreCase && strings.EqualFold(v, s)) {
+			return true
+		}
+	}
+
+	return false
+}
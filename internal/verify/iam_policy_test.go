@@ -0,0 +1,125 @@
+package verify
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestValidateIAMPolicyDocument(t *testing.T) {
+	testCases := []struct {
+		TestName         string
+		Policy           string
+		ExpectedErrors   []string
+		ExpectedWarnings []string
+	}{
+		{
+			TestName: "valid",
+			Policy: `{
+  "Version": "2012-10-17",
+  "Statement": [
+    {
+      "Sid": "AllowList",
+      "Effect": "Allow",
+      "Action": "s3:ListBucket",
+      "Resource": "arn:aws:s3:::my-bucket",
+      "Principal": {"AWS": ["arn:aws:iam::123456789012:root"]},
+      "Condition": {
+        "ForAnyValue:StringLikeIfExists": {"s3:prefix": ["home/"]},
+        "Bool": {"aws:SecureTransport": true},
+        "StringEquals": {"aws:ResourceTag/Environment": "test"}
+      }
+    },
+    {
+      "Effect": "Deny",
+      "NotAction": "s3:*",
+      "NotResource": "*",
+      "Principal": "*"
+    }
+  ]
+}`,
+		},
+		{
+			TestName: "single statement object",
+			Policy:   `{"Statement": {"Effect": "Allow", "Action": "s3:*", "Resource": "*"}}`,
+		},
+		{
+			TestName: "no statements",
+			Policy:   `{}`,
+		},
+		{
+			TestName:       "invalid effect",
+			Policy:         `{"Statement": [{"Effect": "allow", "Action": "s3:*", "Resource": "*"}]}`,
+			ExpectedErrors: []string{"statement 0: Effect must be Allow or Deny"},
+		},
+		{
+			TestName:       "duplicate Sid",
+			Policy:         `{"Statement": [{"Sid": "A", "Action": "s3:*", "Resource": "*"}, {"Sid": "A", "Action": "s3:*", "Resource": "*"}]}`,
+			ExpectedErrors: []string{"duplicate Sid (A)"},
+		},
+		{
+			TestName:       "Action and NotAction",
+			Policy:         `{"Statement": [{"Sid": "A", "Action": "s3:*", "NotAction": "s3:GetObject", "Resource": "*"}]}`,
+			ExpectedErrors: []string{"statement (A): cannot contain both Action and NotAction"},
+		},
+		{
+			TestName:         "non-ARN resource",
+			Policy:           `{"Statement": [{"Action": "s3:*", "Resource": ["arn:aws:s3:::my-bucket", "my-bucket/*"]}]}`,
+			ExpectedWarnings: []string{`statement 0: Resource: (my-bucket/*) is not "*" or a valid ARN`},
+		},
+		{
+			TestName:         "API Gateway resource policy",
+			Policy:           `{"Statement": [{"Effect": "Allow", "Principal": "*", "Action": "execute-api:Invoke", "Resource": "execute-api:/*"}]}`,
+			ExpectedWarnings: []string{`statement 0: Resource: (execute-api:/*) is not "*" or a valid ARN`},
+		},
+		{
+			TestName:       "invalid principal type",
+			Policy:         `{"Statement": [{"Action": "s3:*", "Resource": "*", "Principal": {"Account": "123456789012"}}]}`,
+			ExpectedErrors: []string{"statement 0: Principal: unsupported principal type (Account)"},
+		},
+		{
+			TestName:       "invalid condition operator",
+			Policy:         `{"Statement": [{"Action": "s3:*", "Resource": "*", "Condition": {"StringEqual": {"s3:prefix": "home/"}}}]}`,
+			ExpectedErrors: []string{"statement 0: unsupported condition operator (StringEqual)"},
+		},
+		{
+			TestName:       "Null with IfExists",
+			Policy:         `{"Statement": [{"Action": "s3:*", "Resource": "*", "Condition": {"NullIfExists": {"aws:TokenIssueTime": "true"}}}]}`,
+			ExpectedErrors: []string{"statement 0: unsupported condition operator (NullIfExists)"},
+		},
+		{
+			TestName:         "unknown global condition key",
+			Policy:           `{"Statement": [{"Action": "s3:*", "Resource": "*", "Condition": {"StringEquals": {"aws:SourceAcount": "123456789012"}}}]}`,
+			ExpectedWarnings: []string{"statement 0: unknown global condition key (aws:SourceAcount)"},
+		},
+		{
+			TestName: "organization and EC2 instance source global condition keys",
+			Policy:   `{"Statement": [{"Action": "s3:*", "Resource": "*", "Condition": {"StringEquals": {"aws:SourceOrgID": "o-123456", "aws:Ec2InstanceSourceVpc": "vpc-12345678"}, "ForAnyValue:StringLike": {"aws:SourceOrgPaths": "o-123456/*"}}}]}`,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.TestName, func(t *testing.T) {
+			gotWarnings, gotErrors := ValidateIAMPolicyDocument(testCase.Policy)
+
+			if got, expected := len(gotErrors), len(testCase.ExpectedErrors); got != expected {
+				t.Fatalf("got %d errors (%v), expected %d", got, gotErrors, expected)
+			}
+
+			for i, err := range gotErrors {
+				if !strings.HasPrefix(err.Error(), testCase.ExpectedErrors[i]) {
+					t.Errorf("got error %q, expected %q", err, testCase.ExpectedErrors[i])
+				}
+			}
+
+			if got, expected := len(gotWarnings), len(testCase.ExpectedWarnings); got != expected {
+				t.Fatalf("got %d warnings (%v), expected %d", got, gotWarnings, expected)
+			}
+
+			for i, w := range gotWarnings {
+				if w != testCase.ExpectedWarnings[i] {
+					t.Errorf("got warning %q, expected %q", w, testCase.ExpectedWarnings[i])
+				}
+			}
+		})
+	}
+}
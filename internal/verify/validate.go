@@ -93,7 +93,19 @@ func ValidCIDRNetworkAddress(v interface{}, k string) (ws []string, errors []err
 	return
 }
 
+// ValidIAMPolicyJSON validates a JSON policy document of any service with ValidatePolicyDocument.
 func ValidIAMPolicyJSON(v interface{}, k string) (ws []string, errors []error) {
+	return validIAMPolicyJSON(v, k, ValidatePolicyDocument)
+}
+
+// ValidIAMIdentityPolicyJSON is ValidIAMPolicyJSON with the stricter checks of ValidateIAMPolicyDocument.
+// It is only used for identity-based policies, i.e. IAM managed policies and user, group and role inline policies,
+// as resource-based policies of other services accept principals that IAM does not.
+func ValidIAMIdentityPolicyJSON(v interface{}, k string) (ws []string, errors []error) {
+	return validIAMPolicyJSON(v, k, ValidateIAMPolicyDocument)
+}
+
+func validIAMPolicyJSON(v interface{}, k string, validate func(string) ([]string, []error)) (ws []string, errors []error) {
 	// IAM Policy documents need to be valid JSON, and pass legacy parsing
 	value := v.(string)
 	if len(value) < 1 {
@@ -106,6 +118,14 @@ func ValidIAMPolicyJSON(v interface{}, k string) (ws []string, errors []error) {
 	}
 	if _, err := structure.NormalizeJsonString(v); err != nil {
 		errors = append(errors, fmt.Errorf("%q contains an invalid JSON: %s", k, err))
+		return
+	}
+	policyWarnings, policyErrors := validate(value)
+	for _, w := range policyWarnings {
+		ws = append(ws, fmt.Sprintf("%q: %s", k, w))
+	}
+	for _, err := range policyErrors {
+		errors = append(errors, fmt.Errorf("%q contains an invalid policy: %w", k, err))
 	}
 	return
 }
@@ -346,6 +346,39 @@ func TestValidIAMPolicyJSONString(t *testing.T) {
 	}
 }
 
+func TestValidIAMIdentityPolicyJSON(t *testing.T) {
+	// Principal types other than IAM's are accepted by ValidIAMPolicyJSON for other services' policies.
+	principal := `{"Statement":[{"Effect":"Allow","Principal":{"Account":"123456789012"},"Action":"s3:*","Resource":"*"}]}`
+
+	if ws, errors := ValidIAMPolicyJSON(principal, "json"); len(ws) != 1 || len(errors) != 0 {
+		t.Fatalf("Expected %q to trigger a single validation warning, got %v, %v", principal, ws, errors)
+	}
+
+	if _, errors := ValidIAMIdentityPolicyJSON(principal, "json"); len(errors) != 1 {
+		t.Fatalf("Expected %q to trigger a validation error, got %v", principal, errors)
+	}
+
+	duplicateSid := `{"Statement":[{"Sid":"A","Effect":"Allow","Action":"s3:*","Resource":"*"},{"Sid":"A","Effect":"Allow","Action":"s3:*","Resource":"*"}]}`
+
+	if _, errors := ValidIAMPolicyJSON(duplicateSid, "json"); len(errors) != 1 {
+		t.Fatalf("Expected %q to trigger a validation error, got %v", duplicateSid, errors)
+	}
+
+	if _, errors := ValidIAMIdentityPolicyJSON(duplicateSid, "json"); len(errors) != 1 {
+		t.Fatalf("Expected %q to trigger a validation error, got %v", duplicateSid, errors)
+	}
+
+	if _, errors := ValidIAMIdentityPolicyJSON(`{"xyz":[}}`, "json"); len(errors) != 1 {
+		t.Fatalf("Expected invalid JSON to trigger a single validation error, got %v", errors)
+	}
+
+	valid := `{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Action":"s3:ListBucket","Resource":"arn:aws:s3:::my-bucket"}]}`
+
+	if ws, errors := ValidIAMIdentityPolicyJSON(valid, "json"); len(ws) != 0 || len(errors) != 0 {
+		t.Fatalf("Expected %q not to trigger a validation warning or error, got %v, %v", valid, ws, errors)
+	}
+}
+
 func TestValidStringIsJSONOrYAML(t *testing.T) {
 	type testCases struct {
 		Value    string